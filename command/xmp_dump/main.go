@@ -3,14 +3,19 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"encoding/json"
+	"net/url"
 
 	"github.com/dsoprea/go-logging"
 	"github.com/jessevdk/go-flags"
 
 	"github.com/dsoprea/go-xmp"
 	_ "github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/rdf"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/schema"
 )
 
 var (
@@ -18,10 +23,17 @@ var (
 )
 
 type parameters struct {
-	Filepath            string `short:"f" long:"filepath" required:"true" description:"File-path of image"`
-	PrintAsJson         bool   `short:"j" long:"json" description:"Print out as JSON"`
-	IsVerbose           bool   `short:"v" long:"verbose" description:"Print logging"`
-	DoNotSimplifyExport bool   `short:"n" long:"no-simplify" description:"If exporting, return the raw, unsimplified structure"`
+	Filepath            string   `short:"f" long:"filepath" required:"true" description:"File-path of image"`
+	PrintAsJson         bool     `short:"j" long:"json" description:"Print out as JSON"`
+	IsVerbose           bool     `short:"v" long:"verbose" description:"Print logging"`
+	DoNotSimplifyExport bool     `short:"n" long:"no-simplify" description:"If exporting, return the raw, unsimplified structure"`
+	Order               string   `short:"o" long:"order" choice:"sorted" choice:"document" default:"sorted" description:"Print properties sorted by namespace prefix and name or in the order that they appear in the document"`
+	PrintDocument       bool     `short:"D" long:"document" description:"Print out as a versioned JSON document that can be imported again"`
+	DiffFilepath        string   `short:"d" long:"diff" description:"Print the differences going from the image given by --filepath to this image (as JSON Patch operations if --json)"`
+	IgnoreTimezone      bool     `short:"z" long:"ignore-timezone" description:"If diffing, consider dates describing the same instant in different timezones to be equal"`
+	RdfFormat           string   `short:"r" long:"rdf" choice:"turtle" choice:"ntriples" choice:"jsonld" description:"Print the underlying RDF graph in the given serialization"`
+	BaseIri             string   `short:"b" long:"base-iri" description:"Absolute IRI that relative IRIs are resolved against when printing N-Triples (defaults to the file URI of the image)"`
+	SchemaFilepaths     []string `short:"s" long:"schema" description:"File-path of a JSON or YAML schema of custom namespaces to register (may be given more than once)"`
 }
 
 var (
@@ -38,6 +50,11 @@ func main() {
 		}
 	}()
 
+	if len(os.Args) > 1 && os.Args[1] == namespacesCommand {
+		runNamespaces(os.Args[2:])
+		return
+	}
+
 	_, err := flags.Parse(arguments)
 	if err != nil {
 		os.Exit(-1)
@@ -53,7 +70,64 @@ func main() {
 		log.LoadConfiguration(scp)
 	}
 
-	f, err := os.Open(arguments.Filepath)
+	for _, filepath := range arguments.SchemaFilepaths {
+		err := xmpschema.LoadFile(xmpregistry.Default(), filepath)
+		log.PanicIf(err)
+	}
+
+	xpi := parseFile(arguments.Filepath)
+
+	if arguments.DiffFilepath != "" {
+		printDiff(xpi, parseFile(arguments.DiffFilepath))
+		return
+	}
+
+	if arguments.RdfFormat != "" {
+		baseIri := arguments.BaseIri
+		if baseIri == "" {
+			baseIri = fileIri(arguments.Filepath)
+		}
+
+		printRdf(xpi, arguments.RdfFormat, baseIri)
+		return
+	}
+
+	order := xmp.IndexOrderSorted
+	if arguments.Order == "document" {
+		order = xmp.IndexOrderDocument
+	}
+
+	if arguments.PrintDocument == true {
+		document, err := xpi.ExportDocument()
+		log.PanicIf(err)
+
+		encoded, err := json.MarshalIndent(document, "", "  ")
+		log.PanicIf(err)
+
+		fmt.Println(string(encoded))
+
+		return
+	}
+
+	if arguments.PrintAsJson == true {
+		doSimplify := arguments.DoNotSimplifyExport == false
+
+		flat, err := xpi.ExportOrdered(doSimplify, order)
+		log.PanicIf(err)
+
+		encoded, err := json.MarshalIndent(flat, "", "  ")
+		log.PanicIf(err)
+
+		fmt.Println(string(encoded))
+
+		return
+	}
+
+	xpi.DumpOrdered(order)
+}
+
+func parseFile(filepath string) *xmp.XmpPropertyIndex {
+	f, err := os.Open(filepath)
 	log.PanicIf(err)
 
 	defer f.Close()
@@ -63,13 +137,19 @@ func main() {
 	xpi, err := xp.Parse()
 	log.PanicIf(err)
 
-	if arguments.PrintAsJson == true {
-		doSimplify := arguments.DoNotSimplifyExport == false
+	return xpi
+}
 
-		flat, err := xpi.Export(doSimplify)
-		log.PanicIf(err)
+func printDiff(oldIndex, newIndex *xmp.XmpPropertyIndex) {
+	options := &xmp.DiffOptions{
+		IgnoreTimezone: arguments.IgnoreTimezone,
+	}
 
-		encoded, err := json.MarshalIndent(flat, "", "  ")
+	report, err := xmp.Diff(oldIndex, newIndex, options)
+	log.PanicIf(err)
+
+	if arguments.PrintAsJson == true {
+		encoded, err := json.MarshalIndent(report, "", "  ")
 		log.PanicIf(err)
 
 		fmt.Println(string(encoded))
@@ -77,5 +157,36 @@ func main() {
 		return
 	}
 
-	xpi.Dump()
+	fmt.Print(report.Text())
+}
+
+// fileIri returns the "file" URI of the given file.
+func fileIri(imageFilepath string) string {
+	absFilepath, err := filepath.Abs(imageFilepath)
+	log.PanicIf(err)
+
+	u := url.URL{
+		Scheme: "file",
+		Path:   filepath.ToSlash(absFilepath),
+	}
+
+	return u.String()
+}
+
+func printRdf(xpi *xmp.XmpPropertyIndex, format string, baseIri string) {
+	graph := xpi.Graph()
+	prefixes := xmprdf.RegisteredPrefixes(graph, xpi.Registry())
+
+	var err error
+
+	switch format {
+	case "turtle":
+		err = graph.WriteTurtle(os.Stdout, prefixes)
+	case "ntriples":
+		err = graph.WriteNTriples(os.Stdout, baseIri)
+	case "jsonld":
+		err = graph.WriteJsonLd(os.Stdout, prefixes)
+	}
+
+	log.PanicIf(err)
 }
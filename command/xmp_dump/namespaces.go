@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"encoding/json"
+	"text/tabwriter"
+
+	"github.com/dsoprea/go-logging"
+	"github.com/jessevdk/go-flags"
+
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/schema"
+)
+
+const (
+	// namespacesCommand is the first argument that selects printing the
+	// registry rather than a file.
+	namespacesCommand = "namespaces"
+)
+
+type namespacesParameters struct {
+	PrintAsJson     bool     `short:"j" long:"json" description:"Print out as JSON"`
+	Prefix          string   `short:"p" long:"prefix" description:"Only print the namespace with this preferred prefix"`
+	SchemaFilepaths []string `short:"s" long:"schema" description:"File-path of a JSON or YAML schema of custom namespaces to register first (may be given more than once)"`
+}
+
+// registryField is the JSON form of a single field descriptor.
+type registryField struct {
+	Name          string `json:"name"`
+	Parent        string `json:"parent,omitempty"`
+	Type          string `json:"type"`
+	ArrayKind     string `json:"array_kind,omitempty"`
+	Description   string `json:"description,omitempty"`
+	SpecReference string `json:"spec_reference,omitempty"`
+}
+
+// registryNamespace is the JSON form of a single namespace.
+type registryNamespace struct {
+	Uri           string          `json:"uri"`
+	Prefix        string          `json:"prefix"`
+	Description   string          `json:"description,omitempty"`
+	SpecReference string          `json:"spec_reference,omitempty"`
+	Fields        []registryField `json:"fields"`
+}
+
+// describeNamespace returns the printable form of the namespace.
+func describeNamespace(namespace xmpregistry.Namespace) registryNamespace {
+	rn := registryNamespace{
+		Uri:           namespace.Uri,
+		Prefix:        namespace.PreferredPrefix,
+		Description:   namespace.Description,
+		SpecReference: namespace.SpecReference,
+		Fields:        make([]registryField, 0),
+	}
+
+	for _, fd := range namespace.Describe() {
+		rf := registryField{
+			Name:          fd.Name,
+			Type:          fd.TypeName,
+			ArrayKind:     string(fd.ArrayKind),
+			Description:   fd.Description,
+			SpecReference: fd.SpecReference,
+		}
+
+		if fd.Parent.Local != "" {
+			rf.Parent = xmpregistry.XmlName(fd.Parent).String()
+		}
+
+		rn.Fields = append(rn.Fields, rf)
+	}
+
+	return rn
+}
+
+// runNamespaces prints the namespaces of the default registry.
+func runNamespaces(args []string) {
+	arguments := new(namespacesParameters)
+
+	_, err := flags.ParseArgs(arguments, args)
+	if err != nil {
+		os.Exit(-1)
+	}
+
+	for _, filepath := range arguments.SchemaFilepaths {
+		err := xmpschema.LoadFile(xmpregistry.Default(), filepath)
+		log.PanicIf(err)
+	}
+
+	namespaces := xmpregistry.List()
+
+	if arguments.Prefix != "" {
+		namespace, err := xmpregistry.LookupByPrefix(arguments.Prefix)
+		if err == xmpregistry.ErrNamespaceNotFound {
+			fmt.Printf("No namespace has prefix [%s].\n", arguments.Prefix)
+			os.Exit(1)
+		}
+
+		log.PanicIf(err)
+
+		namespaces = []xmpregistry.Namespace{namespace}
+	}
+
+	described := make([]registryNamespace, len(namespaces))
+	for i, namespace := range namespaces {
+		described[i] = describeNamespace(namespace)
+	}
+
+	if arguments.PrintAsJson == true {
+		encoded, err := json.MarshalIndent(described, "", "  ")
+		log.PanicIf(err)
+
+		fmt.Println(string(encoded))
+
+		return
+	}
+
+	for i, rn := range described {
+		if i > 0 {
+			fmt.Println("")
+		}
+
+		fmt.Printf("%s: %s\n", rn.Prefix, rn.Uri)
+
+		if rn.Description != "" {
+			fmt.Printf("  %s\n", rn.Description)
+		}
+
+		if rn.SpecReference != "" {
+			fmt.Printf("  (%s)\n", rn.SpecReference)
+		}
+
+		fmt.Println("")
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+
+		fmt.Fprintln(tw, "  FIELD\tTYPE\tARRAY\tDESCRIPTION")
+
+		for _, rf := range rn.Fields {
+			name := rf.Name
+			if rf.Parent != "" {
+				name = fmt.Sprintf("%s.%s", rf.Parent, rf.Name)
+			}
+
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", name, rf.Type, rf.ArrayKind, rf.Description)
+		}
+
+		err := tw.Flush()
+		log.PanicIf(err)
+	}
+}
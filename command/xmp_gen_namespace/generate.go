@@ -0,0 +1,498 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"encoding/xml"
+	"go/format"
+	"text/template"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/schema"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// typePackagePath is the import path of the package that the field-types
+	// are in.
+	typePackagePath = "github.com/dsoprea/go-xmp/type"
+)
+
+// identifier converts the given name to an exported Go identifier. Runs of
+// capitals are treated as a single word (e.g. "xmpDM" becomes "XmpDm") and
+// characters that can not appear in an identifier separate words.
+func identifier(name string) string {
+	b := new(strings.Builder)
+
+	runes := []rune(name)
+	isWordStart := true
+
+	for i, r := range runes {
+		if unicode.IsLetter(r) == false && unicode.IsDigit(r) == false {
+			isWordStart = true
+			continue
+		}
+
+		if isWordStart == true {
+			b.WriteRune(unicode.ToUpper(r))
+			isWordStart = false
+
+			continue
+		}
+
+		// A capital continues a run of capitals unless it starts a new word
+		// (e.g. the "D" in "GPSData").
+
+		if unicode.IsUpper(r) == true && unicode.IsUpper(runes[i-1]) == false {
+			b.WriteRune(r)
+		} else if unicode.IsUpper(r) == true && i+1 < len(runes) && unicode.IsLower(runes[i+1]) == true {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	id := b.String()
+	if id == "" || unicode.IsDigit([]rune(id)[0]) == true {
+		id = "X" + id
+	}
+
+	return id
+}
+
+// goLiteral returns the Go expression that constructs the given field-type.
+func goLiteral(value interface{}) string {
+	return literal(reflect.ValueOf(value), true)
+}
+
+func literal(v reflect.Value, withType bool) string {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() == true {
+			return "nil"
+		}
+
+		return literal(v.Elem(), true)
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Slice:
+		parts := make([]string, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			parts[i] = literal(v.Index(i), false) + ",\n"
+		}
+
+		return fmt.Sprintf("%s{\n%s}", typeName(v.Type()), strings.Join(parts, ""))
+	case reflect.Struct:
+		parts := make([]string, 0, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" || isZero(v.Field(i)) == true {
+				continue
+			}
+
+			parts = append(parts, fmt.Sprintf("%s: %s,\n", field.Name, literal(v.Field(i), true)))
+		}
+
+		prefix := ""
+		if withType == true {
+			prefix = typeName(v.Type())
+		}
+
+		if len(parts) == 0 {
+			return prefix + "{}"
+		}
+
+		return fmt.Sprintf("%s{\n%s}", prefix, strings.Join(parts, ""))
+	}
+
+	log.Panicf("field-type can not be expressed as a literal: [%s]", v.Type())
+
+	// Not reachable.
+	return ""
+}
+
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Slice:
+		return v.IsNil()
+	}
+
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Slice {
+		return "[]" + typeName(t.Elem())
+	} else if t.PkgPath() == typePackagePath {
+		return "xmptype." + t.Name()
+	} else if t.PkgPath() == "" {
+		return t.Name()
+	}
+
+	log.Panicf("type not in the type package: [%s]", t)
+
+	// Not reachable.
+	return ""
+}
+
+// valueType returns the Go type of the values of the given field-type and
+// whether it is an array of them.
+func valueType(ft interface{}) (goType string, isArray bool) {
+	switch t := ft.(type) {
+	case xmptype.LanguageAlternativeArrayFieldType:
+		return "xmptype.LanguageAlternativeArrayValue", false
+	case xmptype.OrderedArrayFieldType:
+		goType, _ := valueType(t.ItemType)
+		return goType, true
+	case xmptype.UnorderedArrayFieldType:
+		goType, _ := valueType(t.ItemType)
+		return goType, true
+	case xmptype.AlternativeArrayFieldType:
+		goType, _ := valueType(t.ItemType)
+		return goType, true
+	}
+
+	t, found := xmptype.LookupValueType(ft)
+	if found == false {
+		return "interface{}", false
+	}
+
+	return typeName(t), false
+}
+
+// generatedField is a single entry of a fields map.
+type generatedField struct {
+	Name        string
+	Literal     string
+	Description string
+}
+
+// generatedScope is the fields of a single struct.
+type generatedScope struct {
+	Name   string
+	Fields []generatedField
+}
+
+// generatedAccessor is the typed accessor of a single top-level property.
+type generatedAccessor struct {
+	Identifier  string
+	Name        string
+	ValueType   string
+	IsArray     bool
+	Description string
+}
+
+// generatedDescription is the description of a single field.
+type generatedDescription struct {
+	Name        string
+	Description string
+}
+
+// generatedNamespace is the data given to the template for one namespace.
+type generatedNamespace struct {
+	Package           string
+	Identifier        string
+	Uri               string
+	Prefix            string
+	Description       string
+	SpecReference     string
+	Fields            []generatedField
+	Scopes            []generatedScope
+	FieldDescriptions []generatedDescription
+	Accessors         []generatedAccessor
+}
+
+var (
+	namespaceTemplate = template.Must(template.New("namespace").Parse(`// Code generated by xmp_gen_namespace. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if .Scopes}}
+	"encoding/xml"
+{{end}}
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// {{.Identifier}}Uri is the '{{.Prefix}}' namespace URI made a constant to support testing.
+	{{.Identifier}}Uri = {{printf "%q" .Uri}}
+)
+
+var (
+	// {{.Identifier}}Namespace is the namespace descriptor for "{{.Prefix}}".
+{{- if .Description}}
+	// {{.Description}}
+{{- end}}
+	{{.Identifier}}Namespace = xmpregistry.Namespace{
+		Uri:             {{.Identifier}}Uri,
+		PreferredPrefix: {{printf "%q" .Prefix}},
+		Fields: map[string]interface{}{
+{{- range .Fields}}
+{{- if .Description}}
+			// {{.Description}}
+{{- end}}
+			{{printf "%q" .Name}}: {{.Literal}},
+{{- end}}
+		},
+{{- if .Scopes}}
+		ScopedFields: map[xml.Name]map[string]interface{}{
+{{- range .Scopes}}
+			{Space: {{$.Identifier}}Uri, Local: {{printf "%q" .Name}}}: {
+{{- range .Fields}}
+{{- if .Description}}
+				// {{.Description}}
+{{- end}}
+				{{printf "%q" .Name}}: {{.Literal}},
+{{- end}}
+			},
+{{- end}}
+		},
+{{- end}}
+{{- if .Description}}
+		Description: {{printf "%q" .Description}},
+{{- end}}
+{{- if .SpecReference}}
+		SpecReference: {{printf "%q" .SpecReference}},
+{{- end}}
+{{- if .FieldDescriptions}}
+		FieldDescriptions: map[string]string{
+{{- range .FieldDescriptions}}
+			{{printf "%q" .Name}}: {{printf "%q" .Description}},
+{{- end}}
+		},
+{{- end}}
+	}
+)
+
+var (
+{{- range .Accessors}}
+	// {{.Identifier}}Name is the name of the "{{$.Prefix}}:{{.Name}}" property.
+	{{.Identifier}}Name = xmpregistry.XmlName{Space: {{$.Identifier}}Uri, Local: {{printf "%q" .Name}}}
+{{end}}
+)
+
+func init() {
+	xmpregistry.Register({{.Identifier}}Namespace)
+}
+{{range .Accessors}}
+// {{.Identifier}} returns the value of the "{{$.Prefix}}:{{.Name}}" property.
+{{- if .Description}}
+// {{.Description}}
+{{- end}}
+{{- if .IsArray}}
+func {{.Identifier}}(pg xmpregistry.PropertyGetter) (values []{{.ValueType}}, err error) {
+	raw, err := pg.GetProperty({{.Identifier}}Name)
+	if err != nil {
+		return nil, err
+	}
+
+	apil, ok := raw.(xmptype.ArrayParsedItemLister)
+	if ok == false {
+		return nil, xmpregistry.ErrPropertyValueNotValid
+	}
+
+	items, err := apil.ParsedItems()
+	if err != nil {
+		return nil, err
+	}
+
+	values = make([]{{.ValueType}}, len(items))
+	for i, item := range items {
+		if item.Err != nil {
+			return nil, item.Err
+		}
+
+		values[i], ok = item.Value.({{.ValueType}})
+		if ok == false {
+			return nil, xmpregistry.ErrPropertyValueNotValid
+		}
+	}
+
+	return values, nil
+}
+{{else}}
+func {{.Identifier}}(pg xmpregistry.PropertyGetter) (value {{.ValueType}}, err error) {
+	raw, err := pg.GetProperty({{.Identifier}}Name)
+	if err != nil {
+		return value, err
+	}
+
+	value, ok := raw.({{.ValueType}})
+	if ok == false {
+		return value, xmpregistry.ErrPropertyValueNotValid
+	}
+
+	return value, nil
+}
+{{end}}
+{{- end}}`))
+)
+
+// singleLine collapses whitespace so that a description can be written as a
+// single comment line.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// addScopes appends the scopes of any struct fields among the given fields,
+// in the order that they are defined.
+func addScopes(gn *generatedNamespace, namespace xmpregistry.Namespace, fields []xmpschema.Field) {
+	for _, field := range fields {
+		if field.Type != xmpschema.TypeStruct {
+			continue
+		}
+
+		parent := xml.Name{
+			Space: namespace.Uri,
+			Local: field.Name,
+		}
+
+		scoped := namespace.ScopedFields[parent]
+		gs := generatedScope{
+			Name:   field.Name,
+			Fields: make([]generatedField, len(field.Fields)),
+		}
+
+		for i, child := range field.Fields {
+			gs.Fields[i] = generatedField{
+				Name:        child.Name,
+				Literal:     goLiteral(scoped[child.Name]),
+				Description: singleLine(child.Description),
+			}
+		}
+
+		gn.Scopes = append(gn.Scopes, gs)
+
+		addScopes(gn, namespace, field.Fields)
+	}
+}
+
+// Generate returns the formatted Go source of a file that registers the given
+// namespace and provides typed accessors of its properties. The namespace
+// must have been built from the given schema namespace.
+func Generate(packageName string, sn xmpschema.Namespace, namespace xmpregistry.Namespace) (source []byte, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	id := identifier(sn.Prefix)
+
+	gn := &generatedNamespace{
+		Package:       packageName,
+		Identifier:    id,
+		Uri:           sn.Uri,
+		Prefix:        sn.Prefix,
+		Description:   singleLine(sn.Description),
+		SpecReference: sn.SpecReference,
+		Fields:        make([]generatedField, len(sn.Fields)),
+		Accessors:     make([]generatedAccessor, len(sn.Fields)),
+	}
+
+	names := make([]string, 0, len(namespace.FieldDescriptions))
+	for name := range namespace.FieldDescriptions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		gd := generatedDescription{
+			Name:        name,
+			Description: namespace.FieldDescriptions[name],
+		}
+
+		gn.FieldDescriptions = append(gn.FieldDescriptions, gd)
+	}
+
+	seen := make(map[string]string)
+
+	for i, field := range sn.Fields {
+		ft := namespace.Fields[field.Name]
+		goType, isArray := valueType(ft)
+
+		gn.Fields[i] = generatedField{
+			Name:        field.Name,
+			Literal:     goLiteral(ft),
+			Description: singleLine(field.Description),
+		}
+
+		accessorId := id + identifier(field.Name)
+		if other, found := seen[accessorId]; found == true {
+			log.Panicf("fields have the same identifier: [%s] [%s] [%s]", other, field.Name, accessorId)
+		}
+
+		seen[accessorId] = field.Name
+
+		gn.Accessors[i] = generatedAccessor{
+			Identifier:  accessorId,
+			Name:        field.Name,
+			ValueType:   goType,
+			IsArray:     isArray,
+			Description: singleLine(field.Description),
+		}
+	}
+
+	addScopes(gn, namespace, sn.Fields)
+
+	b := new(bytes.Buffer)
+
+	err = namespaceTemplate.Execute(b, gn)
+	log.PanicIf(err)
+
+	source, err = format.Source(b.Bytes())
+	if err != nil {
+		log.Panicf("generated source not valid: %s\n%s", err, b.String())
+	}
+
+	return source, nil
+}
+
+// GenerateAll returns the generated source of every namespace in the schema
+// keyed by the file-name that it should be written to ("<prefix>.go").
+func GenerateAll(packageName string, schema *xmpschema.Schema) (files map[string][]byte, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	namespaces, err := schema.Build()
+	log.PanicIf(err)
+
+	files = make(map[string][]byte)
+
+	for i, sn := range schema.Namespaces {
+		source, err := Generate(packageName, sn, namespaces[i])
+		log.PanicIf(err)
+
+		files[sn.Prefix+".go"] = source
+	}
+
+	return files, nil
+}
+
+// sortedFilenames returns the file-names in sorted order.
+func sortedFilenames(files map[string][]byte) []string {
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+
+	sort.Strings(filenames)
+
+	return filenames
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"go/parser"
+	"go/token"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/schema"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+var (
+	testSchemaYaml = `
+namespaces:
+  - uri: http://ns.partner.example.com/1.0/
+    prefix: partner
+    description: Partner metadata.
+    fields:
+      - name: Title
+        type: Text
+        array: lang-alt
+        description: The title.
+      - name: Rating
+        type: Choice
+        closed: true
+        choices:
+          - code: "1"
+            label: Poor
+      - name: Keywords
+        type: Text
+        array: bag
+      - name: Count
+        type: Integer
+      - name: Location
+        type: Struct
+        fields:
+          - name: City
+            type: Text
+`
+)
+
+func TestIdentifier(t *testing.T) {
+	cases := map[string]string{
+		"xmp":         "Xmp",
+		"xmpDM":       "XmpDm",
+		"stRef":       "StRef",
+		"GPSLatitude": "GpsLatitude",
+		"exif_ex":     "ExifEx",
+		"Iptc4xmpExt": "Iptc4xmpExt",
+		"3d":          "X3d",
+	}
+
+	for name, expected := range cases {
+		if actual := identifier(name); actual != expected {
+			t.Fatalf("Identifier not correct: [%s] => [%s] != [%s]", name, actual, expected)
+		}
+	}
+}
+
+func TestGoLiteral(t *testing.T) {
+	literal := goLiteral(xmptype.OrderedArrayFieldType{ItemType: xmptype.TextFieldType{}})
+
+	expected := "xmptype.OrderedArrayFieldType{\nItemType: xmptype.TextFieldType{},\n}"
+	if literal != expected {
+		t.Fatalf("Literal not correct: [%s]", literal)
+	}
+}
+
+func TestValueType(t *testing.T) {
+	goType, isArray := valueType(xmptype.UnorderedArrayFieldType{ItemType: xmptype.DateFieldType{}})
+	if goType != "xmptype.XmpDate" || isArray != true {
+		t.Fatalf("Array value-type not correct: [%s] [%v]", goType, isArray)
+	}
+
+	goType, isArray = valueType(xmptype.LanguageAlternativeArrayFieldType{})
+	if goType != "xmptype.LanguageAlternativeArrayValue" || isArray != false {
+		t.Fatalf("Language-alternatives value-type not correct: [%s] [%v]", goType, isArray)
+	}
+
+	goType, isArray = valueType(xmptype.IntegerFieldType{})
+	if goType != "int64" || isArray != false {
+		t.Fatalf("Scalar value-type not correct: [%s] [%v]", goType, isArray)
+	}
+}
+
+func TestGenerateAll(t *testing.T) {
+	schema, err := xmpschema.ParseYaml(strings.NewReader(testSchemaYaml))
+	log.PanicIf(err)
+
+	files, err := GenerateAll("xmpnamespace", schema)
+	log.PanicIf(err)
+
+	source, found := files["partner.go"]
+	if found == false || len(files) != 1 {
+		t.Fatalf("Files not correct: %v", sortedFilenames(files))
+	}
+
+	_, err = parser.ParseFile(token.NewFileSet(), "partner.go", source, 0)
+	log.PanicIf(err)
+
+	expected := []string{
+		"// Code generated by xmp_gen_namespace. DO NOT EDIT.",
+		"package xmpnamespace",
+		"\"encoding/xml\"",
+		"PartnerUri = \"http://ns.partner.example.com/1.0/\"",
+		"// The title.\n\t\t\t\"Title\": xmptype.LanguageAlternativeArrayFieldType{},",
+		"{Space: PartnerUri, Local: \"Location\"}: {",
+		"Description: \"Partner metadata.\",",
+		"FieldDescriptions: map[string]string{\n\t\t\t\"Title\": \"The title.\",",
+		"PartnerCountName = xmpregistry.XmlName{Space: PartnerUri, Local: \"Count\"}",
+		"xmpregistry.Register(PartnerNamespace)",
+		"func PartnerTitle(pg xmpregistry.PropertyGetter) (value xmptype.LanguageAlternativeArrayValue, err error) {",
+		"func PartnerRating(pg xmpregistry.PropertyGetter) (value xmptype.Choice, err error) {",
+		"func PartnerKeywords(pg xmpregistry.PropertyGetter) (values []string, err error) {",
+		"func PartnerCount(pg xmpregistry.PropertyGetter) (value int64, err error) {",
+		"func PartnerLocation(pg xmpregistry.PropertyGetter) (value xmptype.StructValue, err error) {",
+	}
+
+	for _, fragment := range expected {
+		if strings.Contains(string(source), fragment) == false {
+			t.Fatalf("Generated source does not contain [%s]:\n%s", fragment, source)
+		}
+	}
+}
+
+func TestGenerate_NoScopes(t *testing.T) {
+	schema := &xmpschema.Schema{
+		Namespaces: []xmpschema.Namespace{
+			{
+				Uri:    "http://ns.example.com/simple/",
+				Prefix: "simple",
+				Fields: []xmpschema.Field{
+					{Name: "Label", Type: "Text"},
+				},
+			},
+		},
+	}
+
+	files, err := GenerateAll("other", schema)
+	log.PanicIf(err)
+
+	source := string(files["simple.go"])
+
+	if strings.Contains(source, "encoding/xml") == true || strings.Contains(source, "ScopedFields") == true {
+		t.Fatalf("Scopes not expected:\n%s", source)
+	} else if strings.Contains(source, "package other") == false {
+		t.Fatalf("Package not correct:\n%s", source)
+	}
+}
+
+func TestGenerate_IdentifierCollision(t *testing.T) {
+	schema := &xmpschema.Schema{
+		Namespaces: []xmpschema.Namespace{
+			{
+				Uri:    "http://ns.example.com/simple/",
+				Prefix: "simple",
+				Fields: []xmpschema.Field{
+					{Name: "the_label", Type: "Text"},
+					{Name: "theLabel", Type: "Text"},
+				},
+			},
+		},
+	}
+
+	_, err := GenerateAll("xmpnamespace", schema)
+	if err == nil || strings.Contains(err.Error(), "fields have the same identifier") == false {
+		t.Fatalf("Expected collision error: [%v]", err)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"io/ioutil"
+
+	"github.com/dsoprea/go-logging"
+	"github.com/jessevdk/go-flags"
+
+	"github.com/dsoprea/go-xmp/schema"
+)
+
+var (
+	mainLogger = log.NewLogger("main.main")
+)
+
+type parameters struct {
+	Filepath    string `short:"f" long:"filepath" required:"true" description:"File-path of a JSON or YAML schema, or of an XMP document with PDF/A extension schemas (.xmp, .rdf, or .xml)"`
+	OutputPath  string `short:"o" long:"output-path" default:"." description:"Directory to write the generated files to (one per namespace, named for its prefix)"`
+	PackageName string `short:"p" long:"package" default:"xmpnamespace" description:"Package of the generated files"`
+	IsVerbose   bool   `short:"v" long:"verbose" description:"Print logging"`
+}
+
+var (
+	arguments = new(parameters)
+)
+
+// readSchema reads the schema from either a schema file or an RDF document.
+func readSchema(filepath string) (schema *xmpschema.Schema, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	switch strings.ToLower(path.Ext(filepath)) {
+	case ".xmp", ".rdf", ".xml":
+		f, err := os.Open(filepath)
+		log.PanicIf(err)
+
+		defer f.Close()
+
+		schema, err = ParseRdfSchema(f)
+		log.PanicIf(err)
+
+		return schema, nil
+	}
+
+	schema, err = xmpschema.ParseFile(filepath)
+	log.PanicIf(err)
+
+	return schema, nil
+}
+
+func main() {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err := errRaw.(error)
+			log.PrintError(err)
+
+			os.Exit(-2)
+		}
+	}()
+
+	_, err := flags.Parse(arguments)
+	if err != nil {
+		os.Exit(-1)
+	}
+
+	if arguments.IsVerbose == true {
+		cla := log.NewConsoleLogAdapter()
+		log.AddAdapter("console", cla)
+
+		scp := log.NewStaticConfigurationProvider()
+		scp.SetLevelName(log.LevelNameDebug)
+
+		log.LoadConfiguration(scp)
+	}
+
+	schema, err := readSchema(arguments.Filepath)
+	log.PanicIf(err)
+
+	files, err := GenerateAll(arguments.PackageName, schema)
+	log.PanicIf(err)
+
+	for _, filename := range sortedFilenames(files) {
+		filepath := path.Join(arguments.OutputPath, filename)
+
+		err := ioutil.WriteFile(filepath, files[filename], 0644)
+		log.PanicIf(err)
+
+		fmt.Println(filepath)
+	}
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/schema"
+)
+
+const (
+	// rdfUri is the RDF syntax namespace URI.
+	rdfUri = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+
+	// PdfaExtensionUri is the namespace of the container of extension
+	// schemas.
+	PdfaExtensionUri = "http://www.aiim.org/pdfa/ns/extension/"
+
+	// PdfaSchemaUri is the namespace of the fields of a single extension
+	// schema.
+	PdfaSchemaUri = "http://www.aiim.org/pdfa/ns/schema#"
+
+	// PdfaPropertyUri is the namespace of the fields of a single property of
+	// an extension schema.
+	PdfaPropertyUri = "http://www.aiim.org/pdfa/ns/property#"
+
+	// PdfaTypeUri is the namespace of the fields of a single custom value-
+	// type of an extension schema.
+	PdfaTypeUri = "http://www.aiim.org/pdfa/ns/type#"
+
+	// PdfaFieldUri is the namespace of the fields of a single field of a
+	// custom value-type.
+	PdfaFieldUri = "http://www.aiim.org/pdfa/ns/field#"
+)
+
+const (
+	// langAltValueType is the value-type of language alternatives.
+	langAltValueType = "Lang Alt"
+
+	// maximumStructDepth is the deepest that custom value-types may be nested
+	// in one another. This stops types that refer to themselves.
+	maximumStructDepth = 10
+)
+
+var (
+	// arrayValueTypePrefixes map the prefix of an array value-type to the
+	// kind of the array.
+	arrayValueTypePrefixes = map[string]string{
+		"bag ": xmpschema.ArrayBag,
+		"seq ": xmpschema.ArraySeq,
+		"alt ": xmpschema.ArrayAlt,
+	}
+
+	// choiceValueTypePrefixes are the prefixes of choice value-types. The
+	// choices themselves are not described by the schema, so only the type
+	// of the value is kept.
+	choiceValueTypePrefixes = []string{
+		"Open Choice of ",
+		"Closed Choice of ",
+	}
+)
+
+// rdfNode is a single node of a generic XML tree.
+type rdfNode struct {
+	name       xml.Name
+	attributes []xml.Attr
+	text       string
+	children   []*rdfNode
+}
+
+// attribute returns the value of the given attribute.
+func (node *rdfNode) attribute(space, local string) (value string, found bool) {
+	for _, attribute := range node.attributes {
+		if attribute.Name.Space == space && attribute.Name.Local == local {
+			return attribute.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// resource returns the node that holds the properties of a resource
+// described by this node: either this node (with `rdf:parseType="Resource"`
+// or only attributes) or a single nested `rdf:Description`.
+func (node *rdfNode) resource() *rdfNode {
+	if len(node.children) == 1 && node.children[0].name.Space == rdfUri && node.children[0].name.Local == "Description" {
+		return node.children[0]
+	}
+
+	return node
+}
+
+// property returns the given property of the resource described by this node,
+// as either an attribute or a child node. The child node is returned if the
+// property is not simple.
+func (node *rdfNode) property(space, local string) (text string, child *rdfNode, found bool) {
+	resource := node.resource()
+
+	if value, found := resource.attribute(space, local); found == true {
+		return value, nil, true
+	}
+
+	for _, child := range resource.children {
+		if child.name.Space == space && child.name.Local == local {
+			return strings.TrimSpace(child.text), child, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// items returns the items of the array that is the value of this node.
+func (node *rdfNode) items() []*rdfNode {
+	items := make([]*rdfNode, 0)
+
+	for _, container := range node.children {
+		if container.name.Space != rdfUri {
+			continue
+		}
+
+		for _, item := range container.children {
+			if item.name.Space == rdfUri && item.name.Local == "li" {
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items
+}
+
+// find returns every node in the tree with the given name in document order.
+func (node *rdfNode) find(space, local string) []*rdfNode {
+	matches := make([]*rdfNode, 0)
+
+	if node.name.Space == space && node.name.Local == local {
+		matches = append(matches, node)
+	}
+
+	for _, child := range node.children {
+		matches = append(matches, child.find(space, local)...)
+	}
+
+	return matches
+}
+
+// parseRdfTree decodes the whole document into a tree of nodes.
+func parseRdfTree(r io.Reader) (root *rdfNode, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	decoder := xml.NewDecoder(r)
+
+	root = new(rdfNode)
+	stack := []*rdfNode{root}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+
+		log.PanicIf(err)
+
+		current := stack[len(stack)-1]
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &rdfNode{
+				name:       t.Name,
+				attributes: t.Attr,
+			}
+
+			current.children = append(current.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			current.text += string(t)
+		}
+	}
+
+	return root, nil
+}
+
+// rdfSchemaConverter converts PDF/A extension schemas to a schema.
+type rdfSchemaConverter struct {
+	// types are the custom value-types of the schema being converted, by
+	// name.
+	types map[string]*rdfNode
+}
+
+// field returns the field described by the given property (or custom
+// value-type field) node. `space` is the namespace of the node's fields.
+func (rsc *rdfSchemaConverter) field(entry string, namespaceUri string, node *rdfNode, space string, depth int) xmpschema.Field {
+	name, _, _ := node.property(space, "name")
+	if name == "" {
+		entryPanicf(entry, "name not given")
+	}
+
+	entry = fmt.Sprintf("%s(%s)", entry, name)
+
+	valueType, _, _ := node.property(space, "valueType")
+	if valueType == "" {
+		entryPanicf(entry, "value-type not given")
+	}
+
+	description, _, _ := node.property(space, "description")
+
+	field := xmpschema.Field{
+		Name:        name,
+		Description: description,
+	}
+
+	if valueType == langAltValueType {
+		field.Type = "Text"
+		field.Array = xmpschema.ArrayLangAlt
+
+		return field
+	}
+
+	for prefix, array := range arrayValueTypePrefixes {
+		if strings.HasPrefix(valueType, prefix) == true {
+			field.Array = array
+			valueType = valueType[len(prefix):]
+
+			break
+		}
+	}
+
+	for _, prefix := range choiceValueTypePrefixes {
+		if strings.HasPrefix(valueType, prefix) == true {
+			valueType = valueType[len(prefix):]
+			break
+		}
+	}
+
+	typeNode, found := rsc.types[valueType]
+	if found == false {
+		// Either a core type or one that the schema will not accept.
+		field.Type = valueType
+		return field
+	}
+
+	if depth >= maximumStructDepth {
+		entryPanicf(entry, "value-types nested too deeply: [%s]", valueType)
+	}
+
+	typeUri, _, _ := typeNode.property(PdfaTypeUri, "namespaceURI")
+	if typeUri != namespaceUri {
+		entryPanicf(entry, "fields of value-type not in the namespace of the schema: [%s] [%s]", valueType, typeUri)
+	}
+
+	_, fieldsNode, _ := typeNode.property(PdfaTypeUri, "field")
+	if fieldsNode == nil {
+		entryPanicf(entry, "no fields given for value-type: [%s]", valueType)
+	}
+
+	field.Type = xmpschema.TypeStruct
+
+	for i, item := range fieldsNode.items() {
+		childEntry := fmt.Sprintf("%s.field[%d]", entry, i)
+		child := rsc.field(childEntry, namespaceUri, item, PdfaFieldUri, depth+1)
+
+		field.Fields = append(field.Fields, child)
+	}
+
+	return field
+}
+
+// namespace returns the namespace described by a single extension schema.
+func (rsc *rdfSchemaConverter) namespace(entry string, node *rdfNode) xmpschema.Namespace {
+	uri, _, _ := node.property(PdfaSchemaUri, "namespaceURI")
+	prefix, _, _ := node.property(PdfaSchemaUri, "prefix")
+	description, _, _ := node.property(PdfaSchemaUri, "schema")
+
+	if uri == "" {
+		entryPanicf(entry, "namespace URI not given")
+	} else if prefix == "" {
+		entryPanicf(entry, "prefix not given")
+	}
+
+	entry = fmt.Sprintf("%s(%s)", entry, prefix)
+
+	rsc.types = make(map[string]*rdfNode)
+
+	if _, typesNode, _ := node.property(PdfaSchemaUri, "valueType"); typesNode != nil {
+		for i, item := range typesNode.items() {
+			name, _, _ := item.property(PdfaTypeUri, "type")
+			if name == "" {
+				entryPanicf(fmt.Sprintf("%s.valueType[%d]", entry, i), "type not given")
+			}
+
+			rsc.types[name] = item
+		}
+	}
+
+	sn := xmpschema.Namespace{
+		Uri:         uri,
+		Prefix:      prefix,
+		Description: description,
+		Fields:      make([]xmpschema.Field, 0),
+	}
+
+	if _, propertiesNode, _ := node.property(PdfaSchemaUri, "property"); propertiesNode != nil {
+		for i, item := range propertiesNode.items() {
+			fieldEntry := fmt.Sprintf("%s.property[%d]", entry, i)
+			field := rsc.field(fieldEntry, uri, item, PdfaPropertyUri, 0)
+
+			sn.Fields = append(sn.Fields, field)
+		}
+	}
+
+	return sn
+}
+
+// entryPanicf panics with a message that identifies the schema entry that is
+// not valid.
+func entryPanicf(entry string, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Panicf("schema entry not valid: [%s]: %s", entry, message)
+}
+
+// ParseRdfSchema reads the PDF/A extension schemas (`pdfaExtension:schemas`)
+// of an XMP document and returns them as a schema.
+func ParseRdfSchema(r io.Reader) (schema *xmpschema.Schema, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	root, err := parseRdfTree(r)
+	log.PanicIf(err)
+
+	schema = new(xmpschema.Schema)
+	rsc := new(rdfSchemaConverter)
+
+	i := 0
+	for _, schemasNode := range root.find(PdfaExtensionUri, "schemas") {
+		for _, item := range schemasNode.items() {
+			entry := fmt.Sprintf("schemas[%d]", i)
+			sn := rsc.namespace(entry, item)
+
+			schema.Namespaces = append(schema.Namespaces, sn)
+			i++
+		}
+	}
+
+	if len(schema.Namespaces) == 0 {
+		log.Panicf("no extension schemas found")
+	}
+
+	return schema, nil
+}
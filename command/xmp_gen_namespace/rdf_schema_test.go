@@ -0,0 +1,130 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/schema"
+)
+
+var (
+	testRdfSchema = `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+<rdf:Description rdf:about=""
+	xmlns:pdfaExtension="http://www.aiim.org/pdfa/ns/extension/"
+	xmlns:pdfaSchema="http://www.aiim.org/pdfa/ns/schema#"
+	xmlns:pdfaProperty="http://www.aiim.org/pdfa/ns/property#"
+	xmlns:pdfaType="http://www.aiim.org/pdfa/ns/type#"
+	xmlns:pdfaField="http://www.aiim.org/pdfa/ns/field#">
+	<pdfaExtension:schemas>
+		<rdf:Bag>
+			<rdf:li rdf:parseType="Resource">
+				<pdfaSchema:schema>Partner metadata</pdfaSchema:schema>
+				<pdfaSchema:namespaceURI>http://ns.partner.example.com/1.0/</pdfaSchema:namespaceURI>
+				<pdfaSchema:prefix>partner</pdfaSchema:prefix>
+				<pdfaSchema:property>
+					<rdf:Seq>
+						<rdf:li rdf:parseType="Resource">
+							<pdfaProperty:name>Title</pdfaProperty:name>
+							<pdfaProperty:valueType>Lang Alt</pdfaProperty:valueType>
+							<pdfaProperty:category>external</pdfaProperty:category>
+							<pdfaProperty:description>The title</pdfaProperty:description>
+						</rdf:li>
+						<rdf:li>
+							<rdf:Description pdfaProperty:name="Keywords" pdfaProperty:valueType="bag Text" />
+						</rdf:li>
+						<rdf:li rdf:parseType="Resource">
+							<pdfaProperty:name>Status</pdfaProperty:name>
+							<pdfaProperty:valueType>Closed Choice of Integer</pdfaProperty:valueType>
+						</rdf:li>
+						<rdf:li rdf:parseType="Resource">
+							<pdfaProperty:name>Locations</pdfaProperty:name>
+							<pdfaProperty:valueType>seq Location</pdfaProperty:valueType>
+						</rdf:li>
+					</rdf:Seq>
+				</pdfaSchema:property>
+				<pdfaSchema:valueType>
+					<rdf:Seq>
+						<rdf:li rdf:parseType="Resource">
+							<pdfaType:type>Location</pdfaType:type>
+							<pdfaType:namespaceURI>http://ns.partner.example.com/1.0/</pdfaType:namespaceURI>
+							<pdfaType:prefix>partner</pdfaType:prefix>
+							<pdfaType:field>
+								<rdf:Seq>
+									<rdf:li rdf:parseType="Resource">
+										<pdfaField:name>City</pdfaField:name>
+										<pdfaField:valueType>Text</pdfaField:valueType>
+										<pdfaField:description>The city</pdfaField:description>
+									</rdf:li>
+								</rdf:Seq>
+							</pdfaType:field>
+						</rdf:li>
+					</rdf:Seq>
+				</pdfaSchema:valueType>
+			</rdf:li>
+		</rdf:Bag>
+	</pdfaExtension:schemas>
+</rdf:Description>
+</rdf:RDF>
+</x:xmpmeta>`
+)
+
+func TestParseRdfSchema(t *testing.T) {
+	schema, err := ParseRdfSchema(strings.NewReader(testRdfSchema))
+	log.PanicIf(err)
+
+	expected := &xmpschema.Schema{
+		Namespaces: []xmpschema.Namespace{
+			{
+				Uri:         "http://ns.partner.example.com/1.0/",
+				Prefix:      "partner",
+				Description: "Partner metadata",
+				Fields: []xmpschema.Field{
+					{Name: "Title", Type: "Text", Array: xmpschema.ArrayLangAlt, Description: "The title"},
+					{Name: "Keywords", Type: "Text", Array: xmpschema.ArrayBag},
+					{Name: "Status", Type: "Integer"},
+					{
+						Name:  "Locations",
+						Type:  xmpschema.TypeStruct,
+						Array: xmpschema.ArraySeq,
+						Fields: []xmpschema.Field{
+							{Name: "City", Type: "Text", Description: "The city"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if reflect.DeepEqual(schema, expected) != true {
+		t.Fatalf("Schema not correct:\nACTUAL: %v\nEXPECTED: %v", schema, expected)
+	}
+
+	_, err = schema.Build()
+	log.PanicIf(err)
+}
+
+func TestParseRdfSchema_ForeignValueType(t *testing.T) {
+	document := strings.Replace(
+		testRdfSchema,
+		"<pdfaType:namespaceURI>http://ns.partner.example.com/1.0/</pdfaType:namespaceURI>",
+		"<pdfaType:namespaceURI>http://ns.other.example.com/</pdfaType:namespaceURI>",
+		1)
+
+	_, err := ParseRdfSchema(strings.NewReader(document))
+	if err == nil {
+		t.Fatalf("Expected error for value-type in another namespace.")
+	} else if strings.Contains(err.Error(), "[schemas[0](partner).property[3](Locations)]") == false {
+		t.Fatalf("Error does not identify the entry: [%s]", err)
+	}
+}
+
+func TestParseRdfSchema_NoSchemas(t *testing.T) {
+	_, err := ParseRdfSchema(strings.NewReader(`<x:xmpmeta xmlns:x="adobe:ns:meta/" />`))
+	if err == nil || err.Error() != "no extension schemas found" {
+		t.Fatalf("Expected no-schemas error: [%v]", err)
+	}
+}
@@ -1,12 +1,17 @@
 package xmp
 
 import (
+	"bytes"
 	"os"
 	"path"
 
 	"io/ioutil"
 
 	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
 )
 
 var (
@@ -83,3 +88,89 @@ func GetTestData() []byte {
 
 	return data
 }
+
+// getTestDocument wraps the given properties in a complete XMP packet.
+func getTestDocument(properties string) string {
+	return `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+        xmlns:dc="http://purl.org/dc/elements/1.1/"
+        xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+        xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/"
+        xmlns:stEvt="http://ns.adobe.com/xap/1.0/sType/ResourceEvent#"
+        xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#"
+        xmlns:stVer="http://ns.adobe.com/xap/1.0/sType/Version#">
+` + properties + `
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>
+<?xpacket end="w"?>`
+}
+
+// parseTestDocument parses the given properties as a complete XMP packet.
+func parseTestDocument(properties string) *XmpPropertyIndex {
+	document := getTestDocument(properties)
+	b := bytes.NewBufferString(document)
+
+	xp := NewParser(b)
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	return xpi
+}
+
+func parseTestDocumentWithRegistry(registry *xmpregistry.Registry, properties string) *XmpPropertyIndex {
+	document := getTestDocument(properties)
+	b := bytes.NewBufferString(document)
+
+	xp := NewParserWithRegistry(b, registry)
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	return xpi
+}
+
+// getTestTenantRegistry returns a new registry having the namespaces used by
+// getTestDocument as well as a namespace that is unknown to the default
+// registry.
+func getTestTenantRegistry() *xmpregistry.Registry {
+	registry := xmpregistry.NewRegistry()
+
+	registry.Register(xmpnamespace.XNamespace)
+	registry.Register(xmpnamespace.RdfNamespace)
+	registry.Register(xmpnamespace.XmlNamespace)
+	registry.Register(xmpnamespace.DcNamespace)
+	registry.Register(xmpnamespace.XmpNamespace)
+
+	tenantNamespace := xmpregistry.Namespace{
+		Uri:             "http://some/uri/tenant/",
+		PreferredPrefix: "zz",
+		Fields: map[string]interface{}{
+			"Count": xmptype.IntegerFieldType{},
+		},
+	}
+
+	err := registry.Register(tenantNamespace)
+	log.PanicIf(err)
+
+	return registry
+}
+
+// registerTestDocumentNamespaces registers the namespaces that are used by
+// getTestDocument.
+func registerTestDocumentNamespaces() {
+	xmpregistry.Clear()
+
+	xmpregistry.Register(xmpnamespace.XNamespace)
+	xmpregistry.Register(xmpnamespace.RdfNamespace)
+	xmpregistry.Register(xmpnamespace.XmlNamespace)
+	xmpregistry.Register(xmpnamespace.DcNamespace)
+	xmpregistry.Register(xmpnamespace.XmpNamespace)
+	xmpregistry.Register(xmpnamespace.XmpMmNamespace)
+	xmpregistry.Register(xmpnamespace.StEvtNamespace)
+	xmpregistry.Register(xmpnamespace.StRefNamespace)
+	xmpregistry.Register(xmpnamespace.StVerNamespace)
+}
@@ -0,0 +1,625 @@
+package xmp
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+// DiffOperation describes how a property differs between two indices.
+type DiffOperation int
+
+const (
+	// DiffAdd indicates a property found only in the new index.
+	DiffAdd DiffOperation = iota
+
+	// DiffRemove indicates a property found only in the old index.
+	DiffRemove
+
+	// DiffReplace indicates a property found in both indices with different
+	// values.
+	DiffReplace
+)
+
+// String returns the name of the operation as used in JSON Patch.
+func (operation DiffOperation) String() string {
+	switch operation {
+	case DiffAdd:
+		return "add"
+	case DiffRemove:
+		return "remove"
+	case DiffReplace:
+		return "replace"
+	}
+
+	return fmt.Sprintf("DiffOperation<%d>", int(operation))
+}
+
+// symbol returns the single character used for the operation in the text
+// output.
+func (operation DiffOperation) symbol() string {
+	switch operation {
+	case DiffAdd:
+		return "+"
+	case DiffRemove:
+		return "-"
+	}
+
+	return "~"
+}
+
+// DiffOptions controls how values are compared.
+type DiffOptions struct {
+	// IgnoreTimezone considers two dates describing the same instant to be
+	// equivalent even if they were expressed in different timezones.
+	IgnoreTimezone bool
+}
+
+// DiffChange describes a single difference.
+type DiffChange struct {
+	// Operation is the kind of difference.
+	Operation DiffOperation
+
+	// Path is the name of the property.
+	Path xmpregistry.XmpPropertyName
+
+	// Occurrence is the position of the value among all of the values stored
+	// under the same path. It is almost always zero.
+	Occurrence int
+
+	// OldValue is the exported (simplified) value from the old index or nil if
+	// added.
+	OldValue interface{}
+
+	// NewValue is the exported (simplified) value from the new index or nil if
+	// removed.
+	NewValue interface{}
+
+	// registry provides the prefixes used to render the path. The default
+	// registry is used if nil.
+	registry *xmpregistry.Registry
+}
+
+// namePhrase renders one constituent name of the path.
+func (dc DiffChange) namePhrase(name xmpregistry.XmlName) string {
+	if dc.registry == nil {
+		return name.String()
+	}
+
+	return dc.registry.NamePhrase(name)
+}
+
+// pathPhrase renders the path.
+func (dc DiffChange) pathPhrase() string {
+	if dc.registry == nil {
+		return dc.Path.String()
+	}
+
+	return dc.registry.PropertyNamePhrase(dc.Path)
+}
+
+// Pointer returns the path as a JSON-Pointer-like string. The occurrence is
+// only appended if not zero.
+func (dc DiffChange) Pointer() string {
+	parts := make([]string, len(dc.Path))
+	for i, name := range dc.Path {
+		parts[i] = strings.Replace(strings.Replace(dc.namePhrase(name), "~", "~0", -1), "/", "~1", -1)
+	}
+
+	pointer := "/" + strings.Join(parts, "/")
+
+	if dc.Occurrence != 0 {
+		pointer = fmt.Sprintf("%s/%d", pointer, dc.Occurrence)
+	}
+
+	return pointer
+}
+
+// diffChangeJson is the encoded form of a DiffChange.
+type diffChangeJson struct {
+	Operation string      `json:"op"`
+	Path      string      `json:"path"`
+	Value     interface{} `json:"value,omitempty"`
+	Previous  interface{} `json:"previous,omitempty"`
+}
+
+// MarshalJSON encodes the change as a JSON Patch operation. The old value of
+// removed and replaced properties is included as "previous" so that the
+// change can be reviewed without the original document.
+func (dc DiffChange) MarshalJSON() (encoded []byte, err error) {
+	dcj := diffChangeJson{
+		Operation: dc.Operation.String(),
+		Path:      dc.Pointer(),
+		Value:     dc.NewValue,
+		Previous:  dc.OldValue,
+	}
+
+	return json.Marshal(dcj)
+}
+
+// String returns a single line of human-readable text describing the change.
+func (dc DiffChange) String() string {
+	switch dc.Operation {
+	case DiffAdd:
+		return fmt.Sprintf("%s %s: %s", dc.Operation.symbol(), dc.textPath(), diffValueText(dc.NewValue))
+	case DiffRemove:
+		return fmt.Sprintf("%s %s: %s", dc.Operation.symbol(), dc.textPath(), diffValueText(dc.OldValue))
+	}
+
+	return fmt.Sprintf("%s %s: %s -> %s", dc.Operation.symbol(), dc.textPath(), diffValueText(dc.OldValue), diffValueText(dc.NewValue))
+}
+
+func (dc DiffChange) textPath() string {
+	if dc.Occurrence != 0 {
+		return fmt.Sprintf("%s(%d)", dc.pathPhrase(), dc.Occurrence)
+	}
+
+	return dc.pathPhrase()
+}
+
+// diffValueText renders an exported value for the text output. JSON is used
+// so that strings are quoted and arrays and attributes are legible.
+func diffValueText(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(encoded)
+}
+
+// DiffReport lists all differences between two indices.
+type DiffReport struct {
+	// Changes are the differences sorted by path.
+	Changes []DiffChange
+}
+
+// Text returns the changes as human-readable text, one per line.
+func (dr *DiffReport) Text() string {
+	b := new(bytes.Buffer)
+
+	for _, dc := range dr.Changes {
+		fmt.Fprintln(b, dc.String())
+	}
+
+	return b.String()
+}
+
+// MarshalJSON encodes the report as a list of JSON Patch operations.
+func (dr *DiffReport) MarshalJSON() (encoded []byte, err error) {
+	changes := dr.Changes
+	if changes == nil {
+		changes = make([]DiffChange, 0)
+	}
+
+	return json.Marshal(changes)
+}
+
+type differ struct {
+	options *DiffOptions
+	report  *DiffReport
+
+	// registry is used to order and render names. It is the registry of the
+	// new index.
+	registry *xmpregistry.Registry
+}
+
+// scalarsEqual compares two parsed scalar values. Dates are compared by
+// instant, precision, and, unless disabled, by timezone offset.
+func (d *differ) scalarsEqual(a, b interface{}) bool {
+	if aDate, ok := a.(xmptype.XmpDate); ok == true {
+		bDate, ok := b.(xmptype.XmpDate)
+		if ok == false {
+			return false
+		}
+
+		if aDate.Equal(bDate) == false {
+			return false
+		}
+
+		a = aDate.Time
+		b = bDate.Time
+	}
+
+	if aTime, ok := a.(time.Time); ok == true {
+		bTime, ok := b.(time.Time)
+		if ok == false {
+			return false
+		}
+
+		if aTime.Equal(bTime) == false {
+			return false
+		} else if d.options.IgnoreTimezone == true {
+			return true
+		}
+
+		_, aOffset := aTime.Zone()
+		_, bOffset := bTime.Zone()
+
+		return aOffset == bOffset
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// itemsEqual compares array items. Items of unordered arrays (Bags) may be in
+// any order.
+func (d *differ) itemsEqual(a, b []xmptype.ParsedArrayItem, isOrdered bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	if isOrdered == true {
+		for i := range a {
+			if d.itemEqual(a[i], b[i]) == false {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	matched := make([]bool, len(b))
+
+	for _, aItem := range a {
+		found := false
+		for j, bItem := range b {
+			if matched[j] == false && d.itemEqual(aItem, bItem) == true {
+				matched[j] = true
+				found = true
+
+				break
+			}
+		}
+
+		if found == false {
+			return false
+		}
+	}
+
+	return true
+}
+
+// itemEqual compares two array items. Char-data that differs is compared by
+// the values that the item-type of the array parses it to.
+func (d *differ) itemEqual(a, b xmptype.ParsedArrayItem) bool {
+	if a.Name != b.Name ||
+		reflect.DeepEqual(a.Qualifiers, b.Qualifiers) == false ||
+		d.fieldsEqual(a.Attributes, b.Attributes) == false {
+		return false
+	}
+
+	if a.CharData == b.CharData {
+		return true
+	} else if a.Err != nil || b.Err != nil {
+		return false
+	}
+
+	return d.scalarsEqual(a.Value, b.Value)
+}
+
+// fieldsEqual compares the attributes of two array items or nested structs.
+// The values were parsed with the types of their fields when indexed. Nested
+// arrays are compared by their items rather than by how they were written.
+func (d *differ) fieldsEqual(a, b map[xml.Name]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for name, aValue := range a {
+		bValue, found := b[name]
+		if found == false {
+			return false
+		}
+
+		switch aTyped := aValue.(type) {
+		case xmptype.StructValue:
+			bTyped, ok := bValue.(xmptype.StructValue)
+			if ok == false || d.fieldsEqual(aTyped, bTyped) == false {
+				return false
+			}
+		case xmptype.ArrayValue:
+			bTyped, ok := bValue.(xmptype.ArrayValue)
+			if ok == false || d.arraysEqual(aTyped, bTyped) == false {
+				return false
+			}
+		default:
+			if d.scalarsEqual(aValue, bValue) == false {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// parsedItems returns the items of the array along with their parsed values.
+// If the array does not declare an item-type, the items are returned with
+// ErrArrayItemTypeNotDeclared and are only compared by their char-data.
+func parsedItems(av xmptype.ArrayValue) (items []xmptype.ParsedArrayItem, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if apil, ok := av.(xmptype.ArrayParsedItemLister); ok == true {
+		items, err := apil.ParsedItems()
+		if err == nil {
+			return items, nil
+		} else if err != xmptype.ErrArrayItemTypeNotDeclared {
+			log.Panic(err)
+		}
+	}
+
+	rawItems, err := av.(xmptype.ArrayItemLister).Items()
+	log.PanicIf(err)
+
+	items = make([]xmptype.ParsedArrayItem, len(rawItems))
+	for i, ai := range rawItems {
+		items[i] = xmptype.ParsedArrayItem{
+			ArrayItem: ai,
+			Err:       xmptype.ErrArrayItemTypeNotDeclared,
+		}
+	}
+
+	return items, nil
+}
+
+// arraysEqual compares two arrays by their kind and items.
+func (d *differ) arraysEqual(a, b xmptype.ArrayValue) bool {
+	aContainerName := a.ContainerName()
+	if aContainerName != b.ContainerName() {
+		return false
+	}
+
+	_, aOk := a.(xmptype.ArrayItemLister)
+	_, bOk := b.(xmptype.ArrayItemLister)
+
+	if aOk == false || bOk == false {
+		return reflect.DeepEqual(a, b)
+	}
+
+	aItems, err := parsedItems(a)
+	log.PanicIf(err)
+
+	bItems, err := parsedItems(b)
+	log.PanicIf(err)
+
+	isOrdered := aContainerName.Space != xmpnamespace.RdfUri || aContainerName.Local != "Bag"
+
+	return d.itemsEqual(aItems, bItems, isOrdered)
+}
+
+// valuesEqual compares two values stored under the same leaf.
+func (d *differ) valuesEqual(a, b interface{}) (equal bool, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if aSln, ok := a.(ScalarLeafNode); ok == true {
+		bSln, ok := b.(ScalarLeafNode)
+		if ok == false {
+			return false, nil
+		}
+
+		if reflect.DeepEqual(aSln.Qualifiers, bSln.Qualifiers) == false {
+			return false, nil
+		}
+
+		return d.scalarsEqual(aSln.ParsedValue, bSln.ParsedValue), nil
+	}
+
+	if aAv, ok := a.(xmptype.ArrayValue); ok == true {
+		bAv, ok := b.(xmptype.ArrayValue)
+		if ok == false {
+			return false, nil
+		}
+
+		return d.arraysEqual(aAv, bAv), nil
+	}
+
+	if aCln, ok := a.(ComplexLeafNode); ok == true {
+		bCln, ok := b.(ComplexLeafNode)
+		if ok == false {
+			return false, nil
+		}
+
+		return d.fieldsEqual(aCln, bCln), nil
+	}
+
+	return reflect.DeepEqual(a, b), nil
+}
+
+// exportedValue returns the simplified, exported form of a leaf value.
+func exportedValue(xpi *XmpPropertyIndex, value interface{}) (exported interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	exported, err = xpi.exportValue(value, true)
+	log.PanicIf(err)
+
+	return exported, nil
+}
+
+func (d *differ) addChange(operation DiffOperation, path xmpregistry.XmpPropertyName, occurrence int, oldIndex *XmpPropertyIndex, oldValue interface{}, newIndex *XmpPropertyIndex, newValue interface{}) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	dc := DiffChange{
+		Operation:  operation,
+		Path:       path,
+		Occurrence: occurrence,
+		registry:   d.registry,
+	}
+
+	if operation != DiffAdd {
+		dc.OldValue, err = exportedValue(oldIndex, oldValue)
+		log.PanicIf(err)
+	}
+
+	if operation != DiffRemove {
+		dc.NewValue, err = exportedValue(newIndex, newValue)
+		log.PanicIf(err)
+	}
+
+	d.report.Changes = append(d.report.Changes, dc)
+
+	return nil
+}
+
+func (d *differ) diffLeaf(path xmpregistry.XmpPropertyName, oldIndex *XmpPropertyIndex, oldValues []interface{}, newIndex *XmpPropertyIndex, newValues []interface{}) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	count := len(oldValues)
+	if len(newValues) > count {
+		count = len(newValues)
+	}
+
+	for i := 0; i < count; i++ {
+		if i >= len(newValues) {
+			err := d.addChange(DiffRemove, path, i, oldIndex, oldValues[i], nil, nil)
+			log.PanicIf(err)
+
+			continue
+		} else if i >= len(oldValues) {
+			err := d.addChange(DiffAdd, path, i, nil, nil, newIndex, newValues[i])
+			log.PanicIf(err)
+
+			continue
+		}
+
+		equal, err := d.valuesEqual(oldValues[i], newValues[i])
+		log.PanicIf(err)
+
+		if equal == false {
+			err := d.addChange(DiffReplace, path, i, oldIndex, oldValues[i], newIndex, newValues[i])
+			log.PanicIf(err)
+		}
+	}
+
+	return nil
+}
+
+// diff compares two subindices. Either may be nil if the struct only exists
+// on one side.
+func (d *differ) diff(path xmpregistry.XmpPropertyName, oldIndex, newIndex *XmpPropertyIndex) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	entries := make([]indexEntry, 0)
+	seen := make(map[indexEntry]bool)
+
+	for _, xpi := range []*XmpPropertyIndex{oldIndex, newIndex} {
+		if xpi == nil {
+			continue
+		}
+
+		for _, entry := range xpi.entries {
+			if seen[entry] == true {
+				continue
+			}
+
+			seen[entry] = true
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].less(d.registry, entries[j])
+	})
+
+	for _, entry := range entries {
+		currentPath := childPath(path, entry.name)
+
+		if entry.isLeaf == false {
+			var oldSubindex, newSubindex *XmpPropertyIndex
+
+			if oldIndex != nil {
+				oldSubindex = oldIndex.subindices[entry.key]
+			}
+
+			if newIndex != nil {
+				newSubindex = newIndex.subindices[entry.key]
+			}
+
+			err := d.diff(currentPath, oldSubindex, newSubindex)
+			log.PanicIf(err)
+
+			continue
+		}
+
+		var oldValues, newValues []interface{}
+
+		if oldIndex != nil {
+			oldValues = oldIndex.leaves[entry.key]
+		}
+
+		if newIndex != nil {
+			newValues = newIndex.leaves[entry.key]
+		}
+
+		err := d.diffLeaf(currentPath, oldIndex, oldValues, newIndex, newValues)
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+// Diff compares two indices and reports every property that was added,
+// removed, or changed going from the old index to the new one. Values are
+// compared by type: dates by instant and timezone (see DiffOptions), Bag items
+// in any order, and Seq and Alt items in order. Array items and struct fields
+// are compared by their parsed values, so the same applies to them. If
+// options is nil, the defaults are used.
+func Diff(oldIndex, newIndex *XmpPropertyIndex, options *DiffOptions) (report *DiffReport, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if options == nil {
+		options = new(DiffOptions)
+	}
+
+	d := &differ{
+		options:  options,
+		report:   new(DiffReport),
+		registry: newIndex.registry,
+	}
+
+	rootPath := make(xmpregistry.XmpPropertyName, 0)
+
+	err = d.diff(rootPath, oldIndex, newIndex)
+	log.PanicIf(err)
+
+	return d.report, nil
+}
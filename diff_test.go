@@ -0,0 +1,294 @@
+package xmp
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/json"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+func getDiffLines(report *DiffReport) []string {
+	lines := make([]string, len(report.Changes))
+	for i, dc := range report.Changes {
+		lines[i] = dc.String()
+	}
+
+	return lines
+}
+
+func TestDiff(t *testing.T) {
+	oldIndex := parseTestDocument(`
+<xmp:Label>old label</xmp:Label>
+<xmp:Nickname>removed</xmp:Nickname>
+<xmp:Rating>3</xmp:Rating>`)
+
+	newIndex := parseTestDocument(`
+<xmp:Label>new label</xmp:Label>
+<xmp:BaseURL>http://added</xmp:BaseURL>
+<xmp:Rating>3</xmp:Rating>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	expected := []string{
+		`+ [x]xmpmeta.[xmp]BaseURL: "http://added"`,
+		`~ [x]xmpmeta.[xmp]Label: "old label" -> "new label"`,
+		`- [x]xmpmeta.[xmp]Nickname: "removed"`,
+	}
+
+	if lines := getDiffLines(report); reflect.DeepEqual(lines, expected) != true {
+		t.Fatalf("Diff not correct: %v", lines)
+	}
+}
+
+func TestDiff_CustomRegistry(t *testing.T) {
+	registry := getTestTenantRegistry()
+
+	oldIndex := parseTestDocumentWithRegistry(registry, `
+<zz:Count xmlns:zz="http://some/uri/tenant/">1</zz:Count>
+<xmp:Label>old label</xmp:Label>`)
+
+	newIndex := parseTestDocumentWithRegistry(registry, `
+<zz:Count xmlns:zz="http://some/uri/tenant/">2</zz:Count>
+<xmp:Label>new label</xmp:Label>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	expected := []string{
+		`~ [x]xmpmeta.[xmp]Label: "old label" -> "new label"`,
+		`~ [x]xmpmeta.[zz]Count: 1 -> 2`,
+	}
+
+	if lines := getDiffLines(report); reflect.DeepEqual(lines, expected) != true {
+		t.Fatalf("Diff not correct: %v", lines)
+	} else if pointer := report.Changes[1].Pointer(); pointer != "/[x]xmpmeta/[zz]Count" {
+		t.Fatalf("Pointer not correct: [%s]", pointer)
+	}
+}
+
+func TestDiff_Identical(t *testing.T) {
+	document := `
+<xmp:Label>some label</xmp:Label>
+<dc:subject><rdf:Bag><rdf:li>aa</rdf:li></rdf:Bag></dc:subject>`
+
+	report, err := Diff(parseTestDocument(document), parseTestDocument(document), nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Expected no changes: %v", report.Changes)
+	} else if report.Text() != "" {
+		t.Fatalf("Expected no text: [%s]", report.Text())
+	}
+}
+
+func TestDiff_Timezone(t *testing.T) {
+	oldIndex := parseTestDocument(`<xmp:ModifyDate>2020-01-02T03:04:05+00:00</xmp:ModifyDate>`)
+	newIndex := parseTestDocument(`<xmp:ModifyDate>2020-01-02T05:04:05+02:00</xmp:ModifyDate>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 1 {
+		t.Fatalf("Expected timezone change to be reported: %v", report.Changes)
+	} else if report.Changes[0].Operation != DiffReplace {
+		t.Fatalf("Operation not correct: [%s]", report.Changes[0].Operation)
+	}
+
+	report, err = Diff(oldIndex, newIndex, &DiffOptions{IgnoreTimezone: true})
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Expected same instant to be equivalent: %v", report.Changes)
+	}
+
+	laterIndex := parseTestDocument(`<xmp:ModifyDate>2020-01-02T06:04:05+02:00</xmp:ModifyDate>`)
+
+	report, err = Diff(oldIndex, laterIndex, &DiffOptions{IgnoreTimezone: true})
+	log.PanicIf(err)
+
+	if len(report.Changes) != 1 {
+		t.Fatalf("Expected different instant to be reported: %v", report.Changes)
+	}
+}
+
+func TestDiff_Timezone_ArrayItems(t *testing.T) {
+	registry := getTestTenantRegistry()
+
+	datesNamespace := xmpregistry.Namespace{
+		Uri:             "http://some/uri/dates/",
+		PreferredPrefix: "dt",
+		Fields: map[string]interface{}{
+			"Dates": xmptype.OrderedArrayFieldType{ItemType: xmptype.DateFieldType{}},
+		},
+	}
+
+	err := registry.Register(datesNamespace)
+	log.PanicIf(err)
+
+	oldIndex := parseTestDocumentWithRegistry(registry, `
+<dt:Dates xmlns:dt="http://some/uri/dates/">
+  <rdf:Seq>
+    <rdf:li>2020-01-02T03:04:05+00:00</rdf:li>
+    <rdf:li>2021</rdf:li>
+  </rdf:Seq>
+</dt:Dates>`)
+
+	newIndex := parseTestDocumentWithRegistry(registry, `
+<dt:Dates xmlns:dt="http://some/uri/dates/">
+  <rdf:Seq>
+    <rdf:li>2020-01-02T05:04:05+02:00</rdf:li>
+    <rdf:li>2021</rdf:li>
+  </rdf:Seq>
+</dt:Dates>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 1 {
+		t.Fatalf("Expected timezone change to be reported: %v", report.Changes)
+	}
+
+	report, err = Diff(oldIndex, newIndex, &DiffOptions{IgnoreTimezone: true})
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Expected same instants to be equivalent: %v", report.Changes)
+	}
+}
+
+func TestDiff_Timezone_StructFields(t *testing.T) {
+	oldIndex := parseTestDocument(`
+<xmpMM:History>
+  <rdf:Seq>
+    <rdf:li stEvt:action="created" stEvt:when="2020-01-02T03:04:05Z"/>
+  </rdf:Seq>
+</xmpMM:History>
+<xmpMM:DerivedFrom stRef:documentID="xmp.did:1" stRef:lastModifyDate="2020-01-02T03:04:05Z"/>`)
+
+	newIndex := parseTestDocument(`
+<xmpMM:History>
+  <rdf:Seq>
+    <rdf:li stEvt:action="created" stEvt:when="2020-01-02T05:04:05+02:00"/>
+  </rdf:Seq>
+</xmpMM:History>
+<xmpMM:DerivedFrom stRef:documentID="xmp.did:1" stRef:lastModifyDate="2020-01-02T05:04:05+02:00"/>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 2 {
+		t.Fatalf("Expected timezone changes to be reported: %v", report.Changes)
+	}
+
+	report, err = Diff(oldIndex, newIndex, &DiffOptions{IgnoreTimezone: true})
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Expected same instants to be equivalent: %v", report.Changes)
+	}
+}
+
+func TestDiff_DatePrecision(t *testing.T) {
+	oldIndex := parseTestDocument(`<xmp:ModifyDate>1962</xmp:ModifyDate>`)
+	newIndex := parseTestDocument(`<xmp:ModifyDate>1962-01-01T00:00:00Z</xmp:ModifyDate>`)
+
+	report, err := Diff(oldIndex, newIndex, &DiffOptions{IgnoreTimezone: true})
+	log.PanicIf(err)
+
+	if len(report.Changes) != 1 {
+		t.Fatalf("Expected precision change to be reported: %v", report.Changes)
+	} else if report.Text() != "~ [x]xmpmeta.[xmp]ModifyDate: \"1962\" -> \"1962-01-01T00:00:00Z\"\n" {
+		t.Fatalf("Report not correct: [%s]", report.Text())
+	}
+}
+
+func TestDiff_Qualifiers(t *testing.T) {
+	oldIndex := parseTestDocument(`<dc:source xml:lang="en">some source</dc:source>`)
+	newIndex := parseTestDocument(`<dc:source xml:lang="de">some source</dc:source>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 1 {
+		t.Fatalf("Expected qualifier change to be reported: %v", report.Changes)
+	} else if report.Changes[0].String() != `~ [x]xmpmeta.[dc]source: {"Value":"some source","Qualifiers":{"[xml]lang":"en"}} -> {"Value":"some source","Qualifiers":{"[xml]lang":"de"}}` {
+		t.Fatalf("Change not correct: [%s]", report.Changes[0])
+	}
+}
+
+func TestDiff_ArrayOrder(t *testing.T) {
+	oldIndex := parseTestDocument(`
+<dc:subject><rdf:Bag><rdf:li>aa</rdf:li><rdf:li>bb</rdf:li></rdf:Bag></dc:subject>
+<dc:creator><rdf:Seq><rdf:li>cc</rdf:li><rdf:li>dd</rdf:li></rdf:Seq></dc:creator>`)
+
+	newIndex := parseTestDocument(`
+<dc:subject><rdf:Bag><rdf:li>bb</rdf:li><rdf:li>aa</rdf:li></rdf:Bag></dc:subject>
+<dc:creator><rdf:Seq><rdf:li>dd</rdf:li><rdf:li>cc</rdf:li></rdf:Seq></dc:creator>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	expected := []string{
+		`~ [x]xmpmeta.[dc]creator: ["cc","dd"] -> ["dd","cc"]`,
+	}
+
+	if lines := getDiffLines(report); reflect.DeepEqual(lines, expected) != true {
+		t.Fatalf("Diff not correct: %v", lines)
+	}
+}
+
+func TestDiffReport_MarshalJSON(t *testing.T) {
+	oldIndex := parseTestDocument(`
+<xmp:Label>old label</xmp:Label>
+<xmp:Nickname>removed</xmp:Nickname>`)
+
+	newIndex := parseTestDocument(`
+<xmp:Label>new label</xmp:Label>
+<xmp:BaseURL>http://added</xmp:BaseURL>`)
+
+	report, err := Diff(oldIndex, newIndex, nil)
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(report)
+	log.PanicIf(err)
+
+	expected := `[` +
+		`{"op":"add","path":"/[x]xmpmeta/[xmp]BaseURL","value":"http://added"},` +
+		`{"op":"replace","path":"/[x]xmpmeta/[xmp]Label","value":"new label","previous":"old label"},` +
+		`{"op":"remove","path":"/[x]xmpmeta/[xmp]Nickname","previous":"removed"}` +
+		`]`
+
+	if string(encoded) != expected {
+		t.Fatalf("JSON not correct:\n%s", string(encoded))
+	}
+}
+
+func TestDiffReport_MarshalJSON_Empty(t *testing.T) {
+	encoded, err := json.Marshal(new(DiffReport))
+	log.PanicIf(err)
+
+	if string(encoded) != "[]" {
+		t.Fatalf("JSON not correct: [%s]", string(encoded))
+	}
+}
+
+func TestDiffChange_Pointer(t *testing.T) {
+	dc := DiffChange{
+		Path: xmpregistry.XmpPropertyName{
+			{Space: xmpnamespace.XUri, Local: "xmpmeta"},
+			{Space: xmpnamespace.XmpUri, Local: "Label"},
+		},
+		Occurrence: 2,
+	}
+
+	if dc.Pointer() != "/[x]xmpmeta/[xmp]Label/2" {
+		t.Fatalf("Pointer not correct: [%s]", dc.Pointer())
+	}
+}
@@ -0,0 +1,657 @@
+package xmp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// DocumentVersion is the version of the document schema produced by
+	// ExportDocument. It will be incremented whenever the schema changes in a
+	// way that is not backwards-compatible.
+	DocumentVersion = 1
+)
+
+var (
+	// ErrDocumentVersionNotSupported indicates that a document was produced
+	// with a schema version that we do not know how to import.
+	ErrDocumentVersionNotSupported = errors.New("document version not supported")
+)
+
+var (
+	arrayKinds = map[string]struct{}{
+		"Bag": {},
+		"Seq": {},
+		"Alt": {},
+	}
+)
+
+// DocumentAttribute is a single attribute of a complex node or array item.
+type DocumentAttribute struct {
+	// Name is the qualified ("prefix:local") name of the attribute.
+	Name string `json:"name"`
+
+	// Value is the encoded value.
+	Value string `json:"value"`
+
+	// Fields are the fields of a nested struct (e.g. the "stVer:event" of an
+	// "xmpMM:Versions" item). Value is empty if there are fields.
+	Fields []DocumentAttribute `json:"fields,omitempty"`
+
+	// ArrayKind is one of "Bag", "Seq", or "Alt" for a nested array (e.g. the
+	// "xmpDM:markers" of an "xmpDM:Tracks" item). Value is empty if this is
+	// set.
+	ArrayKind string `json:"array_kind,omitempty"`
+
+	// Items are the items of a nested array.
+	Items []DocumentArrayItem `json:"items,omitempty"`
+}
+
+// DocumentArrayItem is a single item of an array.
+type DocumentArrayItem struct {
+	// Language is the "xml:lang" qualifier of the item, if any.
+	Language string `json:"language,omitempty"`
+
+	// Attributes are any other attributes of the item.
+	Attributes []DocumentAttribute `json:"attributes,omitempty"`
+
+	// Value is the char-data of the item.
+	Value string `json:"value"`
+
+	// Qualifiers are the qualifiers of an item expressed in the general
+	// qualifier form ("rdf:value").
+	Qualifiers []DocumentAttribute `json:"qualifiers,omitempty"`
+}
+
+// DocumentProperty is a single leaf value.
+type DocumentProperty struct {
+	// Path is the list of qualified ("prefix:local") names leading to the
+	// property.
+	Path []string `json:"path"`
+
+	// Kind is one of "scalar", "array", or "complex".
+	Kind string `json:"kind"`
+
+	// Value is the encoded value of a scalar.
+	Value string `json:"value,omitempty"`
+
+	// Qualifiers are the qualifiers of a scalar, including "xml:lang".
+	Qualifiers []DocumentAttribute `json:"qualifiers,omitempty"`
+
+	// ArrayKind is one of "Bag", "Seq", or "Alt" for arrays.
+	ArrayKind string `json:"array_kind,omitempty"`
+
+	// Items are the items of an array.
+	Items []DocumentArrayItem `json:"items,omitempty"`
+
+	// Attributes are the attributes of a complex node.
+	Attributes []DocumentAttribute `json:"attributes,omitempty"`
+}
+
+// Document is the stable, versioned and non-simplified JSON representation of
+// an index. Unlike Export, it retains array kinds, attributes, and language
+// qualifiers and can be imported again with ImportDocument.
+type Document struct {
+	// Version is the schema version (DocumentVersion).
+	Version int `json:"version"`
+
+	// Namespaces maps each prefix used in the document to its URI.
+	Namespaces map[string]string `json:"namespaces"`
+
+	// Properties are the leaf values in document order.
+	Properties []DocumentProperty `json:"properties"`
+}
+
+// documentPrefixes assigns a unique prefix to every namespace URI used in a
+// document. The preferred prefix is used when the namespace is registered and
+// the prefix is not already taken.
+type documentPrefixes struct {
+	registry   *xmpregistry.Registry
+	namespaces map[string]string
+	prefixes   map[string]string
+}
+
+func newDocumentPrefixes(registry *xmpregistry.Registry) *documentPrefixes {
+	return &documentPrefixes{
+		registry:   registry,
+		namespaces: make(map[string]string),
+		prefixes:   make(map[string]string),
+	}
+}
+
+func (dp *documentPrefixes) qualify(name xml.Name) string {
+	if prefix, found := dp.prefixes[name.Space]; found == true {
+		return prefix + ":" + name.Local
+	}
+
+	prefix := ""
+
+	if namespace, err := dp.registry.Get(name.Space); err == nil {
+		prefix = namespace.PreferredPrefix
+	}
+
+	if _, found := dp.namespaces[prefix]; prefix == "" || found == true {
+		for i := 1; ; i++ {
+			prefix = fmt.Sprintf("ns%d", i)
+
+			if _, found := dp.namespaces[prefix]; found == false {
+				break
+			}
+		}
+	}
+
+	dp.namespaces[prefix] = name.Space
+	dp.prefixes[name.Space] = prefix
+
+	return prefix + ":" + name.Local
+}
+
+func (dp *documentPrefixes) exportAttributes(attributes map[xml.Name]interface{}, skip xml.Name) (exported []DocumentAttribute, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	for _, name := range sortedAttributeNames(dp.registry, attributes) {
+		if name == skip {
+			continue
+		}
+
+		da := DocumentAttribute{
+			Name: dp.qualify(name),
+		}
+
+		switch value := attributes[name].(type) {
+		case xmptype.StructValue:
+			da.Fields, err = dp.exportAttributes(value, xml.Name{})
+			log.PanicIf(err)
+		case xmptype.ArrayValue:
+			da.ArrayKind, da.Items, err = dp.exportArrayItems(value)
+			log.PanicIf(err)
+		default:
+			da.Value, err = xmptype.FormatValue(value)
+			log.PanicIf(err)
+		}
+
+		exported = append(exported, da)
+	}
+
+	return exported, nil
+}
+
+func (dp *documentPrefixes) exportArray(property *DocumentProperty, av xmptype.ArrayValue) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	property.ArrayKind, property.Items, err = dp.exportArrayItems(av)
+	log.PanicIf(err)
+
+	return nil
+}
+
+// exportArrayItems returns the kind and items of the array.
+func (dp *documentPrefixes) exportArrayItems(av xmptype.ArrayValue) (arrayKind string, documentItems []DocumentArrayItem, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	ail, ok := av.(xmptype.ArrayItemLister)
+	if ok == false {
+		log.Panicf("array does not support items: [%s]", av.FullName())
+	}
+
+	items, err := ail.Items()
+	log.PanicIf(err)
+
+	arrayKind = av.ContainerName().Local
+	documentItems = make([]DocumentArrayItem, len(items))
+
+	for i, ai := range items {
+		attributes, err := dp.exportAttributes(ai.Attributes, xmpnamespace.XmlLangAttribute)
+		log.PanicIf(err)
+
+		qualifiers, err := dp.exportAttributes(ai.Qualifiers, xml.Name{})
+		log.PanicIf(err)
+
+		language, _ := ai.Attributes[xmpnamespace.XmlLangAttribute].(string)
+
+		documentItems[i] = DocumentArrayItem{
+			Language:   language,
+			Attributes: attributes,
+			Value:      ai.CharData,
+			Qualifiers: qualifiers,
+		}
+	}
+
+	return arrayKind, documentItems, nil
+}
+
+// ExportDocument returns the index as a Document. Properties are in document
+// order.
+func (xpi *XmpPropertyIndex) ExportDocument() (document *Document, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	dp := newDocumentPrefixes(xpi.registry)
+	properties := make([]DocumentProperty, 0)
+
+	cb := func(path xmpregistry.XmpPropertyName, node Node) (err error) {
+		defer func() {
+			if errRaw := recover(); errRaw != nil {
+				err = log.Wrap(errRaw.(error))
+			}
+		}()
+
+		if node.Kind == NodeKindStruct {
+			return nil
+		}
+
+		property := DocumentProperty{
+			Path: make([]string, len(path)),
+			Kind: node.Kind.String(),
+		}
+
+		for i, name := range path {
+			property.Path[i] = dp.qualify(xml.Name(name))
+		}
+
+		switch node.Kind {
+		case NodeKindScalar:
+			property.Value, err = xmptype.FormatValue(node.Value)
+			log.PanicIf(err)
+
+			property.Qualifiers, err = dp.exportAttributes(node.Qualifiers, xml.Name{})
+			log.PanicIf(err)
+		case NodeKindArray:
+			err := dp.exportArray(&property, node.Value.(xmptype.ArrayValue))
+			log.PanicIf(err)
+		case NodeKindComplex:
+			property.Attributes, err = dp.exportAttributes(node.Value.(ComplexLeafNode), xml.Name{})
+			log.PanicIf(err)
+		}
+
+		properties = append(properties, property)
+
+		return nil
+	}
+
+	err = xpi.WalkOrdered(IndexOrderDocument, cb)
+	log.PanicIf(err)
+
+	document = &Document{
+		Version:    DocumentVersion,
+		Namespaces: dp.namespaces,
+		Properties: properties,
+	}
+
+	return document, nil
+}
+
+// documentImporter rebuilds an index from a document.
+type documentImporter struct {
+	document *Document
+	registry *xmpregistry.Registry
+}
+
+// resolve returns the full name for a qualified name.
+func (di *documentImporter) resolve(qualifiedName string) (name xml.Name, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	parts := strings.SplitN(qualifiedName, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		log.Panicf("name is not qualified: [%s]", qualifiedName)
+	}
+
+	uri, found := di.document.Namespaces[parts[0]]
+	if found == false {
+		log.Panicf("prefix not declared in document: [%s]", parts[0])
+	}
+
+	name = xml.Name{
+		Space: uri,
+		Local: parts[1],
+	}
+
+	return name, nil
+}
+
+// parseValue parses an encoded value for a registered field that is directly
+// within the given property.
+func (di *documentImporter) parseValue(parent xml.Name, name xml.Name, raw string) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	namespace, err := di.registry.Get(name.Space)
+	if err != nil {
+		log.Panicf("namespace not registered: [%s]", name.Space)
+	}
+
+	parsed, err = xmptype.ParseValue(namespace, parent, name.Local, raw)
+	if err != nil {
+		log.Panicf("value not valid for [%s] [%s]: [%s]: %s", name.Space, name.Local, raw, err)
+	}
+
+	return parsed, nil
+}
+
+// importAttributes parses and validates attributes of the given property. The
+// encoded values are returned as well so that they can be written into array
+// items.
+func (di *documentImporter) importAttributes(attributes []DocumentAttribute, parent xml.Name) (parsed map[xml.Name]interface{}, raw []xml.Attr, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	parsed = make(map[xml.Name]interface{})
+	raw = make([]xml.Attr, 0)
+
+	for _, da := range attributes {
+		name, err := di.resolve(da.Name)
+		log.PanicIf(err)
+
+		parsed[name], err = di.parseValue(parent, name, da.Value)
+		log.PanicIf(err)
+
+		raw = append(raw, xml.Attr{Name: name, Value: da.Value})
+	}
+
+	return parsed, raw, nil
+}
+
+// importStructElements returns the elements of a nested struct or array in
+// the same form that the parser would have collected them.
+func (di *documentImporter) importStructElements(da DocumentAttribute) (elements []interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	name, err := di.resolve(da.Name)
+	log.PanicIf(err)
+
+	elements = []interface{}{
+		xml.StartElement{Name: name},
+	}
+
+	if da.ArrayKind != "" {
+		xpn := xmpregistry.XmpPropertyName{xmpregistry.XmlName(name)}
+
+		collected, err := di.importArrayCollected(xpn, da.ArrayKind, da.Items)
+		log.PanicIf(err)
+
+		elements = append(elements, collected...)
+		elements = append(elements, xml.EndElement{Name: name})
+
+		return elements, nil
+	}
+
+	for _, field := range da.Fields {
+		if len(field.Fields) > 0 || field.ArrayKind != "" {
+			nested, err := di.importStructElements(field)
+			log.PanicIf(err)
+
+			elements = append(elements, nested...)
+
+			continue
+		}
+
+		fieldName, err := di.resolve(field.Name)
+		log.PanicIf(err)
+
+		parsed, err := di.parseValue(name, fieldName, field.Value)
+		log.PanicIf(err)
+
+		elements = append(
+			elements,
+			xml.StartElement{Name: fieldName},
+			parsed,
+			xml.EndElement{Name: fieldName})
+	}
+
+	elements = append(elements, xml.EndElement{Name: name})
+
+	return elements, nil
+}
+
+func (di *documentImporter) importArray(xpi *XmpPropertyIndex, xpn xmpregistry.XmpPropertyName, fieldType interface{}, property DocumentProperty) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	aft, ok := fieldType.(xmptype.ArrayFieldType)
+	if ok == false {
+		log.Panicf("property is not an array: [%s]", xpn)
+	}
+
+	collected, err := di.importArrayCollected(xpn, property.ArrayKind, property.Items)
+	log.PanicIf(err)
+
+	err = xpi.addArrayValue(xpn, aft.New(di.registry, xpn, collected))
+	log.PanicIf(err)
+
+	return nil
+}
+
+// importArrayCollected returns the elements of an array in the same form that
+// the parser would have collected them. The name is only used for messages.
+func (di *documentImporter) importArrayCollected(xpn xmpregistry.XmpPropertyName, arrayKind string, items []DocumentArrayItem) (collected []interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if _, found := arrayKinds[arrayKind]; found == false {
+		log.Panicf("array kind not valid: [%s] [%s]", xpn, arrayKind)
+	}
+
+	containerName := xml.Name{
+		Space: xmpnamespace.RdfUri,
+		Local: arrayKind,
+	}
+
+	parent := xml.Name(xpn[len(xpn)-1])
+	itemElements := make([][]interface{}, len(items))
+
+	for i, item := range items {
+		flatAttributes := make([]DocumentAttribute, 0, len(item.Attributes))
+		structElements := make([]interface{}, 0)
+
+		for _, da := range item.Attributes {
+			if len(da.Fields) == 0 && da.ArrayKind == "" {
+				flatAttributes = append(flatAttributes, da)
+				continue
+			}
+
+			elements, err := di.importStructElements(da)
+			log.PanicIf(err)
+
+			structElements = append(structElements, elements...)
+		}
+
+		_, attributes, err := di.importAttributes(flatAttributes, parent)
+		log.PanicIf(err)
+
+		if item.Language != "" {
+			languageAttribute := xml.Attr{
+				Name:  xmpnamespace.XmlLangAttribute,
+				Value: item.Language,
+			}
+
+			attributes = append([]xml.Attr{languageAttribute}, attributes...)
+		}
+
+		if len(structElements) > 0 {
+			// Nested structs and arrays can only be written as child nodes, so
+			// write the item in the "rdf:parseType='Resource'" form.
+
+			elements := []interface{}{
+				xml.StartElement{Name: xmpnamespace.RdfLiTag, Attr: attributes},
+			}
+
+			elements = append(elements, structElements...)
+			itemElements[i] = append(elements, xml.EndElement{Name: xmpnamespace.RdfLiTag})
+
+			continue
+		} else if len(item.Qualifiers) == 0 {
+			itemElements[i] = []interface{}{
+				xml.StartElement{Name: xmpnamespace.RdfLiTag, Attr: attributes},
+				item.Value,
+				xml.EndElement{Name: xmpnamespace.RdfLiTag},
+			}
+
+			continue
+		}
+
+		// Write the item in the general qualifier form, the same as the
+		// parser would have collected it.
+
+		elements := []interface{}{
+			xml.StartElement{Name: xmpnamespace.RdfLiTag, Attr: attributes},
+			xml.StartElement{Name: xmpnamespace.RdfValueTag},
+			item.Value,
+			xml.EndElement{Name: xmpnamespace.RdfValueTag},
+		}
+
+		for _, da := range item.Qualifiers {
+			name, err := di.resolve(da.Name)
+			log.PanicIf(err)
+
+			parsed, err := di.parseValue(parent, name, da.Value)
+			log.PanicIf(err)
+
+			elements = append(
+				elements,
+				xml.StartElement{Name: name},
+				parsed,
+				xml.EndElement{Name: name})
+		}
+
+		itemElements[i] = append(elements, xml.EndElement{Name: xmpnamespace.RdfLiTag})
+	}
+
+	collected = xmptype.NewCollected(containerName, itemElements)
+
+	return collected, nil
+}
+
+func (di *documentImporter) importProperty(xpi *XmpPropertyIndex, property DocumentProperty) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if len(property.Path) == 0 {
+		log.Panicf("property has empty path")
+	}
+
+	xpn := make(xmpregistry.XmpPropertyName, len(property.Path))
+
+	for i, qualifiedName := range property.Path {
+		name, err := di.resolve(qualifiedName)
+		log.PanicIf(err)
+
+		xpn[i] = xmpregistry.XmlName(name)
+	}
+
+	leafName := xml.Name(xpn[len(xpn)-1])
+
+	switch property.Kind {
+	case NodeKindScalar.String():
+		parsed, err := di.parseValue(xmptype.ParentName(xpn), leafName, property.Value)
+		log.PanicIf(err)
+
+		qualifiers, _, err := di.importAttributes(property.Qualifiers, leafName)
+		log.PanicIf(err)
+
+		err = xpi.addQualifiedScalarValue(xpn, parsed, qualifiers)
+		log.PanicIf(err)
+	case NodeKindArray.String():
+		fieldType := lookupFieldType(xpi.registry, xpn)
+		if fieldType == nil {
+			log.Panicf("array field not registered: [%s]", xpn)
+		}
+
+		err := di.importArray(xpi, xpn, fieldType, property)
+		log.PanicIf(err)
+	case NodeKindComplex.String():
+		attributes, _, err := di.importAttributes(property.Attributes, leafName)
+		log.PanicIf(err)
+
+		err = xpi.addComplexValue(xpn, attributes)
+		log.PanicIf(err)
+	default:
+		log.Panicf("property kind not valid: [%s] [%s]", xpn, property.Kind)
+	}
+
+	return nil
+}
+
+// ImportDocument rebuilds an index from a document produced by ExportDocument.
+// Every value is validated against the default registry: all namespaces must
+// be registered and all values must parse as the registered field-types.
+func ImportDocument(document *Document) (xpi *XmpPropertyIndex, err error) {
+	return ImportDocumentWithRegistry(document, xmpregistry.Default())
+}
+
+// ImportDocumentWithRegistry rebuilds an index from a document and validates
+// it against the given registry.
+func ImportDocumentWithRegistry(document *Document, registry *xmpregistry.Registry) (xpi *XmpPropertyIndex, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if document.Version != DocumentVersion {
+		log.Panic(ErrDocumentVersionNotSupported)
+	}
+
+	di := &documentImporter{
+		document: document,
+		registry: registry,
+	}
+
+	xpi = newXmpPropertyIndex(registry, xmpregistry.XmlName{})
+
+	for _, property := range document.Properties {
+		err := di.importProperty(xpi, property)
+		log.PanicIf(err)
+	}
+
+	xpi.graph, err = xpi.buildGraph()
+	log.PanicIf(err)
+
+	return xpi, nil
+}
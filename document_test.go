@@ -0,0 +1,561 @@
+package xmp
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	testDocumentProperties = `
+<xmp:Label>some label</xmp:Label>
+<xmp:ModifyDate>2014-09-22T10:56:35+02:00</xmp:ModifyDate>
+<xmp:Rating>4</xmp:Rating>
+<dc:title>
+  <rdf:Alt>
+    <rdf:li xml:lang="x-default">default title</rdf:li>
+    <rdf:li xml:lang="de">Titel</rdf:li>
+  </rdf:Alt>
+</dc:title>
+<dc:subject><rdf:Bag><rdf:li>aa</rdf:li><rdf:li>bb</rdf:li></rdf:Bag></dc:subject>
+<xmpMM:History>
+  <rdf:Seq>
+    <rdf:li stEvt:action="created" stEvt:instanceID="xmp.iid:1" stEvt:when="2013-09-23T10:09:46+02:00"/>
+  </rdf:Seq>
+</xmpMM:History>
+<xmpMM:DerivedFrom xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#" stRef:documentID="xmp.did:1"/>`
+)
+
+func TestXmpPropertyIndex_ExportDocument(t *testing.T) {
+	xpi := parseTestDocument(`
+<xmp:Label>some label</xmp:Label>
+<dc:title>
+  <rdf:Alt>
+    <rdf:li xml:lang="x-default">default title</rdf:li>
+  </rdf:Alt>
+</dc:title>
+<xmpMM:DerivedFrom xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#" stRef:documentID="xmp.did:1"/>`)
+
+	document, err := xpi.ExportDocument()
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(document)
+	log.PanicIf(err)
+
+	expected := `{"version":1,` +
+		`"namespaces":{"dc":"http://purl.org/dc/elements/1.1/","stRef":"http://ns.adobe.com/xap/1.0/sType/ResourceRef#","x":"adobe:ns:meta/","xmp":"http://ns.adobe.com/xap/1.0/","xmpMM":"http://ns.adobe.com/xap/1.0/mm/"},` +
+		`"properties":[` +
+		`{"path":["x:xmpmeta","xmp:Label"],"kind":"scalar","value":"some label"},` +
+		`{"path":["x:xmpmeta","dc:title"],"kind":"array","array_kind":"Alt","items":[{"language":"x-default","value":"default title"}]},` +
+		`{"path":["x:xmpmeta","xmpMM:DerivedFrom"],"kind":"complex","attributes":[{"name":"stRef:documentID","value":"xmp.did:1"}]}` +
+		`]}`
+
+	if string(encoded) != expected {
+		t.Fatalf("Document not correct:\n%s", string(encoded))
+	}
+}
+
+func TestImportDocument_RoundTrip(t *testing.T) {
+	original := parseTestDocument(testDocumentProperties)
+
+	document, err := original.ExportDocument()
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(document)
+	log.PanicIf(err)
+
+	decoded := new(Document)
+
+	err = json.Unmarshal(encoded, decoded)
+	log.PanicIf(err)
+
+	imported, err := ImportDocument(decoded)
+	log.PanicIf(err)
+
+	report, err := Diff(original, imported, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Imported index differs:\n%s", report.Text())
+	} else if imported.Count() != original.Count() {
+		t.Fatalf("Imported count not correct: (%d) != (%d)", imported.Count(), original.Count())
+	}
+
+	reexported, err := imported.ExportDocument()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(reexported, document) != true {
+		t.Fatalf("Re-exported document not correct.")
+	}
+}
+
+func TestImportDocument_RoundTrip_GpsCoordinate(t *testing.T) {
+	registry := getTestTenantRegistry()
+
+	err := registry.Register(xmpnamespace.ExifNamespace)
+	log.PanicIf(err)
+
+	document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:exif="http://ns.adobe.com/exif/1.0/" exif:GPSLatitude="47,34.123N" exif:GPSLongitude="8,32,10.5E" />
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	original, err := NewParserWithRegistry(bytes.NewBufferString(document), registry).Parse()
+	log.PanicIf(err)
+
+	exported, err := original.ExportDocument()
+	log.PanicIf(err)
+
+	values := make([]string, len(exported.Properties))
+	for i, property := range exported.Properties {
+		values[i] = property.Value
+	}
+
+	if reflect.DeepEqual(values, []string{"47,34.123N", "8,32,10.5E"}) != true {
+		t.Fatalf("Exported values not correct: %v", values)
+	}
+
+	imported, err := ImportDocumentWithRegistry(exported, registry)
+	log.PanicIf(err)
+
+	report, err := Diff(original, imported, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Imported index differs:\n%s", report.Text())
+	}
+}
+
+// testFieldTypeSamples are the values that TestImportDocument_RoundTrip_FieldTypes
+// writes for every field-type in xmptype.FieldTypes, keyed by the name of the
+// field-type's Go type. The values of struct field-types are their child
+// nodes.
+var testFieldTypeSamples = map[string]string{
+	"AgentNameFieldType":         "Adobe Photoshop CC 2014 (Windows)",
+	"ArtworkOrObjectFieldType":   "<Iptc4xmpExt:AOSource>Museum of Modern Art</Iptc4xmpExt:AOSource><Iptc4xmpExt:AODateCreated>1889</Iptc4xmpExt:AODateCreated>",
+	"BooleanFieldType":           "True",
+	"CfaPatternFieldType":        "<exif:Columns>2</exif:Columns><exif:Rows>2</exif:Rows><exif:Values><rdf:Seq><rdf:li>0</rdf:li><rdf:li>1</rdf:li><rdf:li>1</rdf:li><rdf:li>2</rdf:li></rdf:Seq></exif:Values>",
+	"CvTermFieldType":            "<Iptc4xmpExt:CvId>http://cv.iptc.org/newscodes/scene/</Iptc4xmpExt:CvId><Iptc4xmpExt:CvTermId>http://cv.iptc.org/newscodes/scene/010100</Iptc4xmpExt:CvTermId>",
+	"ContactInfoFieldType":       "<Iptc4xmpCore:CiAdrCity>Berlin</Iptc4xmpCore:CiAdrCity><Iptc4xmpCore:CiEmailWork>someone@example.com</Iptc4xmpCore:CiEmailWork>",
+	"DateFieldType":              "2014-09-22T10:56:35+02:00",
+	"DeviceSettingsFieldType":    "<exif:Columns>1</exif:Columns><exif:Rows>1</exif:Rows><exif:Settings><rdf:Seq><rdf:li>Auto</rdf:li></rdf:Seq></exif:Settings>",
+	"DigitalSourceTypeFieldType": "http://cv.iptc.org/newscodes/digitalsourcetype/digitalCapture",
+	"EntityFieldType":            "<Iptc4xmpExt:Name><rdf:Alt><rdf:li xml:lang=\"x-default\">Some Entity</rdf:li></rdf:Alt></Iptc4xmpExt:Name><Iptc4xmpExt:Role><rdf:Bag><rdf:li>http://cv.iptc.org/newscodes/artworkrole/creator</rdf:li></rdf:Bag></Iptc4xmpExt:Role>",
+	"FlashFieldType":             "<exif:Fired>True</exif:Fired><exif:Return>0</exif:Return><exif:Mode>1</exif:Mode>",
+	"FrameCountFieldType":        "250f25",
+	"FrameRateFieldType":         "f25",
+	"GpsCoordinateFieldType":     "47,34.123N",
+	"GuidFieldType":              "xmp.did:1",
+	"ImageRegionFieldType":       "<Iptc4xmpExt:rId>region1</Iptc4xmpExt:rId>",
+	"IntegerFieldType":           "42",
+	"LocaleFieldType":            "en-US",
+	"LocationDetailsFieldType":   "<Iptc4xmpExt:City>Paris</Iptc4xmpExt:City><exif:GPSLatitude>48,51.4N</exif:GPSLatitude>",
+	"MimeTypeFieldType":          "image/jpeg",
+	"OecfFieldType":              "<exif:Columns>2</exif:Columns><exif:Rows>1</exif:Rows>",
+	"PartFieldType":              "/metadata",
+	"PersonDetailsFieldType":     "<Iptc4xmpExt:PersonId><rdf:Bag><rdf:li>http://example.com/person/1</rdf:li></rdf:Bag></Iptc4xmpExt:PersonId>",
+	"ProperNameFieldType":        "Jane Doe",
+	"RationalFieldType":          "1/250",
+	"RealFieldType":              "2.5",
+	"RegionBoundaryFieldType":    "<Iptc4xmpExt:rbShape>rectangle</Iptc4xmpExt:rbShape><Iptc4xmpExt:rbX>0.25</Iptc4xmpExt:rbX><Iptc4xmpExt:rbY>0.5</Iptc4xmpExt:rbY>",
+	"RegistryEntryFieldType":     "<Iptc4xmpExt:RegItemId>item1</Iptc4xmpExt:RegItemId><Iptc4xmpExt:RegOrgId>org1</Iptc4xmpExt:RegOrgId>",
+	"RenditionClassFieldType":    "thumbnail",
+	"ResourceEventFieldType":     "<stEvt:action>saved</stEvt:action><stEvt:when>2013-09-23T10:09:46+02:00</stEvt:when>",
+	"ResourceRefFieldType":       "<stRef:documentID>xmp.did:1</stRef:documentID><stRef:instanceID>xmp.iid:1</stRef:instanceID>",
+	"TextFieldType":              "some text",
+	"UriFieldType":               "http://example.com/resource",
+	"UrlFieldType":               "http://example.com/page.html",
+	"VersionFieldType":           "<stVer:version>1</stVer:version><stVer:modifyDate>2014-09-22T10:56:35+02:00</stVer:modifyDate>",
+	"CorrectionMaskFieldType":    "<crs:What>Mask/Brush</crs:What><crs:MaskValue>1</crs:MaskValue>",
+	"DefinedChoiceFieldType":     "1",
+	"GenericStructFieldType":     "<Iptc4xmpCore:CiAdrCity>Berlin</Iptc4xmpCore:CiAdrCity>",
+	"LocalCorrectionFieldType":   "<crs:What>Correction</crs:What><crs:CorrectionName>Brush</crs:CorrectionName>",
+	"RetouchAreaFieldType":       "<crs:SpotType>heal</crs:SpotType><crs:Method>gaussian</crs:Method>",
+	"ToneCurvePointFieldType":    "128, 130",
+}
+
+// testFieldTypeScopes are the properties that the fields of some struct
+// field-types are scoped to (see xmpregistry.Namespace.ScopedFields). The test
+// scopes the same fields to the properties that the samples are written to.
+var testFieldTypeScopes = map[string]xml.Name{
+	"CfaPatternFieldType":      {Space: xmpnamespace.ExifUri, Local: "CFAPattern"},
+	"DeviceSettingsFieldType":  {Space: xmpnamespace.ExifUri, Local: "DeviceSettingDescription"},
+	"FlashFieldType":           {Space: xmpnamespace.ExifUri, Local: "Flash"},
+	"OecfFieldType":            {Space: xmpnamespace.ExifUri, Local: "OECF"},
+	"CorrectionMaskFieldType":  {Space: xmpnamespace.CrsUri, Local: "CorrectionMasks"},
+	"LocalCorrectionFieldType": {Space: xmpnamespace.CrsUri, Local: "PaintBasedCorrections"},
+	"RetouchAreaFieldType":     {Space: xmpnamespace.CrsUri, Local: "RetouchAreas"},
+}
+
+func TestImportDocument_RoundTrip_FieldTypes(t *testing.T) {
+	registry := xmpregistry.NewRegistry()
+
+	namespaces := []xmpregistry.Namespace{
+		xmpnamespace.XNamespace,
+		xmpnamespace.RdfNamespace,
+		xmpnamespace.XmlNamespace,
+		xmpnamespace.ExifNamespace,
+		xmpnamespace.CrsNamespace,
+		xmpnamespace.Iptc4xmpCoreNamespace,
+		xmpnamespace.Iptc4xmpExtNamespace,
+		xmpnamespace.StEvtNamespace,
+		xmpnamespace.StRefNamespace,
+		xmpnamespace.StVerNamespace,
+	}
+
+	testNamespaceUri := "http://some/uri/fieldtypes/"
+
+	for _, namespace := range namespaces {
+		scopedFields := make(map[xml.Name]map[string]interface{}, len(namespace.ScopedFields))
+		for parent, fields := range namespace.ScopedFields {
+			scopedFields[parent] = fields
+		}
+
+		for name, parent := range testFieldTypeScopes {
+			if fields, found := namespace.ScopedFields[parent]; found == true {
+				scopedFields[xml.Name{Space: testNamespaceUri, Local: name}] = fields
+			}
+		}
+
+		namespace.ScopedFields = scopedFields
+
+		err := registry.Register(namespace)
+		log.PanicIf(err)
+	}
+
+	testNamespace := xmpregistry.Namespace{
+		Uri:             testNamespaceUri,
+		PreferredPrefix: "ft",
+		Fields:          make(map[string]interface{}),
+	}
+
+	properties := ""
+	for _, entry := range xmptype.FieldTypes {
+		name := reflect.TypeOf(entry.FieldType).Name()
+
+		sample, found := testFieldTypeSamples[name]
+		if found == false {
+			t.Fatalf("No sample for field-type [%s].", name)
+		}
+
+		testNamespace.Fields[name] = entry.FieldType
+
+		if _, ok := entry.FieldType.(xmptype.StructFieldType); ok == true {
+			properties += fmt.Sprintf("<ft:%s rdf:parseType=\"Resource\">%s</ft:%s>\n", name, sample, name)
+		} else {
+			properties += fmt.Sprintf("<ft:%s>%s</ft:%s>\n", name, sample, name)
+		}
+	}
+
+	err := registry.Register(testNamespace)
+	log.PanicIf(err)
+
+	document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+        xmlns:ft="http://some/uri/fieldtypes/"
+        xmlns:exif="http://ns.adobe.com/exif/1.0/"
+        xmlns:crs="http://ns.adobe.com/camera-raw-settings/1.0/"
+        xmlns:Iptc4xmpCore="http://iptc.org/std/Iptc4xmpCore/1.0/xmlns/"
+        xmlns:Iptc4xmpExt="http://iptc.org/std/Iptc4xmpExt/2008-02-29/"
+        xmlns:stEvt="http://ns.adobe.com/xap/1.0/sType/ResourceEvent#"
+        xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#"
+        xmlns:stVer="http://ns.adobe.com/xap/1.0/sType/Version#">
+` + properties + `    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	original, err := NewParserWithRegistry(bytes.NewBufferString(document), registry).Parse()
+	log.PanicIf(err)
+
+	exported, err := original.ExportDocument()
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(exported)
+	log.PanicIf(err)
+
+	decoded := new(Document)
+
+	err = json.Unmarshal(encoded, decoded)
+	log.PanicIf(err)
+
+	imported, err := ImportDocumentWithRegistry(decoded, registry)
+	log.PanicIf(err)
+
+	report, err := Diff(original, imported, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Imported index differs:\n%s", report.Text())
+	}
+
+	for _, entry := range xmptype.FieldTypes {
+		name := xmpregistry.XmlName{Space: testNamespaceUri, Local: reflect.TypeOf(entry.FieldType).Name()}
+
+		originalValue, err := original.GetProperty(name)
+		if err != nil {
+			t.Fatalf("Could not get [%s]: %v", name.Local, err)
+		}
+
+		if reflect.TypeOf(originalValue) != entry.ValueType {
+			t.Fatalf("Parsed value of [%s] not the expected type: [%v] != [%v]", name.Local, reflect.TypeOf(originalValue), entry.ValueType)
+		}
+
+		importedValue, err := imported.GetProperty(name)
+		log.PanicIf(err)
+
+		if reflect.DeepEqual(importedValue, originalValue) != true {
+			t.Fatalf("Imported value of [%s] not correct: [%v] != [%v]", name.Local, importedValue, originalValue)
+		}
+	}
+}
+
+func TestImportDocument_RoundTrip_Qualifiers(t *testing.T) {
+	original := parseTestDocument(`
+<dc:source xml:lang="en">some source</dc:source>
+<xmp:Identifier xmlns:xmpidq="http://ns.adobe.com/xmp/Identifier/qual/1.0/">
+  <rdf:Bag>
+    <rdf:li rdf:parseType="Resource">
+      <rdf:value>978-3-16-148410-0</rdf:value>
+      <xmpidq:Scheme>ISBN</xmpidq:Scheme>
+    </rdf:li>
+  </rdf:Bag>
+</xmp:Identifier>`)
+
+	document, err := original.ExportDocument()
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(document.Properties)
+	log.PanicIf(err)
+
+	expected := `[` +
+		`{"path":["x:xmpmeta","dc:source"],"kind":"scalar","value":"some source","qualifiers":[{"name":"xml:lang","value":"en"}]},` +
+		`{"path":["x:xmpmeta","xmp:Identifier"],"kind":"array","array_kind":"Bag","items":[{"value":"978-3-16-148410-0","qualifiers":[{"name":"xmpidq:Scheme","value":"ISBN"}]}]}` +
+		`]`
+
+	if string(encoded) != expected {
+		t.Fatalf("Properties not correct:\n%s", string(encoded))
+	}
+
+	imported, err := ImportDocument(document)
+	log.PanicIf(err)
+
+	report, err := Diff(original, imported, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Imported index differs:\n%s", report.Text())
+	}
+}
+
+func TestImportDocument_RoundTrip_NestedStruct(t *testing.T) {
+	original := parseTestDocument(`
+<xmpMM:Versions>
+  <rdf:Seq>
+    <rdf:li rdf:parseType="Resource">
+      <stVer:version>1</stVer:version>
+      <stVer:event stEvt:action="checked-in"/>
+    </rdf:li>
+  </rdf:Seq>
+</xmpMM:Versions>`)
+
+	document, err := original.ExportDocument()
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(document.Properties)
+	log.PanicIf(err)
+
+	expected := `[` +
+		`{"path":["x:xmpmeta","xmpMM:Versions"],"kind":"array","array_kind":"Seq","items":[{"attributes":[{"name":"stVer:event","value":"","fields":[{"name":"stEvt:action","value":"checked-in"}]},{"name":"stVer:version","value":"1"}],"value":""}]}` +
+		`]`
+
+	if string(encoded) != expected {
+		t.Fatalf("Properties not correct:\n%s", string(encoded))
+	}
+
+	imported, err := ImportDocument(document)
+	log.PanicIf(err)
+
+	report, err := Diff(original, imported, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Imported index differs:\n%s", report.Text())
+	}
+
+	results, err := imported.Get([]string{"[x]xmpmeta", "[xmpMM]Versions"})
+	log.PanicIf(err)
+
+	versions, err := results[0].(xmptype.OrderedVersionArrayValue).Versions()
+	log.PanicIf(err)
+
+	if len(versions) != 1 || versions[0].Version != "1" || versions[0].Event.Action != "checked-in" {
+		t.Fatalf("Imported versions not correct: %v", versions)
+	}
+}
+
+func TestImportDocument_RoundTrip_NestedArray(t *testing.T) {
+	original := parseTestDocument(`
+<xmpDM:Tracks xmlns:xmpDM="http://ns.adobe.com/xmp/1.0/DynamicMedia/">
+  <rdf:Bag>
+    <rdf:li rdf:parseType="Resource">
+      <xmpDM:trackName>Chapters</xmpDM:trackName>
+      <xmpDM:markers>
+        <rdf:Seq>
+          <rdf:li xmpDM:startTime="250f25" xmpDM:name="Intro"/>
+        </rdf:Seq>
+      </xmpDM:markers>
+    </rdf:li>
+  </rdf:Bag>
+</xmpDM:Tracks>`)
+
+	document, err := original.ExportDocument()
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(document.Properties)
+	log.PanicIf(err)
+
+	expected := `[` +
+		`{"path":["x:xmpmeta","xmpDM:Tracks"],"kind":"array","array_kind":"Bag","items":[{"attributes":[{"name":"xmpDM:markers","value":"","array_kind":"Seq","items":[{"attributes":[{"name":"xmpDM:name","value":"Intro"},{"name":"xmpDM:startTime","value":"250f25"}],"value":""}]},{"name":"xmpDM:trackName","value":"Chapters"}],"value":""}]}` +
+		`]`
+
+	if string(encoded) != expected {
+		t.Fatalf("Properties not correct:\n%s", string(encoded))
+	}
+
+	imported, err := ImportDocument(document)
+	log.PanicIf(err)
+
+	report, err := Diff(original, imported, nil)
+	log.PanicIf(err)
+
+	if len(report.Changes) != 0 {
+		t.Fatalf("Imported index differs:\n%s", report.Text())
+	}
+
+	results, err := imported.Get([]string{"[x]xmpmeta", "[xmpDM]Tracks"})
+	log.PanicIf(err)
+
+	tracks, err := results[0].(xmptype.UnorderedTrackArrayValue).Tracks()
+	log.PanicIf(err)
+
+	if len(tracks) != 1 || len(tracks[0].Markers) != 1 || tracks[0].Markers[0].Name != "Intro" {
+		t.Fatalf("Imported tracks not correct: %v", tracks)
+	}
+}
+
+func TestImportDocument_VersionNotSupported(t *testing.T) {
+	document := &Document{
+		Version: DocumentVersion + 1,
+	}
+
+	_, err := ImportDocument(document)
+	if err == nil {
+		t.Fatalf("Expected error for unsupported version.")
+	} else if log.Is(err, ErrDocumentVersionNotSupported) != true {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	}
+}
+
+func TestImportDocument_InvalidValue(t *testing.T) {
+	document := &Document{
+		Version: DocumentVersion,
+		Namespaces: map[string]string{
+			"x":   xmpnamespace.XUri,
+			"xmp": xmpnamespace.XmpUri,
+		},
+		Properties: []DocumentProperty{
+			{
+				Path:  []string{"x:xmpmeta", "xmp:ModifyDate"},
+				Kind:  "scalar",
+				Value: "not a date",
+			},
+		},
+	}
+
+	_, err := ImportDocument(document)
+	if err == nil {
+		t.Fatalf("Expected error for invalid value.")
+	} else if err.Error() != "value not valid for [http://ns.adobe.com/xap/1.0/] [ModifyDate]: [not a date]: value not valid/allowed" {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	}
+}
+
+func TestImportDocument_NamespaceNotRegistered(t *testing.T) {
+	document := &Document{
+		Version: DocumentVersion,
+		Namespaces: map[string]string{
+			"x":  xmpnamespace.XUri,
+			"zz": "http://unregistered/",
+		},
+		Properties: []DocumentProperty{
+			{
+				Path:  []string{"x:xmpmeta", "zz:Something"},
+				Kind:  "scalar",
+				Value: "some value",
+			},
+		},
+	}
+
+	_, err := ImportDocument(document)
+	if err == nil {
+		t.Fatalf("Expected error for unregistered namespace.")
+	} else if err.Error() != "namespace not registered: [http://unregistered/]" {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	}
+}
+
+func TestImportDocument_PrefixNotDeclared(t *testing.T) {
+	document := &Document{
+		Version: DocumentVersion,
+		Properties: []DocumentProperty{
+			{
+				Path:  []string{"x:xmpmeta", "xmp:Label"},
+				Kind:  "scalar",
+				Value: "some label",
+			},
+		},
+	}
+
+	_, err := ImportDocument(document)
+	if err == nil {
+		t.Fatalf("Expected error for undeclared prefix.")
+	} else if err.Error() != "prefix not declared in document: [x]" {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	}
+}
+
+func TestImportDocument_ArrayKindNotValid(t *testing.T) {
+	document := &Document{
+		Version: DocumentVersion,
+		Namespaces: map[string]string{
+			"x":  xmpnamespace.XUri,
+			"dc": xmpnamespace.DcUri,
+		},
+		Properties: []DocumentProperty{
+			{
+				Path:      []string{"x:xmpmeta", "dc:subject"},
+				Kind:      "array",
+				ArrayKind: "List",
+			},
+		},
+	}
+
+	_, err := ImportDocument(document)
+	if err == nil {
+		t.Fatalf("Expected error for invalid array kind.")
+	} else if err.Error() != "array kind not valid: [[x]xmpmeta.[dc]subject] [List]" {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	}
+}
@@ -8,4 +8,5 @@ require (
 	github.com/dsoprea/go-logging v0.0.0-20200517223158-a10564966e9d
 	github.com/dsoprea/go-unicode-byteorder v0.0.0-20200615041543-c108ead3af9c
 	github.com/jessevdk/go-flags v1.4.0
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,290 @@
+package xmp
+
+import (
+	"reflect"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/rdf"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+// buildGraph returns the graph of an index that was not parsed (i.e. merged
+// or imported). The index is written as RDF/XML and read by the same builder
+// that parsed documents are, so the graph describes the properties the same
+// way that the graph of the equivalent document would.
+func (xpi *XmpPropertyIndex) buildGraph() (graph *xmprdf.Graph, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	description := xml.StartElement{
+		Name: xmpnamespace.RdfDescriptionTag,
+		Attr: []xml.Attr{{Name: xmpnamespace.RdfAboutAttribute, Value: ""}},
+	}
+
+	tokens := []xml.Token{
+		xml.StartElement{Name: xmpnamespace.RdfTag},
+		description,
+	}
+
+	for _, entry := range xpi.entries {
+		if entry.isLeaf == false && entry.name.Space == xmpnamespace.XUri {
+			// The properties are wrapped in an "x:xmpmeta" node, which is
+			// not a part of the RDF.
+
+			propertyTokens, err := xpi.subindices[entry.key].propertyTokens()
+			log.PanicIf(err)
+
+			tokens = append(tokens, propertyTokens...)
+
+			continue
+		}
+
+		entryTokens, err := xpi.entryTokens(entry)
+		log.PanicIf(err)
+
+		tokens = append(tokens, entryTokens...)
+	}
+
+	tokens = append(tokens, description.End(), xml.EndElement{Name: xmpnamespace.RdfTag})
+
+	graph = xmprdf.NewGraph()
+	builder := xmprdf.NewBuilder(graph)
+
+	for _, token := range tokens {
+		err := builder.Process(token)
+		log.PanicIf(err)
+	}
+
+	return graph, nil
+}
+
+// propertyTokens returns the RDF/XML property elements of every entry of the
+// index.
+func (xpi *XmpPropertyIndex) propertyTokens() (tokens []xml.Token, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	tokens = make([]xml.Token, 0)
+
+	for _, entry := range xpi.entries {
+		entryTokens, err := xpi.entryTokens(entry)
+		log.PanicIf(err)
+
+		tokens = append(tokens, entryTokens...)
+	}
+
+	return tokens, nil
+}
+
+// entryTokens returns the RDF/XML property elements of a single entry. A
+// subindex is written as a struct ("rdf:parseType='Resource'"). The
+// attributes of a complex node are written on the element of the subindex
+// having the same name, if there is one.
+func (xpi *XmpPropertyIndex) entryTokens(entry indexEntry) (tokens []xml.Token, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	name := xml.Name(entry.name)
+	tokens = make([]xml.Token, 0)
+
+	if entry.isLeaf == false {
+		if _, found := xpi.leaves[entry.key]; found == true {
+			// Written with the complex node.
+			return tokens, nil
+		}
+
+		fieldTokens, err := xpi.subindices[entry.key].propertyTokens()
+		log.PanicIf(err)
+
+		start := xml.StartElement{
+			Name: name,
+			Attr: []xml.Attr{{Name: xmpnamespace.RdfParseTypeAttribute, Value: "Resource"}},
+		}
+
+		tokens = append(tokens, start)
+		tokens = append(tokens, fieldTokens...)
+		tokens = append(tokens, start.End())
+
+		return tokens, nil
+	}
+
+	for _, value := range xpi.leaves[entry.key] {
+		switch v := value.(type) {
+		case ScalarLeafNode:
+			scalarTokens, err := xpi.scalarTokens(name, v)
+			log.PanicIf(err)
+
+			tokens = append(tokens, scalarTokens...)
+		case xmptype.ArrayValue:
+			arrayTokens, err := arrayTokens(name, v)
+			log.PanicIf(err)
+
+			tokens = append(tokens, arrayTokens...)
+		case ComplexLeafNode:
+			attributes, err := xpi.formatAttributes(v)
+			log.PanicIf(err)
+
+			start := xml.StartElement{
+				Name: name,
+			}
+
+			subindex, found := xpi.subindices[entry.key]
+			if found == false {
+				start.Attr = attributes
+				tokens = append(tokens, start, start.End())
+
+				continue
+			}
+
+			fieldTokens, err := subindex.propertyTokens()
+			log.PanicIf(err)
+
+			description := xml.StartElement{
+				Name: xmpnamespace.RdfDescriptionTag,
+				Attr: attributes,
+			}
+
+			tokens = append(tokens, start, description)
+			tokens = append(tokens, fieldTokens...)
+			tokens = append(tokens, description.End(), start.End())
+		default:
+			log.Panicf("leaf not valid: [%s] [%v]", entry.key, reflect.TypeOf(value))
+		}
+	}
+
+	return tokens, nil
+}
+
+// scalarTokens returns the property element of a scalar. A scalar having
+// qualifiers other than "xml:lang" is written in the general qualifier form
+// ("rdf:value").
+func (xpi *XmpPropertyIndex) scalarTokens(name xml.Name, sln ScalarLeafNode) (tokens []xml.Token, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	raw, err := xmptype.FormatValue(sln.ParsedValue)
+	log.PanicIf(err)
+
+	qualifiers := make(map[xml.Name]interface{})
+	for qualifierName, value := range sln.Qualifiers {
+		if qualifierName != xmpnamespace.XmlLangAttribute {
+			qualifiers[qualifierName] = value
+		}
+	}
+
+	start := xml.StartElement{
+		Name: name,
+	}
+
+	if language := sln.Language(); language != "" {
+		start.Attr = []xml.Attr{{Name: xmpnamespace.XmlLangAttribute, Value: language}}
+	}
+
+	if len(qualifiers) == 0 {
+		tokens = []xml.Token{start, xml.CharData(raw), start.End()}
+		return tokens, nil
+	}
+
+	start.Attr = append(start.Attr, xml.Attr{Name: xmpnamespace.RdfParseTypeAttribute, Value: "Resource"})
+
+	value := xml.StartElement{
+		Name: xmpnamespace.RdfValueTag,
+	}
+
+	tokens = []xml.Token{start, value, xml.CharData(raw), value.End()}
+
+	for _, qualifierName := range sortedAttributeNames(xpi.registry, qualifiers) {
+		qualifierRaw, err := xmptype.FormatValue(qualifiers[qualifierName])
+		log.PanicIf(err)
+
+		qualifier := xml.StartElement{
+			Name: qualifierName,
+		}
+
+		tokens = append(tokens, qualifier, xml.CharData(qualifierRaw), qualifier.End())
+	}
+
+	tokens = append(tokens, start.End())
+
+	return tokens, nil
+}
+
+// formatAttributes returns the attributes of a complex node, ordered by name.
+func (xpi *XmpPropertyIndex) formatAttributes(cln ComplexLeafNode) (attributes []xml.Attr, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	names := sortedAttributeNames(xpi.registry, cln)
+	attributes = make([]xml.Attr, len(names))
+
+	for i, name := range names {
+		raw, err := xmptype.FormatValue(cln[name])
+		log.PanicIf(err)
+
+		attributes[i] = xml.Attr{Name: name, Value: raw}
+	}
+
+	return attributes, nil
+}
+
+// arrayTokens returns the property element of an array. The items are written
+// as they were collected, with their values formatted as char-data.
+func arrayTokens(name xml.Name, av xmptype.ArrayValue) (tokens []xml.Token, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	itemElements, err := av.ItemElements()
+	log.PanicIf(err)
+
+	start := xml.StartElement{
+		Name: name,
+	}
+
+	container := xml.StartElement{
+		Name: av.ContainerName(),
+	}
+
+	tokens = []xml.Token{start, container}
+
+	for _, elements := range itemElements {
+		for _, element := range elements {
+			switch e := element.(type) {
+			case xml.StartElement:
+				tokens = append(tokens, e)
+			case xml.EndElement:
+				tokens = append(tokens, e)
+			default:
+				raw, err := xmptype.FormatValue(e)
+				log.PanicIf(err)
+
+				tokens = append(tokens, xml.CharData(raw))
+			}
+		}
+	}
+
+	tokens = append(tokens, container.End(), start.End())
+
+	return tokens, nil
+}
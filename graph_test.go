@@ -0,0 +1,84 @@
+package xmp
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/rdf"
+)
+
+func TestXmpPropertyIndex_buildGraph(t *testing.T) {
+	xpi := parseTestDocument(testDocumentProperties)
+
+	graph, err := xpi.buildGraph()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(graph.Triples(), xpi.Graph().Triples()) != true {
+		t.Fatalf("Graph not correct:\n%v\n\nExpected:\n%v", graph.Triples(), xpi.Graph().Triples())
+	}
+}
+
+func TestXmpPropertyIndex_buildGraph_Qualifiers(t *testing.T) {
+	xpi := parseTestDocument(`
+<dc:source xml:lang="en">some source</dc:source>
+<dc:identifier rdf:parseType="Resource" xmlns:xmpidq="http://ns.adobe.com/xmp/Identifier/qual/1.0/">
+  <rdf:value>some identifier</rdf:value>
+  <xmpidq:Scheme>some scheme</xmpidq:Scheme>
+</dc:identifier>
+<xmpMM:DerivedFrom stRef:documentID="xmp.did:1">
+  <stRef:instanceID>xmp.iid:1</stRef:instanceID>
+</xmpMM:DerivedFrom>`)
+
+	graph, err := xpi.buildGraph()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(graph.Triples(), xpi.Graph().Triples()) != true {
+		t.Fatalf("Graph not correct:\n%v\n\nExpected:\n%v", graph.Triples(), xpi.Graph().Triples())
+	}
+}
+
+func TestXmpPropertyIndex_buildGraph_Exported(t *testing.T) {
+	original := parseTestDocument(testDocumentProperties)
+
+	document, err := original.ExportDocument()
+	log.PanicIf(err)
+
+	imported, err := ImportDocument(document)
+	log.PanicIf(err)
+
+	b := new(bytes.Buffer)
+
+	err = imported.Graph().WriteNTriples(b, "http://some/image.jpg")
+	log.PanicIf(err)
+
+	expected := new(bytes.Buffer)
+
+	err = original.Graph().WriteNTriples(expected, "http://some/image.jpg")
+	log.PanicIf(err)
+
+	if b.String() != expected.String() {
+		t.Fatalf("Imported graph not correct:\n%s\n\nExpected:\n%s", b.String(), expected.String())
+	}
+}
+
+func TestXmpPropertyIndex_Graph_RegistryPrefixes(t *testing.T) {
+	registry := getTestTenantRegistry()
+
+	xpi := parseTestDocumentWithRegistry(registry, `<zz:Count xmlns:zz="http://some/uri/tenant/">1</zz:Count>`)
+
+	graph := xpi.Graph()
+	b := new(bytes.Buffer)
+
+	err := graph.WriteTurtle(b, xmprdf.RegisteredPrefixes(graph, xpi.Registry()))
+	log.PanicIf(err)
+
+	if strings.Contains(b.String(), "@prefix zz: <http://some/uri/tenant/> .") != true {
+		t.Fatalf("Tenant prefix not written:\n%s", b.String())
+	} else if strings.Contains(b.String(), "zz:Count \"1\"") != true {
+		t.Fatalf("Tenant property not compacted:\n%s", b.String())
+	}
+}
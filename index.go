@@ -1,15 +1,20 @@
 package xmp
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
+	"encoding/json"
 	"encoding/xml"
 
 	"github.com/dsoprea/go-logging"
 
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/rdf"
 	"github.com/dsoprea/go-xmp/registry"
 	"github.com/dsoprea/go-xmp/type"
 )
@@ -22,6 +27,10 @@ var (
 	// ErrFieldNotFound represents an error for a get operation that produced
 	// no results.
 	ErrFieldNotFound = errors.New("node not found in document")
+
+	// ErrFieldNotStruct indicates that the field-type registered for a
+	// property is not a struct field-type.
+	ErrFieldNotStruct = errors.New("field is not a struct")
 )
 
 // ValueParser knows how to parse raw values.
@@ -29,26 +38,262 @@ type ValueParser interface {
 	Parse() (parsed interface{}, err error)
 }
 
+// IndexOrder determines the order in which the properties of an index are
+// enumerated when dumping or exporting.
+type IndexOrder int
+
+const (
+	// IndexOrderSorted enumerates properties by namespace prefix and then by
+	// local name. Array items are always kept in document order.
+	IndexOrderSorted IndexOrder = iota
+
+	// IndexOrderDocument enumerates properties in the order in which they were
+	// first encountered in the source packet.
+	IndexOrderDocument
+)
+
+// String returns a string representation of the order.
+func (order IndexOrder) String() string {
+	if order == IndexOrderSorted {
+		return "sorted"
+	} else if order == IndexOrderDocument {
+		return "document"
+	}
+
+	return fmt.Sprintf("IndexOrder<%d>", int(order))
+}
+
+// indexEntry records a single subindex or leaf of an index.
+type indexEntry struct {
+	name   xmpregistry.XmlName
+	key    string
+	isLeaf bool
+}
+
 // XmpPropertyIndex allows for lookups and browsing of found properties.
 type XmpPropertyIndex struct {
 	nodeName   xmpregistry.XmlName
 	subindices map[string]*XmpPropertyIndex
 	leaves     map[string][]interface{}
+
+	// entries has the subindices and leaves in the order in which they were
+	// first added.
+	entries []indexEntry
+
+	// graph is the RDF graph that the index describes. It is only set on the
+	// root index.
+	graph *xmprdf.Graph
+
+	// registry has the namespaces that the values were interpreted with.
+	registry *xmpregistry.Registry
 }
 
-func newXmpPropertyIndex(nodeName xmpregistry.XmlName) *XmpPropertyIndex {
+func newXmpPropertyIndex(registry *xmpregistry.Registry, nodeName xmpregistry.XmlName) *XmpPropertyIndex {
 	subindices := make(map[string]*XmpPropertyIndex)
 	leaves := make(map[string][]interface{})
+	entries := make([]indexEntry, 0)
 
 	xpi := &XmpPropertyIndex{
+		registry:   registry,
 		nodeName:   nodeName,
 		subindices: subindices,
 		leaves:     leaves,
+		entries:    entries,
 	}
 
 	return xpi
 }
 
+// Registry returns the registry that the values were interpreted with.
+func (xpi *XmpPropertyIndex) Registry() *xmpregistry.Registry {
+	return xpi.registry
+}
+
+// namePhrase returns the phrase that the given name is indexed by, using the
+// prefixes of the registry of the index.
+func (xpi *XmpPropertyIndex) namePhrase(name xmpregistry.XmlName) string {
+	return xpi.registry.NamePhrase(name)
+}
+
+// Graph returns the RDF graph that the index describes. When parsing, the
+// graph is populated first and the index is built from it, so the two always
+// agree (e.g. an "rdf:resource" reference is the value of its property and a
+// node described by "rdf:nodeID" is indexed where it is referenced). The
+// graph of a merged or imported index is built from the index. Returns nil for
+// subindices.
+func (xpi *XmpPropertyIndex) Graph() *xmprdf.Graph {
+	return xpi.graph
+}
+
+// orderedEntries returns the subindices and leaves of this node in the given
+// order. When sorting, a subindex sorts before a leaf having the same name.
+func (xpi *XmpPropertyIndex) orderedEntries(order IndexOrder) []indexEntry {
+	entries := make([]indexEntry, len(xpi.entries))
+	copy(entries, xpi.entries)
+
+	if order == IndexOrderDocument {
+		return entries
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].less(xpi.registry, entries[j])
+	})
+
+	return entries
+}
+
+// less returns true if this entry sorts before the other entry. Prefixes are
+// taken from the given registry.
+func (ie indexEntry) less(registry *xmpregistry.Registry, other indexEntry) bool {
+	prefix := registry.Prefix(ie.name.Space)
+	otherPrefix := registry.Prefix(other.name.Space)
+
+	if prefix != otherPrefix {
+		return prefix < otherPrefix
+	} else if ie.name.Local != other.name.Local {
+		return ie.name.Local < other.name.Local
+	} else if ie.name.Space != other.name.Space {
+		return ie.name.Space < other.name.Space
+	}
+
+	return ie.isLeaf == false && other.isLeaf == true
+}
+
+// sortedAttributeNames returns the attribute names sorted by namespace prefix,
+// as registered with the given registry, and then by local name.
+func sortedAttributeNames(registry *xmpregistry.Registry, attributes map[xml.Name]interface{}) []xml.Name {
+	names := make([]xml.Name, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		a := names[i]
+		b := names[j]
+
+		aPrefix := registry.Prefix(a.Space)
+		bPrefix := registry.Prefix(b.Space)
+
+		if aPrefix != bPrefix {
+			return aPrefix < bPrefix
+		} else if a.Local != b.Local {
+			return a.Local < b.Local
+		}
+
+		return a.Space < b.Space
+	})
+
+	return names
+}
+
+// OrderedExport is an exported node whose keys keep the order in which they
+// were enumerated. It encodes to a JSON object having its keys in that order.
+type OrderedExport struct {
+	// Keys are the keys in order.
+	Keys []string
+
+	// Values are the exported values for each key.
+	Values map[string]interface{}
+}
+
+func newOrderedExport() *OrderedExport {
+	return &OrderedExport{
+		Keys:   make([]string, 0),
+		Values: make(map[string]interface{}),
+	}
+}
+
+// Set adds or replaces a value. New keys are appended.
+func (oe *OrderedExport) Set(key string, value interface{}) {
+	if _, found := oe.Values[key]; found == false {
+		oe.Keys = append(oe.Keys, key)
+	}
+
+	oe.Values[key] = value
+}
+
+// MarshalJSON encodes the node as a JSON object with ordered keys.
+func (oe *OrderedExport) MarshalJSON() (encoded []byte, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	b := new(bytes.Buffer)
+	b.WriteString("{")
+
+	for i, key := range oe.Keys {
+		if i > 0 {
+			b.WriteString(",")
+		}
+
+		encodedKey, err := json.Marshal(key)
+		log.PanicIf(err)
+
+		encodedValue, err := json.Marshal(oe.Values[key])
+		log.PanicIf(err)
+
+		b.Write(encodedKey)
+		b.WriteString(":")
+		b.Write(encodedValue)
+	}
+
+	b.WriteString("}")
+
+	return b.Bytes(), nil
+}
+
+// Map returns the node (and any nested ordered nodes) as plain maps.
+func (oe *OrderedExport) Map() map[string]interface{} {
+	flat := make(map[string]interface{}, len(oe.Keys))
+	for _, key := range oe.Keys {
+		flat[key] = unorderExported(oe.Values[key])
+	}
+
+	return flat
+}
+
+// unorderExported recursively replaces ordered nodes with plain maps.
+func unorderExported(value interface{}) interface{} {
+	if oe, ok := value.(*OrderedExport); ok == true {
+		return oe.Map()
+	} else if list, ok := value.([]interface{}); ok == true {
+		flat := make([]interface{}, len(list))
+		for i, item := range list {
+			flat[i] = unorderExported(item)
+		}
+
+		return flat
+	}
+
+	return value
+}
+
+// exportAttributes returns the attributes as an ordered node. Nested structs
+// are exported as nested nodes and nested arrays as their items.
+func (xpi *XmpPropertyIndex) exportAttributes(attributes map[xml.Name]interface{}, doPrintSimplified bool) *OrderedExport {
+	exported := newOrderedExport()
+
+	for _, name := range sortedAttributeNames(xpi.registry, attributes) {
+		namePhrase := xpi.namePhrase(xmpregistry.XmlName(name))
+
+		switch value := attributes[name].(type) {
+		case xmptype.StructValue:
+			exported.Set(namePhrase, xpi.exportAttributes(value, doPrintSimplified))
+		case xmptype.ArrayValue:
+			encoded, err := xpi.exportValue(value, doPrintSimplified)
+			log.PanicIf(err)
+
+			exported.Set(namePhrase, encoded)
+		default:
+			exported.Set(namePhrase, value)
+		}
+	}
+
+	return exported
+}
+
 func (xpi *XmpPropertyIndex) exportValue(value interface{}, doPrintSimplified bool) (encoded interface{}, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
@@ -62,29 +307,34 @@ func (xpi *XmpPropertyIndex) exportValue(value interface{}, doPrintSimplified bo
 		items, err := ail.Items()
 		log.PanicIf(err)
 
-		ail = ail
-
 		distilled := make([]interface{}, len(items))
 		for i, ai := range items {
-			attributes := make(map[string]interface{})
+			attributes := xpi.exportAttributes(ai.Attributes, doPrintSimplified)
 
-			for name, value := range ai.Attributes {
-				namePhrase := xmpregistry.XmlName(name).String()
-				attributes[namePhrase] = value
-			}
+			if len(ai.Qualifiers) > 0 {
+				// Qualified items always need the full structure.
+
+				encodedComplex := newOrderedExport()
+
+				if len(attributes.Keys) > 0 {
+					encodedComplex.Set("Attributes", attributes)
+				}
+
+				encodedComplex.Set("CharData", ai.CharData)
+				encodedComplex.Set("Qualifiers", xpi.exportAttributes(ai.Qualifiers, doPrintSimplified))
 
-			if len(attributes) > 0 {
+				distilled[i] = encodedComplex
+			} else if len(attributes.Keys) > 0 {
 				// If there are attributes, then only include the char-data if
 				// non-empty. Very frequently, values expressed as attributes
 				// are not paired with char-data. So, that just adds pollution
 				// to the output.
 
-				encodedComplex := map[string]interface{}{
-					"Attributes": attributes,
-				}
+				encodedComplex := newOrderedExport()
+				encodedComplex.Set("Attributes", attributes)
 
 				if ai.CharData != "" {
-					encodedComplex["CharData"] = ai.CharData
+					encodedComplex.Set("CharData", ai.CharData)
 
 					distilled[i] = encodedComplex
 				} else {
@@ -98,9 +348,10 @@ func (xpi *XmpPropertyIndex) exportValue(value interface{}, doPrintSimplified bo
 				if doPrintSimplified == true {
 					distilled[i] = ai.CharData
 				} else {
-					distilled[i] = map[string]interface{}{
-						"CharData": ai.CharData,
-					}
+					encodedComplex := newOrderedExport()
+					encodedComplex.Set("CharData", ai.CharData)
+
+					distilled[i] = encodedComplex
 				}
 			}
 		}
@@ -112,23 +363,24 @@ func (xpi *XmpPropertyIndex) exportValue(value interface{}, doPrintSimplified bo
 
 		return items, nil
 	} else if sln, ok := value.(ScalarLeafNode); ok == true {
-		return sln.ParsedValue, nil
-	} else if cln, ok := value.(ComplexLeafNode); ok == true {
-		exported := make(map[string]interface{})
-
-		for name, value := range cln {
-			namePhrase := xmpregistry.XmlName(name).String()
-			exported[namePhrase] = value
+		if len(sln.Qualifiers) == 0 {
+			return sln.ParsedValue, nil
 		}
 
-		return exported, nil
+		encodedQualified := newOrderedExport()
+		encodedQualified.Set("Value", sln.ParsedValue)
+		encodedQualified.Set("Qualifiers", xpi.exportAttributes(sln.Qualifiers, doPrintSimplified))
+
+		return encodedQualified, nil
+	} else if cln, ok := value.(ComplexLeafNode); ok == true {
+		return xpi.exportAttributes(cln, doPrintSimplified), nil
 	}
 
 	log.Panicf("can not dump unhandled value: [%v]", reflect.TypeOf(value))
 	panic(nil)
 }
 
-func (xpi *XmpPropertyIndex) export(xmp xmpregistry.XmpPropertyName, doPrintSimplified bool) (exported map[string]interface{}, err error) {
+func (xpi *XmpPropertyIndex) export(xmp xmpregistry.XmpPropertyName, doPrintSimplified bool, order IndexOrder) (exported *OrderedExport, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
 			err = log.Wrap(errRaw.(error))
@@ -137,32 +389,37 @@ func (xpi *XmpPropertyIndex) export(xmp xmpregistry.XmpPropertyName, doPrintSimp
 
 	// TODO(dustin): Add test
 
-	exported = make(map[string]interface{})
+	exported = newOrderedExport()
 
-	for _, subindex := range xpi.subindices {
-		currentXpn := append(xmp, xpi.nodeName)
+	for _, entry := range xpi.orderedEntries(order) {
+		if entry.isLeaf == false {
+			subindex := xpi.subindices[entry.key]
+			currentXpn := append(xmp, xpi.nodeName)
 
-		currentExported, err := subindex.export(currentXpn, doPrintSimplified)
-		log.PanicIf(err)
+			currentExported, err := subindex.export(currentXpn, doPrintSimplified, order)
+			log.PanicIf(err)
 
-		exportedKey := subindex.nodeName.String()
+			exportedKey := xpi.namePhrase(subindex.nodeName)
 
-		exported[exportedKey] = currentExported
-	}
+			exported.Set(exportedKey, currentExported)
+
+			continue
+		}
+
+		values := xpi.leaves[entry.key]
 
-	for key, values := range xpi.leaves {
 		encodedValues := make([]interface{}, len(values))
 		for i, value := range values {
 			encodedValue, err := xpi.exportValue(value, doPrintSimplified)
 			if err != nil {
-				mainLogger.Errorf(nil, err, "%s: Had trouble enumerating array items under leaf (%d).", key, i)
+				mainLogger.Errorf(nil, err, "%s: Had trouble enumerating array items under leaf (%d).", entry.key, i)
 				log.Panic(err)
 			}
 
 			encodedValues[i] = encodedValue
 		}
 
-		exported[key] = encodedValues
+		exported.Set(entry.key, encodedValues)
 	}
 
 	return exported, nil
@@ -191,9 +448,25 @@ func (xpi *XmpPropertyIndex) Export(doPrintSimplified bool) (exported map[string
 
 	// TODO(dustin): Add test
 
+	oe, err := xpi.ExportOrdered(doPrintSimplified, IndexOrderSorted)
+	log.PanicIf(err)
+
+	return oe.Map(), nil
+}
+
+// ExportOrdered is equivalent to Export except that the structure retains the
+// given ordering of properties when encoded to JSON. Attributes are always
+// sorted, and array items are always in document order.
+func (xpi *XmpPropertyIndex) ExportOrdered(doPrintSimplified bool, order IndexOrder) (exported *OrderedExport, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
 	rootXpn := make(xmpregistry.XmpPropertyName, 0)
 
-	exported, err = xpi.export(rootXpn, doPrintSimplified)
+	exported, err = xpi.export(rootXpn, doPrintSimplified, order)
 	log.PanicIf(err)
 
 	return exported, nil
@@ -207,13 +480,13 @@ func (xpi *XmpPropertyIndex) addValue(xpn xmpregistry.XmpPropertyName, value int
 	}()
 
 	currentNodeName := xpn[0]
-	currentNodeNamePhrase := currentNodeName.String()
+	currentNodeNamePhrase := xpi.namePhrase(currentNodeName)
 
 	if len(xpn) > 1 {
 		subindex, found := xpi.subindices[currentNodeNamePhrase]
 
 		if found == false {
-			subindex = newXmpPropertyIndex(currentNodeName)
+			subindex = newXmpPropertyIndex(xpi.registry, currentNodeName)
 		}
 
 		err := subindex.addValue(xpn[1:], value)
@@ -221,12 +494,27 @@ func (xpi *XmpPropertyIndex) addValue(xpn xmpregistry.XmpPropertyName, value int
 
 		if found == false {
 			xpi.subindices[currentNodeNamePhrase] = subindex
+
+			entry := indexEntry{
+				name: currentNodeName,
+				key:  currentNodeNamePhrase,
+			}
+
+			xpi.entries = append(xpi.entries, entry)
 		}
 	} else {
 		if currentLeaves, found := xpi.leaves[currentNodeNamePhrase]; found == true {
 			xpi.leaves[currentNodeNamePhrase] = append(currentLeaves, value)
 		} else {
 			xpi.leaves[currentNodeNamePhrase] = []interface{}{value}
+
+			entry := indexEntry{
+				name:   currentNodeName,
+				key:    currentNodeNamePhrase,
+				isLeaf: true,
+			}
+
+			xpi.entries = append(xpi.entries, entry)
 		}
 	}
 
@@ -254,6 +542,20 @@ func (xpi *XmpPropertyIndex) addArrayValue(xpn xmpregistry.XmpPropertyName, arra
 type ScalarLeafNode struct {
 	Name        xml.Name
 	ParsedValue interface{}
+
+	// Qualifiers are the qualifiers of the value, if any. Nil otherwise.
+	Qualifiers xmptype.Qualifiers
+}
+
+// Language returns the "xml:lang" qualifier of the value or an empty string
+// if there isn't one.
+func (sln ScalarLeafNode) Language() string {
+	return sln.Qualifiers.Language()
+}
+
+// Qualifier returns the value of the given qualifier.
+func (sln ScalarLeafNode) Qualifier(uri string, local string) (value interface{}, found bool) {
+	return sln.Qualifiers.Get(uri, local)
 }
 
 func (xpi *XmpPropertyIndex) addScalarValue(xpn xmpregistry.XmpPropertyName, parsedValue interface{}) (err error) {
@@ -263,15 +565,33 @@ func (xpi *XmpPropertyIndex) addScalarValue(xpn xmpregistry.XmpPropertyName, par
 		}
 	}()
 
+	err = xpi.addQualifiedScalarValue(xpn, parsedValue, nil)
+	log.PanicIf(err)
+
+	return nil
+}
+
+func (xpi *XmpPropertyIndex) addQualifiedScalarValue(xpn xmpregistry.XmpPropertyName, parsedValue interface{}, qualifiers xmptype.Qualifiers) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
 	if len(xpn) == 0 {
 		log.Panicf("scalar value must have non-empty property-name")
 	}
 
 	currentNodeName := xpn[len(xpn)-1]
 
+	if len(qualifiers) == 0 {
+		qualifiers = nil
+	}
+
 	sln := ScalarLeafNode{
 		Name:        xml.Name(currentNodeName),
 		ParsedValue: parsedValue,
+		Qualifiers:  qualifiers,
 	}
 
 	err = xpi.addValue(xpn, sln)
@@ -314,9 +634,144 @@ func (xpi *XmpPropertyIndex) addComplexValue(xpn xmpregistry.XmpPropertyName, at
 	return nil
 }
 
+// subindex returns the subindex at the given path or nil if there isn't one.
+func (xpi *XmpPropertyIndex) subindex(xpn xmpregistry.XmpPropertyName) *XmpPropertyIndex {
+	subindex, found := xpi.subindices[xpi.namePhrase(xpn[0])]
+	if found == false {
+		return nil
+	} else if len(xpn) > 1 {
+		return subindex.subindex(xpn[1:])
+	}
+
+	return subindex
+}
+
+// removeSubindex detaches the subindex at the given path, if there is one.
+func (xpi *XmpPropertyIndex) removeSubindex(xpn xmpregistry.XmpPropertyName) {
+	currentNodeNamePhrase := xpi.namePhrase(xpn[0])
+
+	subindex, found := xpi.subindices[currentNodeNamePhrase]
+	if found == false {
+		return
+	} else if len(xpn) > 1 {
+		subindex.removeSubindex(xpn[1:])
+		return
+	}
+
+	delete(xpi.subindices, currentNodeNamePhrase)
+
+	for i, entry := range xpi.entries {
+		if entry.isLeaf == false && entry.key == currentNodeNamePhrase {
+			xpi.entries = append(xpi.entries[:i], xpi.entries[i+1:]...)
+			break
+		}
+	}
+}
+
+// removeProperty detaches both the leaves and the subindex at the given path.
+func (xpi *XmpPropertyIndex) removeProperty(xpn xmpregistry.XmpPropertyName) {
+	xpi.removeSubindex(xpn)
+
+	parent := xpi
+	if len(xpn) > 1 {
+		parent = xpi.subindex(xpn[:len(xpn)-1])
+		if parent == nil {
+			return
+		}
+	}
+
+	namePhrase := parent.namePhrase(xpn[len(xpn)-1])
+
+	if _, found := parent.leaves[namePhrase]; found == false {
+		return
+	}
+
+	delete(parent.leaves, namePhrase)
+
+	for i, entry := range parent.entries {
+		if entry.isLeaf == true && entry.key == namePhrase {
+			parent.entries = append(parent.entries[:i], parent.entries[i+1:]...)
+			break
+		}
+	}
+}
+
+// scalarQualifiers returns the scalar leaves of this node as qualifiers. This
+// is only possible if the node has nothing but single scalar leaves.
+func (xpi *XmpPropertyIndex) scalarQualifiers() (qualifiers xmptype.Qualifiers, ok bool) {
+	if len(xpi.subindices) > 0 {
+		return nil, false
+	}
+
+	qualifiers = make(xmptype.Qualifiers)
+
+	for _, entry := range xpi.entries {
+		values := xpi.leaves[entry.key]
+		if len(values) != 1 {
+			return nil, false
+		}
+
+		sln, ok := values[0].(ScalarLeafNode)
+		if ok == false {
+			return nil, false
+		}
+
+		qualifiers[xml.Name(entry.name)] = sln.ParsedValue
+	}
+
+	return qualifiers, true
+}
+
 // Get searches the index for the property with the name represented by the
-// string slice.
+// string slice. A property that is registered as an alias is found as its
+// base property.
 func (xpi *XmpPropertyIndex) Get(namePhraseSlice []string) (results []interface{}, err error) {
+	results, err = xpi.get(namePhraseSlice)
+	if err != ErrFieldNotFound {
+		return results, err
+	}
+
+	resolved, found := xpi.resolveAliasPhrases(namePhraseSlice)
+	if found == false {
+		return nil, err
+	}
+
+	return xpi.get(resolved)
+}
+
+// resolveAliasPhrases replaces the phrases of any registered aliases with the
+// phrases of their base properties.
+func (xpi *XmpPropertyIndex) resolveAliasPhrases(namePhraseSlice []string) (resolved []string, found bool) {
+	resolved = make([]string, len(namePhraseSlice))
+
+	for i, phrase := range namePhraseSlice {
+		resolved[i] = phrase
+
+		end := strings.Index(phrase, "]")
+		if strings.HasPrefix(phrase, "[") == false || end == -1 {
+			continue
+		}
+
+		namespace, err := xpi.registry.LookupByPrefix(phrase[1:end])
+		if err != nil {
+			continue
+		}
+
+		name := xml.Name{
+			Space: namespace.Uri,
+			Local: phrase[end+1:],
+		}
+
+		if alias, isAlias := xpi.registry.ResolveAlias(name); isAlias == true {
+			resolved[i] = xpi.namePhrase(xmpregistry.XmlName(alias.Base))
+			found = true
+		}
+	}
+
+	return resolved, found
+}
+
+func (xpi *XmpPropertyIndex) get(namePhraseSlice []string) (results []interface{}, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
 			err = log.Wrap(errRaw.(error))
@@ -332,7 +787,7 @@ func (xpi *XmpPropertyIndex) Get(namePhraseSlice []string) (results []interface{
 			return nil, ErrFieldNotFound
 		}
 
-		values, err := subindex.Get(namePhraseSlice[1:])
+		values, err := subindex.get(namePhraseSlice[1:])
 		if err != nil {
 			if err == ErrFieldNotFound {
 				return nil, err
@@ -353,12 +808,148 @@ func (xpi *XmpPropertyIndex) Get(namePhraseSlice []string) (results []interface{
 	return nil, ErrFieldNotFound
 }
 
-func (xpi *XmpPropertyIndex) dump(prefix []string) {
-	for name, subindex := range xpi.subindices {
-		subindex.dump(append(prefix, name))
+// GetStruct returns the value of the given property as parsed by the struct
+// field-type registered for it (e.g. a ResourceRef for "xmpMM:DerivedFrom").
+// The fields may be attributes of the property node or child nodes.
+func (xpi *XmpPropertyIndex) GetStruct(xpn xmpregistry.XmpPropertyName) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sft, ok := lookupFieldType(xpi.registry, xpn).(xmptype.StructFieldType)
+	if ok == false {
+		return nil, ErrFieldNotStruct
+	}
+
+	fields, found := xpi.structFields(xpn)
+	if found == false {
+		return nil, ErrFieldNotFound
+	}
+
+	parsed, err = sft.ParseStruct(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// GetProperty returns the value of the given top-level property: the parsed
+// value of a scalar, the ArrayValue of an array, or the struct as parsed by
+// its registered field-type. Properties are found whether or not they are
+// wrapped in an "x:xmpmeta" node, and a registered alias is found as its base
+// property. This satisfies xmpregistry.PropertyGetter.
+func (xpi *XmpPropertyIndex) GetProperty(name xmpregistry.XmlName) (value interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if alias, found := xpi.registry.ResolveAlias(xml.Name(name)); found == true {
+		name = xmpregistry.XmlName(alias.Base)
+	}
+
+	xpn := xmpregistry.XmpPropertyName{name}
+	parent := xpi
+
+	for _, entry := range xpi.entries {
+		if entry.isLeaf == false && entry.name.Space == xmpnamespace.XUri {
+			xpn = xmpregistry.XmpPropertyName{entry.name, name}
+			parent = xpi.subindices[entry.key]
+
+			break
+		}
+	}
+
+	if _, ok := lookupFieldType(xpi.registry, xpn).(xmptype.StructFieldType); ok == true {
+		return xpi.GetStruct(xpn)
+	}
+
+	values := parent.leaves[parent.namePhrase(name)]
+	if len(values) == 0 {
+		return nil, ErrFieldNotFound
+	}
+
+	switch v := values[0].(type) {
+	case ScalarLeafNode:
+		return v.ParsedValue, nil
+	case xmptype.ArrayValue:
+		return v, nil
+	}
+
+	log.Panicf("property is not a scalar or an array: [%s] [%v]", xpn, reflect.TypeOf(values[0]))
+
+	// Not reachable.
+	return nil, nil
+}
+
+// structFields collects the fields of the given struct property from both
+// the attributes of its node and its child nodes. Child arrays are returned as
+// their ArrayValue.
+func (xpi *XmpPropertyIndex) structFields(xpn xmpregistry.XmpPropertyName) (fields map[xml.Name]interface{}, found bool) {
+	parent := xpi
+	if len(xpn) > 1 {
+		parent = xpi.subindex(xpn[:len(xpn)-1])
+		if parent == nil {
+			return nil, false
+		}
+	}
+
+	currentNodeNamePhrase := parent.namePhrase(xpn[len(xpn)-1])
+	fields = make(map[xml.Name]interface{})
+
+	for _, value := range parent.leaves[currentNodeNamePhrase] {
+		if cln, ok := value.(ComplexLeafNode); ok == true {
+			found = true
+
+			for name, attributeValue := range cln {
+				fields[name] = attributeValue
+			}
+		}
+	}
+
+	if subindex, ok := parent.subindices[currentNodeNamePhrase]; ok == true {
+		found = true
+
+		for _, entry := range subindex.entries {
+			if entry.isLeaf == false {
+				continue
+			}
+
+			value := subindex.leaves[entry.key][0]
+
+			if sln, ok := value.(ScalarLeafNode); ok == true {
+				fields[xml.Name(entry.name)] = sln.ParsedValue
+			} else if av, ok := value.(xmptype.ArrayValue); ok == true {
+				fields[xml.Name(entry.name)] = av
+			}
+		}
 	}
 
-	for name, values := range xpi.leaves {
+	return fields, found
+}
+
+func (xpi *XmpPropertyIndex) dump(prefix []string, order IndexOrder) {
+	for _, entry := range xpi.orderedEntries(order) {
+		name := entry.key
+
+		if entry.isLeaf == false {
+			subindex := xpi.subindices[name]
+
+			// Copy so that siblings never share the backing array.
+			subprefix := make([]string, len(prefix), len(prefix)+1)
+			copy(subprefix, prefix)
+
+			subindex.dump(append(subprefix, name), order)
+
+			continue
+		}
+
+		values := xpi.leaves[name]
+
 		fqName := append(prefix, name)
 		fqNamePhrase := strings.Join(fqName, ".")
 
@@ -379,21 +970,50 @@ func (xpi *XmpPropertyIndex) dump(prefix []string) {
 
 				fmt.Printf("\n")
 
+			} else if apil, ok := value.(xmptype.ArrayParsedItemLister); ok == true {
+				items, err := apil.ParsedItems()
+				if err == xmptype.ErrArrayItemTypeNotDeclared {
+					items, err = rawParsedItems(value)
+				}
+
+				if err != nil {
+					fmt.Printf("%s: Had trouble enumerating array items under leaf (%d).\n\n", fqNamePhrase, i)
+					log.Panic(err)
+				}
+
+				fmt.Printf("%s:\n\n  ARRAY [%s]\n  COUNT (%d)\n", fqNamePhrase, reflect.TypeOf(apil), len(items))
+				fmt.Printf("\n")
+
+				for i, pai := range items {
+					if pai.Err != nil {
+						fmt.Printf("  Item (%d): NOT VALID [%s]: %s\n", i, pai.CharData, pai.Err)
+					} else {
+						fmt.Printf("  Item (%d): [%s] %v\n", i, reflect.TypeOf(pai.Value), pai.Value)
+					}
+				}
+
+				fmt.Printf("\n")
 			} else if sln, ok := value.(ScalarLeafNode); ok == true {
 				fmt.Printf("%s:\n\n   SCALAR\n", fqNamePhrase)
 				fmt.Printf("\n")
 
-				namePhrase := xmpregistry.XmlName(sln.Name).String()
+				namePhrase := xpi.namePhrase(xmpregistry.XmlName(sln.Name))
 
 				fmt.Printf("  %s = [%s] [%v]\n", namePhrase, reflect.TypeOf(sln.ParsedValue), sln.ParsedValue)
 
+				for _, name := range sortedAttributeNames(xpi.registry, sln.Qualifiers) {
+					value := sln.Qualifiers[name]
+					fmt.Printf("  QUALIFIER %s: [%s] [%v]\n", xpi.namePhrase(xmpregistry.XmlName(name)), reflect.TypeOf(value), value)
+				}
+
 				fmt.Printf("\n")
 			} else if cln, ok := value.(ComplexLeafNode); ok == true {
 				fmt.Printf("%s:\n\n  COMPLEX\n", fqNamePhrase)
 				fmt.Printf("\n")
 
-				for name, value := range cln {
-					fmt.Printf("  %s: [%s] [%v]\n", xmpregistry.XmlName(name), reflect.TypeOf(value), value)
+				for _, name := range sortedAttributeNames(xpi.registry, cln) {
+					value := cln[name]
+					fmt.Printf("  %s: [%s] [%v]\n", xpi.namePhrase(xmpregistry.XmlName(name)), reflect.TypeOf(value), value)
 				}
 
 				fmt.Printf("\n")
@@ -404,9 +1024,43 @@ func (xpi *XmpPropertyIndex) dump(prefix []string) {
 	}
 }
 
-// Dump prints all of the properties in the index.
+// rawParsedItems returns the items of an array that has no item-type with
+// their char-data as the value.
+func rawParsedItems(value interface{}) (items []xmptype.ParsedArrayItem, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	ail, ok := value.(xmptype.ArrayItemLister)
+	if ok == false {
+		log.Panicf("array does not support items: [%v]", reflect.TypeOf(value))
+	}
+
+	rawItems, err := ail.Items()
+	log.PanicIf(err)
+
+	items = make([]xmptype.ParsedArrayItem, len(rawItems))
+	for i, ai := range rawItems {
+		items[i] = xmptype.ParsedArrayItem{
+			ArrayItem: ai,
+			Value:     ai.CharData,
+		}
+	}
+
+	return items, nil
+}
+
+// Dump prints all of the properties in the index, sorted by namespace prefix
+// and then by local name.
 func (xpi *XmpPropertyIndex) Dump() {
-	xpi.dump([]string{})
+	xpi.dump([]string{}, IndexOrderSorted)
+}
+
+// DumpOrdered prints all of the properties in the index in the given order.
+func (xpi *XmpPropertyIndex) DumpOrdered(order IndexOrder) {
+	xpi.dump([]string{}, order)
 }
 
 // Count returns the number of entries.
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"encoding/json"
 	"encoding/xml"
 
 	"github.com/dsoprea/go-logging"
@@ -21,7 +22,7 @@ var (
 )
 
 func TestNewXmpPropertyIndex(t *testing.T) {
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 	if xpi.subindices == nil {
 		t.Fatalf("subindices not initialized.")
 	} else if xpi.leaves == nil {
@@ -30,7 +31,7 @@ func TestNewXmpPropertyIndex(t *testing.T) {
 }
 
 func getTestIndex() *XmpPropertyIndex {
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 
 	microsoftphotoNamespaceUri := "http://ns.microsoft.com/photo/1.0/"
 
@@ -306,7 +307,7 @@ func TestXmpPropertyIndex_Dump(t *testing.T) {
 
 func TestXmpPropertyIndex_dump(t *testing.T) {
 	xpi := getTestIndex()
-	xpi.dump([]string{})
+	xpi.dump([]string{}, IndexOrderSorted)
 }
 
 func TestXmpPropertyIndex_addValue_One_OneLevel(t *testing.T) {
@@ -318,7 +319,7 @@ func TestXmpPropertyIndex_addValue_One_OneLevel(t *testing.T) {
 		Local: "test_name",
 	}
 
-	xpi := newXmpPropertyIndex(xn)
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xn)
 
 	if len(xpi.leaves) != 0 {
 		t.Fatalf("Expected no initial leaves.")
@@ -352,7 +353,7 @@ func TestXmpPropertyIndex_addValue_One_MultipleLevel(t *testing.T) {
 		Local: "root_name",
 	}
 
-	xpi := newXmpPropertyIndex(rootXn)
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), rootXn)
 
 	if len(xpi.subindices) != 0 {
 		t.Fatalf("Expected zero subindices.")
@@ -418,7 +419,7 @@ func TestXmpPropertyIndex_addValue_Multiple_OneLevel(t *testing.T) {
 		Local: "test_name",
 	}
 
-	xpi := newXmpPropertyIndex(xn)
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xn)
 
 	if len(xpi.leaves) != 0 {
 		t.Fatalf("Expected no initial leaves.")
@@ -486,7 +487,7 @@ func TestXmpPropertyIndex_addValue_Multiple_MultipleLevels_RandomAccess(t *testi
 		Local: "test_name",
 	}
 
-	xpi := newXmpPropertyIndex(xn)
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xn)
 
 	if len(xpi.leaves) != 0 {
 		t.Fatalf("Expected no initial leaves.")
@@ -672,7 +673,7 @@ func TestXmpPropertyIndex_addArrayValue(t *testing.T) {
 		Local: "root_node",
 	}
 
-	xpi := newXmpPropertyIndex(xnRoot)
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xnRoot)
 
 	oreaft := xmptype.OrderedResourceEventArrayFieldType{}
 
@@ -689,7 +690,7 @@ func TestXmpPropertyIndex_addArrayValue(t *testing.T) {
 		33,
 	}
 
-	avOriginal := oreaft.New(xpn1, items)
+	avOriginal := oreaft.New(xmpregistry.Default(), xpn1, items)
 
 	err := xpi.addArrayValue(xpn1, avOriginal)
 	log.PanicIf(err)
@@ -719,7 +720,7 @@ func TestXmpPropertyIndex_addScalarValue(t *testing.T) {
 		Local: "root_node",
 	}
 
-	xpi := newXmpPropertyIndex(xnRoot)
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xnRoot)
 
 	xn1 := xmpregistry.XmlName{
 		Space: "space/uri",
@@ -750,6 +751,262 @@ func TestXmpPropertyIndex_addScalarValue(t *testing.T) {
 	}
 }
 
+func TestXmpPropertyIndex_addQualifiedScalarValue(t *testing.T) {
+	xmpregistry.Clear()
+	defer xmpregistry.Clear()
+
+	xnRoot := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "root_node",
+	}
+
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xnRoot)
+
+	xn1 := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "test_node1",
+	}
+
+	xpn1 := xmpregistry.XmpPropertyName{xnRoot, xn1}
+
+	qualifierName := xml.Name{
+		Space: "space/uri",
+		Local: "test_qualifier1",
+	}
+
+	qualifiers := xmptype.Qualifiers{
+		qualifierName: "some qualifier",
+	}
+
+	err := xpi.addQualifiedScalarValue(xpn1, "some value", qualifiers)
+	log.PanicIf(err)
+
+	results, err := xpi.Get([]string{"[?]root_node", "[?]test_node1"})
+	log.PanicIf(err)
+
+	sln := results[0].(ScalarLeafNode)
+
+	if sln.ParsedValue != "some value" {
+		t.Fatalf("Value not correct: [%v]", sln.ParsedValue)
+	} else if value, found := sln.Qualifier("space/uri", "test_qualifier1"); found != true || value != "some qualifier" {
+		t.Fatalf("Qualifier not correct: [%v]", value)
+	}
+
+	exported, err := xpi.exportValue(sln, true)
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(exported)
+	log.PanicIf(err)
+
+	if string(encoded) != `{"Value":"some value","Qualifiers":{"[?]test_qualifier1":"some qualifier"}}` {
+		t.Fatalf("Exported value not correct: %s", string(encoded))
+	}
+}
+
+func TestXmpPropertyIndex_scalarQualifiers(t *testing.T) {
+	xmpregistry.Clear()
+	defer xmpregistry.Clear()
+
+	xnRoot := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "root_node",
+	}
+
+	xn1 := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "test_node1",
+	}
+
+	xn2 := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "test_node2",
+	}
+
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xnRoot)
+
+	err := xpi.addScalarValue(xmpregistry.XmpPropertyName{xnRoot, xn1, xn2}, "some value")
+	log.PanicIf(err)
+
+	xpn := xmpregistry.XmpPropertyName{xnRoot, xn1}
+
+	subindex := xpi.subindex(xpn)
+	if subindex == nil {
+		t.Fatalf("Subindex not found.")
+	}
+
+	qualifiers, ok := subindex.scalarQualifiers()
+	if ok != true {
+		t.Fatalf("Expected simple values to be qualifiers.")
+	} else if reflect.DeepEqual(qualifiers, xmptype.Qualifiers{xml.Name(xn2): "some value"}) != true {
+		t.Fatalf("Qualifiers not correct: %v", qualifiers)
+	}
+
+	// A nested node can not be a qualifier.
+
+	_, ok = xpi.subindex(xmpregistry.XmpPropertyName{xnRoot}).scalarQualifiers()
+	if ok != false {
+		t.Fatalf("Expected nested node to not be a qualifier.")
+	}
+
+	xpi.removeSubindex(xpn)
+
+	if xpi.subindex(xpn) != nil {
+		t.Fatalf("Expected subindex to be removed.")
+	} else if xpi.Count() != 0 {
+		t.Fatalf("Expected index to be empty: (%d)", xpi.Count())
+	}
+
+	entries := xpi.subindex(xmpregistry.XmpPropertyName{xnRoot}).entries
+	if len(entries) != 0 {
+		t.Fatalf("Expected entry to be removed: %v", entries)
+	}
+}
+
+func TestXmpPropertyIndex_removeProperty(t *testing.T) {
+	xmpregistry.Clear()
+	defer xmpregistry.Clear()
+
+	xnRoot := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "root_node",
+	}
+
+	xn1 := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "test_node1",
+	}
+
+	xn2 := xmpregistry.XmlName{
+		Space: "space/uri",
+		Local: "test_node2",
+	}
+
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xnRoot)
+
+	err := xpi.addScalarValue(xmpregistry.XmpPropertyName{xnRoot, xn1}, "some value")
+	log.PanicIf(err)
+
+	err = xpi.addScalarValue(xmpregistry.XmpPropertyName{xnRoot, xn1, xn2}, "nested value")
+	log.PanicIf(err)
+
+	err = xpi.addScalarValue(xmpregistry.XmpPropertyName{xnRoot, xn2}, "other value")
+	log.PanicIf(err)
+
+	xpi.removeProperty(xmpregistry.XmpPropertyName{xnRoot, xn1})
+
+	if xpi.Count() != 1 {
+		t.Fatalf("Expected only the other property to remain: (%d)", xpi.Count())
+	}
+
+	entries := xpi.subindex(xmpregistry.XmpPropertyName{xnRoot}).entries
+	if len(entries) != 1 || entries[0].name != xn2 {
+		t.Fatalf("Entries not correct: %v", entries)
+	}
+}
+
+func TestXmpPropertyIndex_GetStruct(t *testing.T) {
+	xmpregistry.Clear()
+	defer xmpregistry.Clear()
+
+	xmpregistry.Register(xmpnamespace.XmpMmNamespace)
+	xmpregistry.Register(xmpnamespace.StRefNamespace)
+
+	xnRoot := xmpregistry.XmlName{
+		Space: xmpnamespace.XUri,
+		Local: "xmpmeta",
+	}
+
+	xnDerivedFrom := xmpregistry.XmlName{
+		Space: xmpnamespace.XmpMmUri,
+		Local: "DerivedFrom",
+	}
+
+	xnDocumentId := xmpregistry.XmlName{
+		Space: xmpnamespace.XmpMmUri,
+		Local: "DocumentID",
+	}
+
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xnRoot)
+	xpn := xmpregistry.XmpPropertyName{xnRoot, xnDerivedFrom}
+
+	_, err := xpi.GetStruct(xpn)
+	if err != ErrFieldNotFound {
+		t.Fatalf("Expected not-found error: %v", err)
+	}
+
+	_, err = xpi.GetStruct(xmpregistry.XmpPropertyName{xnRoot, xnDocumentId})
+	if err != ErrFieldNotStruct {
+		t.Fatalf("Expected not-struct error: %v", err)
+	}
+
+	// Fields may come from both the attributes and the child nodes.
+
+	attributes := map[xml.Name]interface{}{
+		{Space: xmpnamespace.StRefUri, Local: "documentID"}: "xmp.did:1",
+	}
+
+	err = xpi.addComplexValue(xpn, attributes)
+	log.PanicIf(err)
+
+	xnInstanceId := xmpregistry.XmlName{
+		Space: xmpnamespace.StRefUri,
+		Local: "instanceID",
+	}
+
+	err = xpi.addScalarValue(xmpregistry.XmpPropertyName{xnRoot, xnDerivedFrom, xnInstanceId}, "xmp.iid:1")
+	log.PanicIf(err)
+
+	parsed, err := xpi.GetStruct(xpn)
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(parsed, xmptype.ResourceRef{DocumentID: "xmp.did:1", InstanceID: "xmp.iid:1"}) != true {
+		t.Fatalf("Struct not correct: %v", parsed)
+	}
+}
+
+func TestXmpPropertyIndex_GetProperty(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xpi := parseTestDocument(`
+<xmp:Label>some label</xmp:Label>
+<dc:subject>
+	<rdf:Bag>
+		<rdf:li>aa</rdf:li>
+	</rdf:Bag>
+</dc:subject>
+<xmpMM:DerivedFrom stRef:documentID="xmp.did:1" stRef:instanceID="xmp.iid:1" />`)
+
+	value, err := xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.XmpUri, Local: "Label"})
+	log.PanicIf(err)
+
+	if value != "some label" {
+		t.Fatalf("Scalar not correct: [%v]", value)
+	}
+
+	value, err = xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.DcUri, Local: "subject"})
+	log.PanicIf(err)
+
+	items, err := xmptype.TextItems(value.(xmptype.ArrayValue))
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"aa"}) != true {
+		t.Fatalf("Array not correct: %v", items)
+	}
+
+	value, err = xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.XmpMmUri, Local: "DerivedFrom"})
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(value, xmptype.ResourceRef{DocumentID: "xmp.did:1", InstanceID: "xmp.iid:1"}) != true {
+		t.Fatalf("Struct not correct: %v", value)
+	}
+
+	_, err = xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.XmpUri, Local: "Nickname"})
+	if err != ErrFieldNotFound {
+		t.Fatalf("Expected not-found error: [%v]", err)
+	}
+}
+
 func TestXmpPropertyIndex_addComplexValue(t *testing.T) {
 	xmpregistry.Clear()
 	defer xmpregistry.Clear()
@@ -759,7 +1016,7 @@ func TestXmpPropertyIndex_addComplexValue(t *testing.T) {
 		Local: "root_node",
 	}
 
-	xpi := newXmpPropertyIndex(xnRoot)
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xnRoot)
 
 	xn1 := xmpregistry.XmlName{
 		Space: "space/uri",
@@ -795,3 +1052,151 @@ func TestXmpPropertyIndex_addComplexValue(t *testing.T) {
 		t.Fatalf("Recovered complex not correct.")
 	}
 }
+
+// getTestOrderingIndex returns an index whose properties were deliberately
+// added out of alphabetical order. The caller must clear the registry.
+func getTestOrderingIndex() *XmpPropertyIndex {
+	xmpregistry.Clear()
+
+	xmpregistry.Register(xmpnamespace.XNamespace)
+	xmpregistry.Register(xmpnamespace.XmpNamespace)
+
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
+
+	labelXpn := xmpregistry.XmpPropertyName{
+		{Space: xmpnamespace.XUri, Local: "xmpmeta"},
+		{Space: xmpnamespace.XmpUri, Local: "Label"},
+	}
+
+	err := xpi.addScalarValue(labelXpn, "some label")
+	log.PanicIf(err)
+
+	nicknameXpn := xmpregistry.XmpPropertyName{
+		{Space: xmpnamespace.XUri, Local: "xmpmeta"},
+		{Space: xmpnamespace.XmpUri, Local: "Nickname"},
+	}
+
+	err = xpi.addScalarValue(nicknameXpn, "some nickname")
+	log.PanicIf(err)
+
+	baseUrlXpn := xmpregistry.XmpPropertyName{
+		{Space: xmpnamespace.XUri, Local: "xmpmeta"},
+		{Space: xmpnamespace.XmpUri, Local: "BaseURL"},
+	}
+
+	err = xpi.addScalarValue(baseUrlXpn, "http://some/url")
+	log.PanicIf(err)
+
+	return xpi
+}
+
+func TestXmpPropertyIndex_orderedEntries_Sorted(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestOrderingIndex()
+
+	root := xpi.subindices["[x]xmpmeta"]
+	entries := root.orderedEntries(IndexOrderSorted)
+
+	actual := make([]string, len(entries))
+	for i, entry := range entries {
+		actual[i] = entry.key
+	}
+
+	expected := []string{
+		"[xmp]BaseURL",
+		"[xmp]Label",
+		"[xmp]Nickname",
+	}
+
+	if reflect.DeepEqual(actual, expected) != true {
+		t.Fatalf("Sorted entries not correct: %v", actual)
+	}
+}
+
+func TestXmpPropertyIndex_orderedEntries_Document(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestOrderingIndex()
+
+	root := xpi.subindices["[x]xmpmeta"]
+	entries := root.orderedEntries(IndexOrderDocument)
+
+	actual := make([]string, len(entries))
+	for i, entry := range entries {
+		actual[i] = entry.key
+	}
+
+	expected := []string{
+		"[xmp]Label",
+		"[xmp]Nickname",
+		"[xmp]BaseURL",
+	}
+
+	if reflect.DeepEqual(actual, expected) != true {
+		t.Fatalf("Document entries not correct: %v", actual)
+	}
+}
+
+func TestOrderedExport_MarshalJSON(t *testing.T) {
+	oe := newOrderedExport()
+
+	oe.Set("zz", 1)
+	oe.Set("aa", []interface{}{"x", "y"})
+
+	nested := newOrderedExport()
+	nested.Set("bb", true)
+	nested.Set("ab", "value")
+
+	oe.Set("mm", nested)
+
+	encoded, err := json.Marshal(oe)
+	log.PanicIf(err)
+
+	if string(encoded) != `{"zz":1,"aa":["x","y"],"mm":{"bb":true,"ab":"value"}}` {
+		t.Fatalf("Encoding not correct: [%s]", string(encoded))
+	}
+}
+
+func TestOrderedExport_Map(t *testing.T) {
+	nested := newOrderedExport()
+	nested.Set("bb", true)
+
+	oe := newOrderedExport()
+	oe.Set("aa", []interface{}{nested})
+
+	expected := map[string]interface{}{
+		"aa": []interface{}{
+			map[string]interface{}{
+				"bb": true,
+			},
+		},
+	}
+
+	if reflect.DeepEqual(oe.Map(), expected) != true {
+		t.Fatalf("Map not correct: %v", oe.Map())
+	}
+}
+
+func TestXmpPropertyIndex_ExportOrdered(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestOrderingIndex()
+
+	oe, err := xpi.ExportOrdered(true, IndexOrderDocument)
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(oe)
+	log.PanicIf(err)
+
+	if string(encoded) != `{"[x]xmpmeta":{"[xmp]Label":["some label"],"[xmp]Nickname":["some nickname"],"[xmp]BaseURL":["http://some/url"]}}` {
+		t.Fatalf("Document-order export not correct: [%s]", string(encoded))
+	}
+
+	oe, err = xpi.ExportOrdered(true, IndexOrderSorted)
+	log.PanicIf(err)
+
+	encoded, err = json.Marshal(oe)
+	log.PanicIf(err)
+
+	if string(encoded) != `{"[x]xmpmeta":{"[xmp]BaseURL":["http://some/url"],"[xmp]Label":["some label"],"[xmp]Nickname":["some nickname"]}}` {
+		t.Fatalf("Sorted export not correct: [%s]", string(encoded))
+	}
+}
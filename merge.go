@@ -0,0 +1,695 @@
+package xmp
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+var (
+	mergeLogger = log.NewLogger("xmp.merge")
+)
+
+var (
+	xmpMetadataDateName = xmpregistry.XmlName{
+		Space: xmpnamespace.XmpUri,
+		Local: "MetadataDate",
+	}
+)
+
+// MergePolicy determines how a property found in both indices with different
+// values is resolved.
+type MergePolicy int
+
+const (
+	// MergePreferLeft keeps the value from the left index.
+	MergePreferLeft MergePolicy = iota
+
+	// MergePreferRight keeps the value from the right index.
+	MergePreferRight
+
+	// MergeNewest keeps the value from whichever index has the most recent
+	// "xmp:MetadataDate". If that can not be determined, the right value is
+	// kept.
+	MergeNewest
+
+	// MergeUnion combines the items of two unordered arrays ("rdf:Bag"),
+	// dropping duplicates.
+	MergeUnion
+
+	// MergeAppend appends the items of the right array that are not already
+	// in the left array (e.g. "xmpMM:History").
+	MergeAppend
+
+	// MergePerLanguage combines two language-alternative arrays by "xml:lang".
+	// Languages found on only one side are kept and the right value is kept
+	// when both sides have the same language.
+	MergePerLanguage
+)
+
+// String returns a string representation of the policy.
+func (policy MergePolicy) String() string {
+	switch policy {
+	case MergePreferLeft:
+		return "prefer-left"
+	case MergePreferRight:
+		return "prefer-right"
+	case MergeNewest:
+		return "newest"
+	case MergeUnion:
+		return "union"
+	case MergeAppend:
+		return "append"
+	case MergePerLanguage:
+		return "per-language"
+	}
+
+	return fmt.Sprintf("MergePolicy<%d>", int(policy))
+}
+
+// MergeOptions configures which policy applies to which property. Property
+// policies take precedence over namespace policies, which take precedence
+// over the default policy.
+type MergeOptions struct {
+	// DefaultPolicy is applied when no other policy matches.
+	DefaultPolicy MergePolicy
+
+	// NamespacePolicies maps namespace URIs to policies.
+	NamespacePolicies map[string]MergePolicy
+
+	// PropertyPolicies maps property names to policies.
+	PropertyPolicies map[xml.Name]MergePolicy
+}
+
+// NewMergeOptions returns a MergeOptions struct with the given default policy
+// and no other policies.
+func NewMergeOptions(defaultPolicy MergePolicy) *MergeOptions {
+	return &MergeOptions{
+		DefaultPolicy:     defaultPolicy,
+		NamespacePolicies: make(map[string]MergePolicy),
+		PropertyPolicies:  make(map[xml.Name]MergePolicy),
+	}
+}
+
+// policyFor returns the policy that applies to the given property.
+func (mo *MergeOptions) policyFor(name xmpregistry.XmlName) MergePolicy {
+	if policy, found := mo.PropertyPolicies[xml.Name(name)]; found == true {
+		return policy
+	} else if policy, found := mo.NamespacePolicies[name.Space]; found == true {
+		return policy
+	}
+
+	return mo.DefaultPolicy
+}
+
+// fallbackPolicy returns the policy used when the selected policy does not
+// apply to a particular value (e.g. "union" on a scalar).
+func (mo *MergeOptions) fallbackPolicy() MergePolicy {
+	if mo.DefaultPolicy == MergePreferLeft || mo.DefaultPolicy == MergeNewest {
+		return mo.DefaultPolicy
+	}
+
+	return MergePreferRight
+}
+
+// MergeResolution describes which side a conflict was resolved from.
+type MergeResolution int
+
+const (
+	// MergeResolvedLeft indicates that the left value was kept.
+	MergeResolvedLeft MergeResolution = iota
+
+	// MergeResolvedRight indicates that the right value was kept.
+	MergeResolvedRight
+
+	// MergeResolvedCombined indicates that the values were combined.
+	MergeResolvedCombined
+)
+
+// String returns a string representation of the resolution.
+func (resolution MergeResolution) String() string {
+	switch resolution {
+	case MergeResolvedLeft:
+		return "left"
+	case MergeResolvedRight:
+		return "right"
+	case MergeResolvedCombined:
+		return "combined"
+	}
+
+	return fmt.Sprintf("MergeResolution<%d>", int(resolution))
+}
+
+// MergeConflict describes a property that had different values in each index
+// and how it was resolved.
+type MergeConflict struct {
+	// Path is the fully-qualified name of the property.
+	Path xmpregistry.XmpPropertyName
+
+	// Policy is the policy that was actually applied.
+	Policy MergePolicy
+
+	// Resolution is the side that the value was taken from.
+	Resolution MergeResolution
+
+	// Detail is a human-readable description of the resolution.
+	Detail string
+
+	// registry provides the prefixes used to render the path. The default
+	// registry is used if nil.
+	registry *xmpregistry.Registry
+}
+
+// String returns a string representation of the conflict.
+func (mc MergeConflict) String() string {
+	pathPhrase := mc.Path.String()
+	if mc.registry != nil {
+		pathPhrase = mc.registry.PropertyNamePhrase(mc.Path)
+	}
+
+	return fmt.Sprintf("MergeConflict<PATH=[%s] POLICY=[%s] RESOLUTION=[%s] DETAIL=[%s]>", pathPhrase, mc.Policy, mc.Resolution, mc.Detail)
+}
+
+// MergeReport describes the outcome of a merge.
+type MergeReport struct {
+	// Conflicts has one entry for every property that differed between the
+	// two indices, in the order that they were merged.
+	Conflicts []MergeConflict
+}
+
+// merger carries the state of a single merge.
+type merger struct {
+	options *MergeOptions
+	report  *MergeReport
+
+	// registry is used to reconstruct merged arrays. It is the registry of
+	// the left index.
+	registry *xmpregistry.Registry
+
+	// newestResolution is the side having the most recent metadata-date.
+	newestResolution MergeResolution
+	newestDetail     string
+}
+
+// findScalar returns the first scalar value stored under a leaf with the
+// given name anywhere in the index.
+func (xpi *XmpPropertyIndex) findScalar(name xmpregistry.XmlName) (value interface{}, found bool) {
+	cb := func(path xmpregistry.XmpPropertyName, node Node) error {
+		if node.Kind == NodeKindScalar && node.Name == name {
+			value = node.Value
+			found = true
+
+			return ErrStopWalk
+		}
+
+		return nil
+	}
+
+	err := xpi.WalkOrdered(IndexOrderDocument, cb)
+	log.PanicIf(err)
+
+	return value, found
+}
+
+// metadataDate returns the "xmp:MetadataDate" of the index if present.
+func (xpi *XmpPropertyIndex) metadataDate() (t time.Time, found bool) {
+	value, found := xpi.findScalar(xmpMetadataDateName)
+	if found == false {
+		return t, false
+	}
+
+	xd, ok := value.(xmptype.XmpDate)
+	if ok == false {
+		return t, false
+	}
+
+	return xd.Time, true
+}
+
+func newMerger(left, right *XmpPropertyIndex, options *MergeOptions) *merger {
+	m := &merger{
+		options:  options,
+		registry: left.registry,
+		report: &MergeReport{
+			Conflicts: make([]MergeConflict, 0),
+		},
+	}
+
+	leftDate, leftFound := left.metadataDate()
+	rightDate, rightFound := right.metadataDate()
+
+	if leftFound == true && rightFound == true {
+		if leftDate.After(rightDate) == true {
+			m.newestResolution = MergeResolvedLeft
+			m.newestDetail = "left metadata-date is newer"
+		} else {
+			m.newestResolution = MergeResolvedRight
+			m.newestDetail = "right metadata-date is newer or the same"
+		}
+	} else if leftFound == true {
+		m.newestResolution = MergeResolvedLeft
+		m.newestDetail = "only left has a metadata-date"
+	} else {
+		m.newestResolution = MergeResolvedRight
+
+		if rightFound == true {
+			m.newestDetail = "only right has a metadata-date"
+		} else {
+			m.newestDetail = "neither side has a metadata-date"
+		}
+	}
+
+	return m
+}
+
+// valuesEqual compares two leaf values. Dates are compared by instant and
+// precision.
+func valuesEqual(a, b interface{}) (equal bool, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if aSln, ok := a.(ScalarLeafNode); ok == true {
+		bSln, ok := b.(ScalarLeafNode)
+		if ok == false {
+			return false, nil
+		}
+
+		if aDate, ok := aSln.ParsedValue.(xmptype.XmpDate); ok == true {
+			if bDate, ok := bSln.ParsedValue.(xmptype.XmpDate); ok == true {
+				return aDate.Equal(bDate), nil
+			}
+		}
+
+		return reflect.DeepEqual(aSln, bSln), nil
+	}
+
+	if aAil, ok := a.(xmptype.ArrayItemLister); ok == true {
+		bAil, ok := b.(xmptype.ArrayItemLister)
+		if ok == false {
+			return false, nil
+		}
+
+		aItems, err := aAil.Items()
+		log.PanicIf(err)
+
+		bItems, err := bAil.Items()
+		log.PanicIf(err)
+
+		return reflect.DeepEqual(aItems, bItems), nil
+	}
+
+	return reflect.DeepEqual(a, b), nil
+}
+
+// leavesEqual compares all of the values stored under a leaf.
+func leavesEqual(a, b []interface{}) (equal bool, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if len(a) != len(b) {
+		return false, nil
+	}
+
+	for i := range a {
+		equal, err := valuesEqual(a[i], b[i])
+		log.PanicIf(err)
+
+		if equal == false {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// arrayParts returns the array and its items for a leaf having exactly one
+// array value. ok is false for any other leaf.
+func arrayParts(values []interface{}) (av xmptype.ArrayValue, items []xmptype.ArrayItem, itemElements [][]interface{}, ok bool, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if len(values) != 1 {
+		return nil, nil, nil, false, nil
+	}
+
+	av, ok = values[0].(xmptype.ArrayValue)
+	if ok == false {
+		return nil, nil, nil, false, nil
+	}
+
+	ail, ok := av.(xmptype.ArrayItemLister)
+	if ok == false {
+		return nil, nil, nil, false, nil
+	}
+
+	items, err = ail.Items()
+	log.PanicIf(err)
+
+	itemElements, err = av.ItemElements()
+	log.PanicIf(err)
+
+	if len(items) != len(itemElements) {
+		log.Panicf("array items and elements do not correspond: [%s]", av.FullName())
+	}
+
+	return av, items, itemElements, true, nil
+}
+
+// newMergedArray constructs a new array value from raw item elements.
+func (m *merger) newMergedArray(path xmpregistry.XmpPropertyName, av xmptype.ArrayValue, itemElements [][]interface{}) (merged xmptype.ArrayValue, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	aft, ok := lookupFieldType(m.registry, path).(xmptype.ArrayFieldType)
+	if ok == false {
+		log.Panicf("array field-type not registered: [%s]", path)
+	}
+
+	collected := xmptype.NewCollected(av.ContainerName(), itemElements)
+	merged = aft.New(m.registry, av.FullName(), collected)
+
+	return merged, nil
+}
+
+// combineArrays applies the union, append, and per-language policies. ok is
+// false if the policy does not apply to these values.
+func (m *merger) combineArrays(path xmpregistry.XmpPropertyName, policy MergePolicy, leftValues, rightValues []interface{}) (merged []interface{}, detail string, ok bool, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	leftAv, leftItems, leftElements, leftOk, err := arrayParts(leftValues)
+	log.PanicIf(err)
+
+	rightAv, rightItems, rightElements, rightOk, err := arrayParts(rightValues)
+	log.PanicIf(err)
+
+	if leftOk == false || rightOk == false {
+		return nil, "", false, nil
+	}
+
+	containerName := leftAv.ContainerName()
+	if containerName != rightAv.ContainerName() {
+		return nil, "", false, nil
+	}
+
+	hasItem := func(items []xmptype.ArrayItem, ai xmptype.ArrayItem) bool {
+		for _, current := range items {
+			if reflect.DeepEqual(current, ai) == true {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	combinedElements := make([][]interface{}, len(leftElements))
+	copy(combinedElements, leftElements)
+
+	switch policy {
+	case MergeUnion, MergeAppend:
+		if policy == MergeUnion && containerName.Local != "Bag" {
+			return nil, "", false, nil
+		} else if policy == MergeAppend && containerName.Local != "Seq" {
+			return nil, "", false, nil
+		}
+
+		added := 0
+		for i, ai := range rightItems {
+			if hasItem(leftItems, ai) == true {
+				continue
+			}
+
+			combinedElements = append(combinedElements, rightElements[i])
+			added++
+		}
+
+		detail = fmt.Sprintf("added (%d) item(s) from right to (%d) item(s) from left", added, len(leftItems))
+
+	case MergePerLanguage:
+		if containerName.Local != "Alt" {
+			return nil, "", false, nil
+		}
+
+		leftLanguages := make(map[string]int)
+		for i, ai := range leftItems {
+			leftLanguages[ai.Language()] = i
+		}
+
+		added := 0
+		replaced := 0
+		for i, ai := range rightItems {
+			language := ai.Language()
+
+			if j, found := leftLanguages[language]; found == true {
+				if reflect.DeepEqual(leftItems[j], ai) == false {
+					combinedElements[j] = rightElements[i]
+					replaced++
+				}
+			} else {
+				combinedElements = append(combinedElements, rightElements[i])
+				added++
+			}
+		}
+
+		detail = fmt.Sprintf("added (%d) and replaced (%d) language(s) from right", added, replaced)
+
+	default:
+		return nil, "", false, nil
+	}
+
+	mergedAv, err := m.newMergedArray(path, leftAv, combinedElements)
+	log.PanicIf(err)
+
+	return []interface{}{mergedAv}, detail, true, nil
+}
+
+// resolve returns the merged values of a leaf that differs between the two
+// indices.
+func (m *merger) resolve(path xmpregistry.XmpPropertyName, name xmpregistry.XmlName, leftValues, rightValues []interface{}) (merged []interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	policy := m.options.policyFor(name)
+
+	if policy == MergeUnion || policy == MergeAppend || policy == MergePerLanguage {
+		combined, detail, ok, err := m.combineArrays(path, policy, leftValues, rightValues)
+		log.PanicIf(err)
+
+		if ok == true {
+			mc := MergeConflict{
+				Path:       path,
+				Policy:     policy,
+				Resolution: MergeResolvedCombined,
+				Detail:     detail,
+				registry:   m.registry,
+			}
+
+			m.report.Conflicts = append(m.report.Conflicts, mc)
+
+			return combined, nil
+		}
+
+		mergeLogger.Debugf(nil, "Policy [%s] does not apply to [%s]. Falling back.", policy, path)
+
+		policy = m.options.fallbackPolicy()
+	}
+
+	mc := MergeConflict{
+		Path:     path,
+		Policy:   policy,
+		registry: m.registry,
+	}
+
+	switch policy {
+	case MergePreferLeft:
+		mc.Resolution = MergeResolvedLeft
+		mc.Detail = "preferred left"
+
+	case MergePreferRight:
+		mc.Resolution = MergeResolvedRight
+		mc.Detail = "preferred right"
+
+	case MergeNewest:
+		mc.Resolution = m.newestResolution
+		mc.Detail = m.newestDetail
+
+	default:
+		log.Panicf("merge policy not valid: [%s]", policy)
+	}
+
+	m.report.Conflicts = append(m.report.Conflicts, mc)
+
+	if mc.Resolution == MergeResolvedLeft {
+		return leftValues, nil
+	}
+
+	return rightValues, nil
+}
+
+// copyInto adds all of the values of a leaf to the destination index.
+func copyInto(destination *XmpPropertyIndex, path xmpregistry.XmpPropertyName, values []interface{}) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	for _, value := range values {
+		err := destination.addValue(path, value)
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+// copySubindex adds every leaf under the subindex to the destination index.
+// The path includes the name of the subindex.
+func copySubindex(destination *XmpPropertyIndex, path xmpregistry.XmpPropertyName, subindex *XmpPropertyIndex) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	for _, entry := range subindex.orderedEntries(IndexOrderDocument) {
+		currentPath := childPath(path, entry.name)
+
+		if entry.isLeaf == false {
+			err := copySubindex(destination, currentPath, subindex.subindices[entry.key])
+			log.PanicIf(err)
+
+			continue
+		}
+
+		err := copyInto(destination, currentPath, subindex.leaves[entry.key])
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+func (m *merger) merge(destination *XmpPropertyIndex, path xmpregistry.XmpPropertyName, left, right *XmpPropertyIndex) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	// Visit the left entries in document order followed by any entries found
+	// only on the right.
+
+	entries := left.orderedEntries(IndexOrderDocument)
+
+	for _, entry := range right.orderedEntries(IndexOrderDocument) {
+		var found bool
+		if entry.isLeaf == true {
+			_, found = left.leaves[entry.key]
+		} else {
+			_, found = left.subindices[entry.key]
+		}
+
+		if found == false {
+			entries = append(entries, entry)
+		}
+	}
+
+	for _, entry := range entries {
+		currentPath := childPath(path, entry.name)
+
+		if entry.isLeaf == false {
+			leftSubindex, leftFound := left.subindices[entry.key]
+			rightSubindex, rightFound := right.subindices[entry.key]
+
+			if leftFound == true && rightFound == true {
+				err := m.merge(destination, currentPath, leftSubindex, rightSubindex)
+				log.PanicIf(err)
+			} else if leftFound == true {
+				err := copySubindex(destination, currentPath, leftSubindex)
+				log.PanicIf(err)
+			} else {
+				err := copySubindex(destination, currentPath, rightSubindex)
+				log.PanicIf(err)
+			}
+
+			continue
+		}
+
+		leftValues, leftFound := left.leaves[entry.key]
+		rightValues, rightFound := right.leaves[entry.key]
+
+		values := leftValues
+
+		if leftFound == true && rightFound == true {
+			equal, err := leavesEqual(leftValues, rightValues)
+			log.PanicIf(err)
+
+			if equal == false {
+				values, err = m.resolve(currentPath, entry.name, leftValues, rightValues)
+				log.PanicIf(err)
+			}
+		} else if rightFound == true {
+			values = rightValues
+		}
+
+		err := copyInto(destination, currentPath, values)
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+// Merge combines two indices into a new index. Properties found in only one
+// index are always kept. Properties found in both with different values are
+// resolved according to the options and recorded in the report. Neither input
+// index is modified.
+func Merge(left, right *XmpPropertyIndex, options *MergeOptions) (merged *XmpPropertyIndex, report *MergeReport, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if options == nil {
+		options = NewMergeOptions(MergePreferRight)
+	}
+
+	m := newMerger(left, right, options)
+
+	merged = newXmpPropertyIndex(m.registry, xmpregistry.XmlName{})
+
+	rootPath := make(xmpregistry.XmpPropertyName, 0)
+
+	err = m.merge(merged, rootPath, left, right)
+	log.PanicIf(err)
+
+	merged.graph, err = merged.buildGraph()
+	log.PanicIf(err)
+
+	return merged, m.report, nil
+}
@@ -0,0 +1,314 @@
+package xmp
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+func getStringItems(xpi *XmpPropertyIndex, namePhraseSlice []string) []string {
+	results, err := xpi.Get(namePhraseSlice)
+	log.PanicIf(err)
+
+	items, err := results[0].(xmptype.ArrayStringValueLister).StringItems()
+	log.PanicIf(err)
+
+	return items
+}
+
+func getScalar(xpi *XmpPropertyIndex, namePhraseSlice []string) interface{} {
+	results, err := xpi.Get(namePhraseSlice)
+	log.PanicIf(err)
+
+	return results[0].(ScalarLeafNode).ParsedValue
+}
+
+func TestMerge_PreferLeftAndRight(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	left := parseTestDocument(`
+<xmp:Label>left label</xmp:Label>
+<xmp:Nickname>left only</xmp:Nickname>`)
+
+	right := parseTestDocument(`
+<xmp:Label>right label</xmp:Label>
+<xmp:BaseURL>http://right/only</xmp:BaseURL>`)
+
+	labelPath := []string{"[x]xmpmeta", "[xmp]Label"}
+
+	// Prefer left.
+
+	merged, report, err := Merge(left, right, NewMergeOptions(MergePreferLeft))
+	log.PanicIf(err)
+
+	if value := getScalar(merged, labelPath); value != "left label" {
+		t.Fatalf("Prefer-left value not correct: [%v]", value)
+	} else if value := getScalar(merged, []string{"[x]xmpmeta", "[xmp]Nickname"}); value != "left only" {
+		t.Fatalf("Left-only value not kept: [%v]", value)
+	} else if value := getScalar(merged, []string{"[x]xmpmeta", "[xmp]BaseURL"}); value != "http://right/only" {
+		t.Fatalf("Right-only value not kept: [%v]", value)
+	}
+
+	if len(report.Conflicts) != 1 {
+		t.Fatalf("Expected one conflict: %v", report.Conflicts)
+	}
+
+	mc := report.Conflicts[0]
+
+	if mc.Path.String() != "[x]xmpmeta.[xmp]Label" {
+		t.Fatalf("Conflict path not correct: [%s]", mc.Path)
+	} else if mc.Policy != MergePreferLeft {
+		t.Fatalf("Conflict policy not correct: [%s]", mc.Policy)
+	} else if mc.Resolution != MergeResolvedLeft {
+		t.Fatalf("Conflict resolution not correct: [%s]", mc.Resolution)
+	}
+
+	// Prefer right.
+
+	merged, report, err = Merge(left, right, NewMergeOptions(MergePreferRight))
+	log.PanicIf(err)
+
+	if value := getScalar(merged, labelPath); value != "right label" {
+		t.Fatalf("Prefer-right value not correct: [%v]", value)
+	} else if report.Conflicts[0].Resolution != MergeResolvedRight {
+		t.Fatalf("Conflict resolution not correct: [%s]", report.Conflicts[0].Resolution)
+	}
+
+	// Nothing was modified.
+
+	if value := getScalar(left, labelPath); value != "left label" {
+		t.Fatalf("Left index was modified: [%v]", value)
+	}
+}
+
+func TestMergeConflict_String_CustomRegistry(t *testing.T) {
+	registry := getTestTenantRegistry()
+
+	left := parseTestDocumentWithRegistry(registry, `<zz:Count xmlns:zz="http://some/uri/tenant/">1</zz:Count>`)
+	right := parseTestDocumentWithRegistry(registry, `<zz:Count xmlns:zz="http://some/uri/tenant/">2</zz:Count>`)
+
+	_, report, err := Merge(left, right, NewMergeOptions(MergePreferLeft))
+	log.PanicIf(err)
+
+	if len(report.Conflicts) != 1 {
+		t.Fatalf("Expected one conflict: %v", report.Conflicts)
+	}
+
+	expected := "MergeConflict<PATH=[[x]xmpmeta.[zz]Count] POLICY=[prefer-left] RESOLUTION=[left] DETAIL=[preferred left]>"
+
+	if phrase := report.Conflicts[0].String(); phrase != expected {
+		t.Fatalf("String not correct: [%s]", phrase)
+	}
+}
+
+func TestMerge_PropertyAndNamespacePolicies(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	left := parseTestDocument(`
+<xmp:Label>left label</xmp:Label>
+<xmp:Nickname>left nickname</xmp:Nickname>`)
+
+	right := parseTestDocument(`
+<xmp:Label>right label</xmp:Label>
+<xmp:Nickname>right nickname</xmp:Nickname>`)
+
+	mo := NewMergeOptions(MergePreferRight)
+	mo.NamespacePolicies[xmpnamespace.XmpUri] = MergePreferLeft
+	mo.PropertyPolicies[xml.Name{Space: xmpnamespace.XmpUri, Local: "Nickname"}] = MergePreferRight
+
+	merged, _, err := Merge(left, right, mo)
+	log.PanicIf(err)
+
+	if value := getScalar(merged, []string{"[x]xmpmeta", "[xmp]Label"}); value != "left label" {
+		t.Fatalf("Namespace policy not applied: [%v]", value)
+	} else if value := getScalar(merged, []string{"[x]xmpmeta", "[xmp]Nickname"}); value != "right nickname" {
+		t.Fatalf("Property policy not applied: [%v]", value)
+	}
+}
+
+func TestMerge_Newest(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	left := parseTestDocument(`
+<xmp:MetadataDate>2020-01-02T03:04:05+00:00</xmp:MetadataDate>
+<xmp:Label>newer label</xmp:Label>`)
+
+	right := parseTestDocument(`
+<xmp:MetadataDate>2019-01-02T03:04:05+00:00</xmp:MetadataDate>
+<xmp:Label>older label</xmp:Label>`)
+
+	merged, report, err := Merge(left, right, NewMergeOptions(MergeNewest))
+	log.PanicIf(err)
+
+	if value := getScalar(merged, []string{"[x]xmpmeta", "[xmp]Label"}); value != "newer label" {
+		t.Fatalf("Newest value not kept: [%v]", value)
+	}
+
+	for _, mc := range report.Conflicts {
+		if mc.Resolution != MergeResolvedLeft {
+			t.Fatalf("Conflict not resolved to left: %s", mc)
+		}
+	}
+}
+
+func TestMerge_Union(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	left := parseTestDocument(`
+<dc:subject><rdf:Bag><rdf:li>aa</rdf:li><rdf:li>bb</rdf:li></rdf:Bag></dc:subject>`)
+
+	right := parseTestDocument(`
+<dc:subject><rdf:Bag><rdf:li>bb</rdf:li><rdf:li>cc</rdf:li></rdf:Bag></dc:subject>`)
+
+	mo := NewMergeOptions(MergePreferRight)
+	mo.PropertyPolicies[xml.Name{Space: xmpnamespace.DcUri, Local: "subject"}] = MergeUnion
+
+	merged, report, err := Merge(left, right, mo)
+	log.PanicIf(err)
+
+	items := getStringItems(merged, []string{"[x]xmpmeta", "[dc]subject"})
+
+	if reflect.DeepEqual(items, []string{"aa", "bb", "cc"}) != true {
+		t.Fatalf("Union not correct: %v", items)
+	} else if report.Conflicts[0].Resolution != MergeResolvedCombined {
+		t.Fatalf("Conflict resolution not correct: [%s]", report.Conflicts[0].Resolution)
+	}
+}
+
+func TestMerge_Union_NotApplicable(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	left := parseTestDocument(`<xmp:Label>left label</xmp:Label>`)
+	right := parseTestDocument(`<xmp:Label>right label</xmp:Label>`)
+
+	merged, report, err := Merge(left, right, NewMergeOptions(MergeUnion))
+	log.PanicIf(err)
+
+	if value := getScalar(merged, []string{"[x]xmpmeta", "[xmp]Label"}); value != "right label" {
+		t.Fatalf("Fallback not applied: [%v]", value)
+	} else if report.Conflicts[0].Policy != MergePreferRight {
+		t.Fatalf("Fallback policy not reported: [%s]", report.Conflicts[0].Policy)
+	}
+}
+
+func TestMerge_Append(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	left := parseTestDocument(`
+<xmpMM:History>
+  <rdf:Seq>
+    <rdf:li stEvt:action="created" stEvt:instanceID="xmp.iid:1"/>
+    <rdf:li stEvt:action="saved" stEvt:instanceID="xmp.iid:2"/>
+  </rdf:Seq>
+</xmpMM:History>`)
+
+	right := parseTestDocument(`
+<xmpMM:History>
+  <rdf:Seq>
+    <rdf:li stEvt:action="created" stEvt:instanceID="xmp.iid:1"/>
+    <rdf:li stEvt:action="saved" stEvt:instanceID="xmp.iid:3"/>
+  </rdf:Seq>
+</xmpMM:History>`)
+
+	mo := NewMergeOptions(MergePreferLeft)
+	mo.PropertyPolicies[xml.Name{Space: xmpnamespace.XmpMmUri, Local: "History"}] = MergeAppend
+
+	merged, _, err := Merge(left, right, mo)
+	log.PanicIf(err)
+
+	results, err := merged.Get([]string{"[x]xmpmeta", "[xmpMM]History"})
+	log.PanicIf(err)
+
+	items, err := results[0].(xmptype.ArrayItemLister).Items()
+	log.PanicIf(err)
+
+	instanceIdName := xml.Name{Space: xmpnamespace.StEvtUri, Local: "instanceID"}
+
+	actual := make([]interface{}, len(items))
+	for i, ai := range items {
+		actual[i] = ai.Attributes[instanceIdName]
+	}
+
+	expected := []interface{}{"xmp.iid:1", "xmp.iid:2", "xmp.iid:3"}
+
+	if reflect.DeepEqual(actual, expected) != true {
+		t.Fatalf("Appended history not correct: %v", actual)
+	}
+}
+
+func TestMerge_PerLanguage(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	left := parseTestDocument(`
+<dc:title>
+  <rdf:Alt>
+    <rdf:li xml:lang="x-default">left default</rdf:li>
+    <rdf:li xml:lang="de">links</rdf:li>
+  </rdf:Alt>
+</dc:title>`)
+
+	right := parseTestDocument(`
+<dc:title>
+  <rdf:Alt>
+    <rdf:li xml:lang="x-default">right default</rdf:li>
+    <rdf:li xml:lang="fr">droite</rdf:li>
+  </rdf:Alt>
+</dc:title>`)
+
+	mo := NewMergeOptions(MergePreferLeft)
+	mo.PropertyPolicies[xml.Name{Space: xmpnamespace.DcUri, Local: "title"}] = MergePerLanguage
+
+	merged, report, err := Merge(left, right, mo)
+	log.PanicIf(err)
+
+	items := getStringItems(merged, []string{"[x]xmpmeta", "[dc]title"})
+
+	expected := []string{
+		"{[xml]lang=[x-default]} [right default]",
+		"{[xml]lang=[de]} [links]",
+		"{[xml]lang=[fr]} [droite]",
+	}
+
+	if reflect.DeepEqual(items, expected) != true {
+		t.Fatalf("Per-language merge not correct: %v", items)
+	} else if report.Conflicts[0].Detail != "added (1) and replaced (1) language(s) from right" {
+		t.Fatalf("Conflict detail not correct: [%s]", report.Conflicts[0].Detail)
+	}
+}
+
+func TestMerge_NoConflicts(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	document := `
+<xmp:Label>same label</xmp:Label>
+<dc:subject><rdf:Bag><rdf:li>aa</rdf:li></rdf:Bag></dc:subject>`
+
+	left := parseTestDocument(document)
+	right := parseTestDocument(document)
+
+	merged, report, err := Merge(left, right, nil)
+	log.PanicIf(err)
+
+	if len(report.Conflicts) != 0 {
+		t.Fatalf("Expected no conflicts: %v", report.Conflicts)
+	} else if merged.Count() != left.Count() {
+		t.Fatalf("Merged count not correct: (%d) != (%d)", merged.Count(), left.Count())
+	} else if reflect.DeepEqual(merged.Graph().Triples(), left.Graph().Triples()) != true {
+		t.Fatalf("Merged graph not correct: %v", merged.Graph().Triples())
+	}
+}
@@ -0,0 +1,48 @@
+package xmpnamespace
+
+import (
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// Iptc4xmpCoreUri is the 'Iptc4xmpCore' namespace URI made a constant to
+	// support testing.
+	Iptc4xmpCoreUri = xmptype.Iptc4xmpCoreUri
+)
+
+var (
+	// Iptc4xmpCoreNamespace is the namespace descriptor for "Iptc4xmpCore".
+	// Most IPTC Core properties are in the "dc", "photoshop", and
+	// "xmpRights" namespaces; these are the remainder.
+	Iptc4xmpCoreNamespace = xmpregistry.Namespace{
+		Uri:             Iptc4xmpCoreUri,
+		PreferredPrefix: "Iptc4xmpCore",
+		SpecReference:   "IPTC Photo Metadata Standard",
+		Fields: map[string]interface{}{
+			"AltTextAccessibility":  xmptype.LanguageAlternativeArrayFieldType{},
+			"CountryCode":           xmptype.TextFieldType{},
+			"CreatorContactInfo":    xmptype.ContactInfoFieldType{},
+			"ExtDescrAccessibility": xmptype.LanguageAlternativeArrayFieldType{},
+			"IntellectualGenre":     xmptype.TextFieldType{},
+			"Location":              xmptype.TextFieldType{},
+			"Scene":                 xmptype.UnorderedTextArrayFieldType{},
+			"SubjectCode":           xmptype.UnorderedTextArrayFieldType{},
+
+			// ContactInfo
+
+			"CiAdrExtadr": xmptype.TextFieldType{},
+			"CiAdrCity":   xmptype.TextFieldType{},
+			"CiAdrRegion": xmptype.TextFieldType{},
+			"CiAdrPcode":  xmptype.TextFieldType{},
+			"CiAdrCtry":   xmptype.TextFieldType{},
+			"CiTelWork":   xmptype.TextFieldType{},
+			"CiEmailWork": xmptype.TextFieldType{},
+			"CiUrlWork":   xmptype.TextFieldType{},
+		},
+	}
+)
+
+func init() {
+	xmpregistry.Register(Iptc4xmpCoreNamespace)
+}
@@ -0,0 +1,157 @@
+package xmpnamespace
+
+import (
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// Iptc4xmpExtUri is the 'Iptc4xmpExt' namespace URI made a constant to
+	// support testing.
+	Iptc4xmpExtUri = xmptype.Iptc4xmpExtUri
+)
+
+var (
+	// Iptc4xmpExtNamespace is the namespace descriptor for "Iptc4xmpExt" (IPTC
+	// Extension).
+	Iptc4xmpExtNamespace = xmpregistry.Namespace{
+		Uri:             Iptc4xmpExtUri,
+		PreferredPrefix: "Iptc4xmpExt",
+		SpecReference:   "IPTC Photo Metadata Standard",
+		Fields: map[string]interface{}{
+			"AboutCvTerm":             xmptype.UnorderedArrayFieldType{ItemType: xmptype.CvTermFieldType{}},
+			"AddlModelInfo":           xmptype.TextFieldType{},
+			"ArtworkOrObject":         xmptype.UnorderedArrayFieldType{ItemType: xmptype.ArtworkOrObjectFieldType{}},
+			"Contributor":             xmptype.UnorderedArrayFieldType{ItemType: xmptype.EntityFieldType{}},
+			"CopyrightYear":           xmptype.IntegerFieldType{},
+			"Creator":                 xmptype.UnorderedArrayFieldType{ItemType: xmptype.EntityFieldType{}},
+			"DigitalImageGUID":        xmptype.TextFieldType{},
+			"DigitalSourceType":       xmptype.DigitalSourceTypeFieldType{},
+			"EmbdEncRightsExpr":       xmptype.UnorderedArrayFieldType{ItemType: xmptype.GenericStructFieldType{}},
+			"Event":                   xmptype.LanguageAlternativeArrayFieldType{},
+			"EventId":                 xmptype.UnorderedTextArrayFieldType{},
+			"Genre":                   xmptype.UnorderedArrayFieldType{ItemType: xmptype.CvTermFieldType{}},
+			"ImageRegion":             xmptype.UnorderedArrayFieldType{ItemType: xmptype.ImageRegionFieldType{}},
+			"IptcLastEdited":          xmptype.DateFieldType{},
+			"LinkedEncRightsExpr":     xmptype.UnorderedArrayFieldType{ItemType: xmptype.GenericStructFieldType{}},
+			"LocationCreated":         xmptype.UnorderedArrayFieldType{ItemType: xmptype.LocationDetailsFieldType{}},
+			"LocationShown":           xmptype.UnorderedArrayFieldType{ItemType: xmptype.LocationDetailsFieldType{}},
+			"MaxAvailHeight":          xmptype.IntegerFieldType{},
+			"MaxAvailWidth":           xmptype.IntegerFieldType{},
+			"ModelAge":                xmptype.UnorderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+			"OrganisationInImageCode": xmptype.UnorderedTextArrayFieldType{},
+			"OrganisationInImageName": xmptype.UnorderedTextArrayFieldType{},
+			"PersonHeard":             xmptype.UnorderedArrayFieldType{ItemType: xmptype.EntityFieldType{}},
+			"PersonInImage":           xmptype.UnorderedTextArrayFieldType{},
+			"PersonInImageWDetails":   xmptype.UnorderedArrayFieldType{ItemType: xmptype.PersonDetailsFieldType{}},
+			"ProductInImage":          xmptype.UnorderedArrayFieldType{ItemType: xmptype.GenericStructFieldType{}},
+			"RegistryId":              xmptype.UnorderedArrayFieldType{ItemType: xmptype.RegistryEntryFieldType{}},
+			"ShownEvent":              xmptype.UnorderedArrayFieldType{ItemType: xmptype.EntityFieldType{}},
+			"Transcript":              xmptype.LanguageAlternativeArrayFieldType{},
+
+			// CVTerm
+
+			"CvId":               xmptype.UriFieldType{},
+			"CvTermId":           xmptype.UriFieldType{},
+			"CvTermName":         xmptype.LanguageAlternativeArrayFieldType{},
+			"CvTermRefinedAbout": xmptype.UriFieldType{},
+
+			// Entity and EntityWRole
+
+			"Identifier": xmptype.UnorderedArrayFieldType{ItemType: xmptype.UriFieldType{}},
+			"Name":       xmptype.LanguageAlternativeArrayFieldType{},
+			"Role":       xmptype.UnorderedArrayFieldType{ItemType: xmptype.UriFieldType{}},
+
+			// LocationDetails (the GPS fields are in the "exif" namespace)
+
+			"City":          xmptype.TextFieldType{},
+			"CountryCode":   xmptype.TextFieldType{},
+			"CountryName":   xmptype.TextFieldType{},
+			"LocationId":    xmptype.UnorderedArrayFieldType{ItemType: xmptype.UriFieldType{}},
+			"LocationName":  xmptype.LanguageAlternativeArrayFieldType{},
+			"ProvinceState": xmptype.TextFieldType{},
+			"Sublocation":   xmptype.TextFieldType{},
+			"WorldRegion":   xmptype.TextFieldType{},
+
+			// ArtworkOrObjectDetails
+
+			"AOCircaDateCreated":          xmptype.TextFieldType{},
+			"AOContentDescription":        xmptype.LanguageAlternativeArrayFieldType{},
+			"AOContributionDescription":   xmptype.LanguageAlternativeArrayFieldType{},
+			"AOCopyrightNotice":           xmptype.TextFieldType{},
+			"AOCreator":                   xmptype.OrderedArrayFieldType{ItemType: xmptype.ProperNameFieldType{}},
+			"AOCreatorId":                 xmptype.OrderedTextArrayFieldType{},
+			"AOCurrentCopyrightOwnerId":   xmptype.UriFieldType{},
+			"AOCurrentCopyrightOwnerName": xmptype.TextFieldType{},
+			"AOCurrentLicensorId":         xmptype.UriFieldType{},
+			"AOCurrentLicensorName":       xmptype.TextFieldType{},
+			"AODateCreated":               xmptype.DateFieldType{},
+			"AOPhysicalDescription":       xmptype.LanguageAlternativeArrayFieldType{},
+			"AOSource":                    xmptype.TextFieldType{},
+			"AOSourceInvNo":               xmptype.TextFieldType{},
+			"AOSourceInvURL":              xmptype.UrlFieldType{},
+			"AOStylePeriod":               xmptype.UnorderedTextArrayFieldType{},
+			"AOTitle":                     xmptype.LanguageAlternativeArrayFieldType{},
+
+			// PersonDetails
+
+			"PersonCharacteristic": xmptype.UnorderedArrayFieldType{ItemType: xmptype.CvTermFieldType{}},
+			"PersonDescription":    xmptype.LanguageAlternativeArrayFieldType{},
+			"PersonId":             xmptype.UnorderedArrayFieldType{ItemType: xmptype.UriFieldType{}},
+			"PersonName":           xmptype.LanguageAlternativeArrayFieldType{},
+
+			// ProductDetails
+
+			"ProductDescription": xmptype.LanguageAlternativeArrayFieldType{},
+			"ProductGTIN":        xmptype.TextFieldType{},
+			"ProductId":          xmptype.UriFieldType{},
+			"ProductName":        xmptype.LanguageAlternativeArrayFieldType{},
+
+			// RegistryEntryDetails
+
+			"RegEntryRole": xmptype.UriFieldType{},
+			"RegItemId":    xmptype.TextFieldType{},
+			"RegOrgId":     xmptype.TextFieldType{},
+
+			// EmbdEncRightsExpr and LinkedEncRightsExpr
+
+			"EncRightsExpr":     xmptype.TextFieldType{},
+			"LinkedRightsExpr":  xmptype.UrlFieldType{},
+			"RightsExprEncType": xmptype.TextFieldType{},
+			"RightsExprLangId":  xmptype.UriFieldType{},
+
+			// ImageRegion
+
+			"RegionBoundary": xmptype.RegionBoundaryFieldType{},
+			"rCtype":         xmptype.UnorderedArrayFieldType{ItemType: xmptype.EntityFieldType{}},
+			"rId":            xmptype.TextFieldType{},
+			"rRole":          xmptype.UnorderedArrayFieldType{ItemType: xmptype.EntityFieldType{}},
+
+			// RegionBoundary and BoundaryPoint
+
+			"rbShape":    xmptype.DefinedChoiceFieldType{Choices: iptc4xmpExtRegionShapeChoices, IsClosed: true},
+			"rbUnit":     xmptype.DefinedChoiceFieldType{Choices: iptc4xmpExtRegionUnitChoices, IsClosed: true},
+			"rbX":        xmptype.RealFieldType{},
+			"rbY":        xmptype.RealFieldType{},
+			"rbW":        xmptype.RealFieldType{},
+			"rbH":        xmptype.RealFieldType{},
+			"rbRx":       xmptype.RealFieldType{},
+			"rbVertices": xmptype.OrderedArrayFieldType{ItemType: xmptype.RegionBoundaryPointFieldType{}},
+		},
+	}
+
+	iptc4xmpExtRegionShapeChoices = []xmptype.ChoiceDefinition{
+		{Code: "rectangle", Label: "Rectangle"},
+		{Code: "circle", Label: "Circle"},
+		{Code: "polygon", Label: "Polygon"},
+	}
+
+	iptc4xmpExtRegionUnitChoices = []xmptype.ChoiceDefinition{
+		{Code: "pixel", Label: "Pixel"},
+		{Code: "relative", Label: "Relative"},
+	}
+)
+
+func init() {
+	xmpregistry.Register(Iptc4xmpExtNamespace)
+}
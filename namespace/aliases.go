@@ -0,0 +1,85 @@
+package xmpnamespace
+
+import (
+	"encoding/xml"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+const (
+	// PdfUri is the 'pdf' namespace URI. It is only used by aliases.
+	PdfUri = "http://ns.adobe.com/pdf/1.3/"
+
+	// PngUri is the 'png' namespace URI. It is only used by aliases.
+	PngUri = "http://ns.adobe.com/png/1.0/"
+)
+
+var (
+	// StandardAliases are the aliases defined by the specification (Part 1,
+	// "Property aliases"), which are registered with the default registry.
+	StandardAliases = []xmpregistry.Alias{
+		// Aliases to XMP basic and XMP rights.
+
+		newAlias(XmpUri, "Author", DcUri, "creator", xmpregistry.AliasFormOrderedFirstItem),
+		newAlias(XmpUri, "Authors", DcUri, "creator", xmpregistry.AliasFormSimple),
+		newAlias(XmpUri, "Description", DcUri, "description", xmpregistry.AliasFormSimple),
+		newAlias(XmpUri, "Format", DcUri, "format", xmpregistry.AliasFormSimple),
+		newAlias(XmpUri, "Keywords", DcUri, "subject", xmpregistry.AliasFormSimple),
+		newAlias(XmpUri, "Locale", DcUri, "language", xmpregistry.AliasFormSimple),
+		newAlias(XmpUri, "Title", DcUri, "title", xmpregistry.AliasFormSimple),
+		newAlias(XmpRightsUri, "Copyright", DcUri, "rights", xmpregistry.AliasFormSimple),
+
+		// Aliases from PDF.
+
+		newAlias(PdfUri, "Author", DcUri, "creator", xmpregistry.AliasFormOrderedFirstItem),
+		newAlias(PdfUri, "BaseURL", XmpUri, "BaseURL", xmpregistry.AliasFormSimple),
+		newAlias(PdfUri, "CreationDate", XmpUri, "CreateDate", xmpregistry.AliasFormSimple),
+		newAlias(PdfUri, "Creator", XmpUri, "CreatorTool", xmpregistry.AliasFormSimple),
+		newAlias(PdfUri, "ModDate", XmpUri, "ModifyDate", xmpregistry.AliasFormSimple),
+		newAlias(PdfUri, "Subject", DcUri, "description", xmpregistry.AliasFormLanguageDefault),
+		newAlias(PdfUri, "Title", DcUri, "title", xmpregistry.AliasFormLanguageDefault),
+
+		// Aliases from Photoshop.
+
+		newAlias(PhotoshopUri, "Author", DcUri, "creator", xmpregistry.AliasFormOrderedFirstItem),
+		newAlias(PhotoshopUri, "Caption", DcUri, "description", xmpregistry.AliasFormLanguageDefault),
+		newAlias(PhotoshopUri, "Copyright", DcUri, "rights", xmpregistry.AliasFormLanguageDefault),
+		newAlias(PhotoshopUri, "Keywords", DcUri, "subject", xmpregistry.AliasFormSimple),
+		newAlias(PhotoshopUri, "Marked", XmpRightsUri, "Marked", xmpregistry.AliasFormSimple),
+		newAlias(PhotoshopUri, "Title", DcUri, "title", xmpregistry.AliasFormLanguageDefault),
+		newAlias(PhotoshopUri, "WebStatement", XmpRightsUri, "WebStatement", xmpregistry.AliasFormSimple),
+
+		// Aliases from TIFF and Exif.
+
+		newAlias(TiffUri, "Artist", DcUri, "creator", xmpregistry.AliasFormOrderedFirstItem),
+		newAlias(TiffUri, "Copyright", DcUri, "rights", xmpregistry.AliasFormSimple),
+		newAlias(TiffUri, "DateTime", XmpUri, "ModifyDate", xmpregistry.AliasFormSimple),
+		newAlias(TiffUri, "ImageDescription", DcUri, "description", xmpregistry.AliasFormSimple),
+		newAlias(TiffUri, "Software", XmpUri, "CreatorTool", xmpregistry.AliasFormSimple),
+		newAlias(ExifUri, "DateTimeDigitized", XmpUri, "CreateDate", xmpregistry.AliasFormSimple),
+
+		// Aliases from PNG.
+
+		newAlias(PngUri, "Author", DcUri, "creator", xmpregistry.AliasFormOrderedFirstItem),
+		newAlias(PngUri, "Copyright", DcUri, "rights", xmpregistry.AliasFormLanguageDefault),
+		newAlias(PngUri, "CreationTime", XmpUri, "CreateDate", xmpregistry.AliasFormSimple),
+		newAlias(PngUri, "Description", DcUri, "description", xmpregistry.AliasFormLanguageDefault),
+		newAlias(PngUri, "ModificationTime", XmpUri, "ModifyDate", xmpregistry.AliasFormSimple),
+		newAlias(PngUri, "Software", XmpUri, "CreatorTool", xmpregistry.AliasFormSimple),
+		newAlias(PngUri, "Title", DcUri, "title", xmpregistry.AliasFormLanguageDefault),
+	}
+)
+
+func newAlias(space, local, baseSpace, baseLocal string, form xmpregistry.AliasForm) xmpregistry.Alias {
+	return xmpregistry.Alias{
+		Name: xml.Name{Space: space, Local: local},
+		Base: xml.Name{Space: baseSpace, Local: baseLocal},
+		Form: form,
+	}
+}
+
+func init() {
+	for _, alias := range StandardAliases {
+		xmpregistry.RegisterAlias(alias)
+	}
+}
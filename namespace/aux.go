@@ -0,0 +1,47 @@
+package xmpnamespace
+
+import (
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// AuxUri is the 'aux' namespace URI made a constant to support testing.
+	AuxUri = "http://ns.adobe.com/exif/1.0/aux/"
+)
+
+var (
+	// AuxNamespace is the namespace descriptor for "aux" (additional camera
+	// and lens properties written by Adobe applications).
+	AuxNamespace = xmpregistry.Namespace{
+		Uri:             AuxUri,
+		PreferredPrefix: "aux",
+		SpecReference:   "XMP Specification Part 2",
+		Fields: map[string]interface{}{
+			"ApproximateFocusDistance": xmptype.RationalFieldType{},
+			"Firmware":                 xmptype.TextFieldType{},
+			"FlashCompensation":        xmptype.RationalFieldType{},
+			"ImageNumber":              xmptype.IntegerFieldType{},
+			"IsMergedHDR":              xmptype.BooleanFieldType{},
+			"IsMergedPanorama":         xmptype.BooleanFieldType{},
+			"Lens":                     xmptype.TextFieldType{},
+			"LensDistortInfo":          xmptype.TextFieldType{},
+
+			// This is documented as an integer but is written by some
+			// applications as an identifier that is not numeric.
+			"LensID": xmptype.TextFieldType{},
+
+			// This is four rationals delimited by spaces (e.g. "24/1 70/1 28/10
+			// 28/10"). We take it as text.
+			"LensInfo": xmptype.TextFieldType{},
+
+			"LensSerialNumber": xmptype.TextFieldType{},
+			"OwnerName":        xmptype.ProperNameFieldType{},
+			"SerialNumber":     xmptype.TextFieldType{},
+		},
+	}
+)
+
+func init() {
+	xmpregistry.Register(AuxNamespace)
+}
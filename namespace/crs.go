@@ -0,0 +1,311 @@
+package xmpnamespace
+
+import (
+	"encoding/xml"
+
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// CrsUri is the 'crs' namespace URI made a constant to support testing.
+	CrsUri = xmptype.CrsUri
+)
+
+var (
+	// CrsNamespace is the namespace descriptor for "crs" (the develop
+	// settings of Camera Raw and Lightroom). Most settings are written as
+	// attributes of the description.
+	CrsNamespace = xmpregistry.Namespace{
+		Uri:             CrsUri,
+		PreferredPrefix: "crs",
+		SpecReference:   "XMP Specification Part 2",
+		Fields: map[string]interface{}{
+			"AlreadyApplied":                      xmptype.BooleanFieldType{},
+			"AutoBrightness":                      xmptype.BooleanFieldType{},
+			"AutoContrast":                        xmptype.BooleanFieldType{},
+			"AutoExposure":                        xmptype.BooleanFieldType{},
+			"AutoLateralCA":                       xmptype.IntegerFieldType{},
+			"AutoShadows":                         xmptype.BooleanFieldType{},
+			"AutoWhiteVersion":                    xmptype.IntegerFieldType{},
+			"Blacks2012":                          xmptype.IntegerFieldType{},
+			"BlueHue":                             xmptype.IntegerFieldType{},
+			"BlueSaturation":                      xmptype.IntegerFieldType{},
+			"Brightness":                          xmptype.IntegerFieldType{},
+			"CameraProfile":                       xmptype.TextFieldType{},
+			"CameraProfileDigest":                 xmptype.TextFieldType{},
+			"ChromaticAberrationB":                xmptype.IntegerFieldType{},
+			"ChromaticAberrationR":                xmptype.IntegerFieldType{},
+			"CircularGradientBasedCorrections":    xmptype.OrderedArrayFieldType{ItemType: xmptype.LocalCorrectionFieldType{}},
+			"Clarity2012":                         xmptype.IntegerFieldType{},
+			"ColorGradeBlending":                  xmptype.IntegerFieldType{},
+			"ColorGradeGlobalHue":                 xmptype.IntegerFieldType{},
+			"ColorGradeGlobalLum":                 xmptype.IntegerFieldType{},
+			"ColorGradeGlobalSat":                 xmptype.IntegerFieldType{},
+			"ColorGradeHighlightLum":              xmptype.IntegerFieldType{},
+			"ColorGradeMidtoneHue":                xmptype.IntegerFieldType{},
+			"ColorGradeMidtoneLum":                xmptype.IntegerFieldType{},
+			"ColorGradeMidtoneSat":                xmptype.IntegerFieldType{},
+			"ColorGradeShadowLum":                 xmptype.IntegerFieldType{},
+			"ColorNoiseReduction":                 xmptype.IntegerFieldType{},
+			"ColorNoiseReductionDetail":           xmptype.IntegerFieldType{},
+			"ColorNoiseReductionSmoothness":       xmptype.IntegerFieldType{},
+			"CompatibleVersion":                   xmptype.TextFieldType{},
+			"Contrast":                            xmptype.IntegerFieldType{},
+			"Contrast2012":                        xmptype.IntegerFieldType{},
+			"ConvertToGrayscale":                  xmptype.BooleanFieldType{},
+			"CropAngle":                           xmptype.RealFieldType{},
+			"CropBottom":                          xmptype.RealFieldType{},
+			"CropConstrainToWarp":                 xmptype.IntegerFieldType{},
+			"CropHeight":                          xmptype.RealFieldType{},
+			"CropLeft":                            xmptype.RealFieldType{},
+			"CropRight":                           xmptype.RealFieldType{},
+			"CropTop":                             xmptype.RealFieldType{},
+			"CropUnits":                           xmptype.IntegerFieldType{},
+			"CropWidth":                           xmptype.RealFieldType{},
+			"DefringeGreenAmount":                 xmptype.IntegerFieldType{},
+			"DefringeGreenHueHi":                  xmptype.IntegerFieldType{},
+			"DefringeGreenHueLo":                  xmptype.IntegerFieldType{},
+			"DefringePurpleAmount":                xmptype.IntegerFieldType{},
+			"DefringePurpleHueHi":                 xmptype.IntegerFieldType{},
+			"DefringePurpleHueLo":                 xmptype.IntegerFieldType{},
+			"Dehaze":                              xmptype.IntegerFieldType{},
+			"Exposure":                            xmptype.RealFieldType{},
+			"Exposure2012":                        xmptype.RealFieldType{},
+			"GradientBasedCorrections":            xmptype.OrderedArrayFieldType{ItemType: xmptype.LocalCorrectionFieldType{}},
+			"GrainAmount":                         xmptype.IntegerFieldType{},
+			"GrainFrequency":                      xmptype.IntegerFieldType{},
+			"GrainSeed":                           xmptype.IntegerFieldType{},
+			"GrainSize":                           xmptype.IntegerFieldType{},
+			"GreenHue":                            xmptype.IntegerFieldType{},
+			"GreenSaturation":                     xmptype.IntegerFieldType{},
+			"HasCrop":                             xmptype.BooleanFieldType{},
+			"HasSettings":                         xmptype.BooleanFieldType{},
+			"Highlights2012":                      xmptype.IntegerFieldType{},
+			"HueAdjustmentAqua":                   xmptype.IntegerFieldType{},
+			"HueAdjustmentBlue":                   xmptype.IntegerFieldType{},
+			"HueAdjustmentGreen":                  xmptype.IntegerFieldType{},
+			"HueAdjustmentMagenta":                xmptype.IntegerFieldType{},
+			"HueAdjustmentOrange":                 xmptype.IntegerFieldType{},
+			"HueAdjustmentPurple":                 xmptype.IntegerFieldType{},
+			"HueAdjustmentRed":                    xmptype.IntegerFieldType{},
+			"HueAdjustmentYellow":                 xmptype.IntegerFieldType{},
+			"IncrementalTemperature":              xmptype.IntegerFieldType{},
+			"IncrementalTint":                     xmptype.IntegerFieldType{},
+			"LensManualDistortionAmount":          xmptype.IntegerFieldType{},
+			"LensProfileChromaticAberrationScale": xmptype.IntegerFieldType{},
+			"LensProfileDigest":                   xmptype.TextFieldType{},
+			"LensProfileDistortionScale":          xmptype.IntegerFieldType{},
+			"LensProfileEnable":                   xmptype.IntegerFieldType{},
+			"LensProfileFilename":                 xmptype.TextFieldType{},
+			"LensProfileName":                     xmptype.TextFieldType{},
+			"LensProfileSetup":                    xmptype.TextFieldType{},
+			"LensProfileVignettingScale":          xmptype.IntegerFieldType{},
+			"Look":                                xmptype.GenericStructFieldType{},
+			"LuminanceAdjustmentAqua":             xmptype.IntegerFieldType{},
+			"LuminanceAdjustmentBlue":             xmptype.IntegerFieldType{},
+			"LuminanceAdjustmentGreen":            xmptype.IntegerFieldType{},
+			"LuminanceAdjustmentMagenta":          xmptype.IntegerFieldType{},
+			"LuminanceAdjustmentOrange":           xmptype.IntegerFieldType{},
+			"LuminanceAdjustmentPurple":           xmptype.IntegerFieldType{},
+			"LuminanceAdjustmentRed":              xmptype.IntegerFieldType{},
+			"LuminanceAdjustmentYellow":           xmptype.IntegerFieldType{},
+			"LuminanceNoiseReductionContrast":     xmptype.IntegerFieldType{},
+			"LuminanceNoiseReductionDetail":       xmptype.IntegerFieldType{},
+			"LuminanceSmoothing":                  xmptype.IntegerFieldType{},
+			"MaskGroupBasedCorrections":           xmptype.OrderedArrayFieldType{ItemType: xmptype.LocalCorrectionFieldType{}},
+			"OverrideLookVignette":                xmptype.BooleanFieldType{},
+			"PaintBasedCorrections":               xmptype.OrderedArrayFieldType{ItemType: xmptype.LocalCorrectionFieldType{}},
+			"ParametricDarks":                     xmptype.IntegerFieldType{},
+			"ParametricHighlightSplit":            xmptype.IntegerFieldType{},
+			"ParametricHighlights":                xmptype.IntegerFieldType{},
+			"ParametricLights":                    xmptype.IntegerFieldType{},
+			"ParametricMidtoneSplit":              xmptype.IntegerFieldType{},
+			"ParametricShadowSplit":               xmptype.IntegerFieldType{},
+			"ParametricShadows":                   xmptype.IntegerFieldType{},
+			"PerspectiveAspect":                   xmptype.IntegerFieldType{},
+			"PerspectiveHorizontal":               xmptype.RealFieldType{},
+			"PerspectiveRotate":                   xmptype.RealFieldType{},
+			"PerspectiveScale":                    xmptype.IntegerFieldType{},
+			"PerspectiveUpright":                  xmptype.IntegerFieldType{},
+			"PerspectiveVertical":                 xmptype.RealFieldType{},
+			"PerspectiveX":                        xmptype.RealFieldType{},
+			"PerspectiveY":                        xmptype.RealFieldType{},
+			"PostCropVignetteAmount":              xmptype.IntegerFieldType{},
+			"PostCropVignetteFeather":             xmptype.IntegerFieldType{},
+			"PostCropVignetteHighlightContrast":   xmptype.IntegerFieldType{},
+			"PostCropVignetteMidpoint":            xmptype.IntegerFieldType{},
+			"PostCropVignetteRoundness":           xmptype.IntegerFieldType{},
+			"PostCropVignetteStyle":               xmptype.IntegerFieldType{},
+			"ProcessVersion":                      xmptype.TextFieldType{},
+			"RawFileName":                         xmptype.TextFieldType{},
+			"RedHue":                              xmptype.IntegerFieldType{},
+			"RedSaturation":                       xmptype.IntegerFieldType{},
+			"RetouchAreas":                        xmptype.OrderedArrayFieldType{ItemType: xmptype.RetouchAreaFieldType{}},
+			"Saturation":                          xmptype.IntegerFieldType{},
+			"SaturationAdjustmentAqua":            xmptype.IntegerFieldType{},
+			"SaturationAdjustmentBlue":            xmptype.IntegerFieldType{},
+			"SaturationAdjustmentGreen":           xmptype.IntegerFieldType{},
+			"SaturationAdjustmentMagenta":         xmptype.IntegerFieldType{},
+			"SaturationAdjustmentOrange":          xmptype.IntegerFieldType{},
+			"SaturationAdjustmentPurple":          xmptype.IntegerFieldType{},
+			"SaturationAdjustmentRed":             xmptype.IntegerFieldType{},
+			"SaturationAdjustmentYellow":          xmptype.IntegerFieldType{},
+			"ShadowTint":                          xmptype.IntegerFieldType{},
+			"Shadows":                             xmptype.IntegerFieldType{},
+			"Shadows2012":                         xmptype.IntegerFieldType{},
+			"SharpenDetail":                       xmptype.IntegerFieldType{},
+			"SharpenEdgeMasking":                  xmptype.IntegerFieldType{},
+			"SharpenRadius":                       xmptype.RealFieldType{},
+			"Sharpness":                           xmptype.IntegerFieldType{},
+			"SplitToningBalance":                  xmptype.IntegerFieldType{},
+			"SplitToningHighlightHue":             xmptype.IntegerFieldType{},
+			"SplitToningHighlightSaturation":      xmptype.IntegerFieldType{},
+			"SplitToningShadowHue":                xmptype.IntegerFieldType{},
+			"SplitToningShadowSaturation":         xmptype.IntegerFieldType{},
+			"Temperature":                         xmptype.IntegerFieldType{},
+			"Texture":                             xmptype.IntegerFieldType{},
+			"Tint":                                xmptype.IntegerFieldType{},
+			"ToneCurve":                           xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"ToneCurveBlue":                       xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"ToneCurveGreen":                      xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"ToneCurveName":                       xmptype.TextFieldType{},
+			"ToneCurveName2012":                   xmptype.TextFieldType{},
+			"ToneCurvePV2012":                     xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"ToneCurvePV2012Blue":                 xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"ToneCurvePV2012Green":                xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"ToneCurvePV2012Red":                  xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"ToneCurveRed":                        xmptype.OrderedArrayFieldType{ItemType: xmptype.ToneCurvePointFieldType{}},
+			"UprightCenterMode":                   xmptype.IntegerFieldType{},
+			"UprightVersion":                      xmptype.IntegerFieldType{},
+			"Version":                             xmptype.TextFieldType{},
+			"Vibrance":                            xmptype.IntegerFieldType{},
+			"VignetteAmount":                      xmptype.IntegerFieldType{},
+			"VignetteMidpoint":                    xmptype.IntegerFieldType{},
+			"WhiteBalance":                        xmptype.DefinedChoiceFieldType{Choices: crsWhiteBalanceChoices, IsClosed: true},
+			"Whites2012":                          xmptype.IntegerFieldType{},
+		},
+
+		// ScopedFields resolves the fields of the struct-valued properties.
+		// Masks are found within corrections, retouch areas, and other masks.
+		// The correction settings of the look are the top-level settings.
+		ScopedFields: map[xml.Name]map[string]interface{}{
+			{Space: CrsUri, Local: "GradientBasedCorrections"}:         crsLocalCorrectionFields,
+			{Space: CrsUri, Local: "CircularGradientBasedCorrections"}: crsLocalCorrectionFields,
+			{Space: CrsUri, Local: "PaintBasedCorrections"}:            crsLocalCorrectionFields,
+			{Space: CrsUri, Local: "MaskGroupBasedCorrections"}:        crsLocalCorrectionFields,
+			{Space: CrsUri, Local: "CorrectionMasks"}:                  crsCorrectionMaskFields,
+			{Space: CrsUri, Local: "Masks"}:                            crsCorrectionMaskFields,
+			{Space: CrsUri, Local: "RetouchAreas"}:                     crsRetouchAreaFields,
+
+			{Space: CrsUri, Local: "Look"}: {
+				"Name":                   xmptype.TextFieldType{},
+				"Amount":                 xmptype.RealFieldType{},
+				"UUID":                   xmptype.TextFieldType{},
+				"SupportsAmount":         xmptype.BooleanFieldType{},
+				"SupportsMonochrome":     xmptype.BooleanFieldType{},
+				"SupportsOutputReferred": xmptype.BooleanFieldType{},
+				"Copyright":              xmptype.TextFieldType{},
+				"Group":                  xmptype.LanguageAlternativeArrayFieldType{},
+				"Parameters":             xmptype.GenericStructFieldType{},
+			},
+		},
+	}
+
+	// crsLocalCorrectionFields are the fields of a local correction.
+	crsLocalCorrectionFields = map[string]interface{}{
+		"CorrectionActive":           xmptype.BooleanFieldType{},
+		"CorrectionAmount":           xmptype.RealFieldType{},
+		"CorrectionMasks":            xmptype.OrderedArrayFieldType{ItemType: xmptype.CorrectionMaskFieldType{}},
+		"CorrectionName":             xmptype.TextFieldType{},
+		"CorrectionSyncID":           xmptype.TextFieldType{},
+		"LocalBlacks2012":            xmptype.RealFieldType{},
+		"LocalBrightness":            xmptype.RealFieldType{},
+		"LocalClarity":               xmptype.RealFieldType{},
+		"LocalClarity2012":           xmptype.RealFieldType{},
+		"LocalContrast":              xmptype.RealFieldType{},
+		"LocalContrast2012":          xmptype.RealFieldType{},
+		"LocalCurveRefineSaturation": xmptype.RealFieldType{},
+		"LocalDefringe":              xmptype.RealFieldType{},
+		"LocalDehaze":                xmptype.RealFieldType{},
+		"LocalExposure":              xmptype.RealFieldType{},
+		"LocalExposure2012":          xmptype.RealFieldType{},
+		"LocalGrain":                 xmptype.RealFieldType{},
+		"LocalHighlights2012":        xmptype.RealFieldType{},
+		"LocalHue":                   xmptype.RealFieldType{},
+		"LocalLuminanceNoise":        xmptype.RealFieldType{},
+		"LocalMoire":                 xmptype.RealFieldType{},
+		"LocalSaturation":            xmptype.RealFieldType{},
+		"LocalShadows2012":           xmptype.RealFieldType{},
+		"LocalSharpness":             xmptype.RealFieldType{},
+		"LocalTemperature":           xmptype.RealFieldType{},
+		"LocalTexture":               xmptype.RealFieldType{},
+		"LocalTint":                  xmptype.RealFieldType{},
+		"LocalToningHue":             xmptype.RealFieldType{},
+		"LocalToningSaturation":      xmptype.RealFieldType{},
+		"LocalWhites2012":            xmptype.RealFieldType{},
+		"What":                       xmptype.TextFieldType{},
+	}
+
+	// crsCorrectionMaskFields are the fields of a mask.
+	crsCorrectionMaskFields = map[string]interface{}{
+		"Angle":         xmptype.RealFieldType{},
+		"Bottom":        xmptype.RealFieldType{},
+		"CenterWeight":  xmptype.RealFieldType{},
+		"Dabs":          xmptype.OrderedTextArrayFieldType{},
+		"Feather":       xmptype.RealFieldType{},
+		"Flipped":       xmptype.BooleanFieldType{},
+		"Flow":          xmptype.RealFieldType{},
+		"FullX":         xmptype.RealFieldType{},
+		"FullY":         xmptype.RealFieldType{},
+		"Left":          xmptype.RealFieldType{},
+		"MaskActive":    xmptype.BooleanFieldType{},
+		"MaskBlendMode": xmptype.IntegerFieldType{},
+		"MaskInverted":  xmptype.BooleanFieldType{},
+		"MaskName":      xmptype.TextFieldType{},
+		"MaskSubType":   xmptype.IntegerFieldType{},
+		"MaskSyncID":    xmptype.TextFieldType{},
+		"MaskValue":     xmptype.RealFieldType{},
+		"Masks":         xmptype.OrderedArrayFieldType{ItemType: xmptype.CorrectionMaskFieldType{}},
+		"Midpoint":      xmptype.RealFieldType{},
+		"Radius":        xmptype.RealFieldType{},
+		"Right":         xmptype.RealFieldType{},
+		"Roundness":     xmptype.RealFieldType{},
+		"SizeX":         xmptype.RealFieldType{},
+		"SizeY":         xmptype.RealFieldType{},
+		"Top":           xmptype.RealFieldType{},
+		"Version":       xmptype.IntegerFieldType{},
+		"What":          xmptype.TextFieldType{},
+		"ZeroX":         xmptype.RealFieldType{},
+		"ZeroY":         xmptype.RealFieldType{},
+	}
+
+	// crsRetouchAreaFields are the fields of a retouch area.
+	crsRetouchAreaFields = map[string]interface{}{
+		"Feather":     xmptype.RealFieldType{},
+		"Masks":       xmptype.OrderedArrayFieldType{ItemType: xmptype.CorrectionMaskFieldType{}},
+		"Method":      xmptype.TextFieldType{},
+		"OffsetY":     xmptype.RealFieldType{},
+		"Opacity":     xmptype.RealFieldType{},
+		"Seed":        xmptype.IntegerFieldType{},
+		"SourceState": xmptype.TextFieldType{},
+		"SourceX":     xmptype.RealFieldType{},
+		"SpotType":    xmptype.TextFieldType{},
+	}
+
+	crsWhiteBalanceChoices = []xmptype.ChoiceDefinition{
+		{Code: "As Shot", Label: "As Shot"},
+		{Code: "Auto", Label: "Auto"},
+		{Code: "Daylight", Label: "Daylight"},
+		{Code: "Cloudy", Label: "Cloudy"},
+		{Code: "Shade", Label: "Shade"},
+		{Code: "Tungsten", Label: "Tungsten"},
+		{Code: "Fluorescent", Label: "Fluorescent"},
+		{Code: "Flash", Label: "Flash"},
+		{Code: "Custom", Label: "Custom"},
+	}
+)
+
+func init() {
+	xmpregistry.Register(CrsNamespace)
+}
@@ -11,10 +11,12 @@ const (
 	DcUri = "http://purl.org/dc/elements/1.1/"
 )
 
-func init() {
-	namespace := xmpregistry.Namespace{
+var (
+	// DcNamespace is the namespace descriptor for "dc".
+	DcNamespace = xmpregistry.Namespace{
 		Uri:             DcUri,
 		PreferredPrefix: "dc",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
 			"contributor": xmptype.UnorderedTextArrayFieldType{},
 			"coverage":    xmptype.TextFieldType{},
@@ -33,6 +35,8 @@ func init() {
 			"type":        xmptype.UnorderedTextArrayFieldType{},
 		},
 	}
+)
 
-	xmpregistry.Register(namespace)
+func init() {
+	xmpregistry.Register(DcNamespace)
 }
@@ -0,0 +1,313 @@
+package xmpnamespace
+
+import (
+	"encoding/xml"
+
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// ExifUri is the 'exif' namespace URI made a constant to support testing.
+	ExifUri = xmptype.ExifUri
+)
+
+var (
+	// ExifNamespace is the namespace descriptor for "exif".
+	ExifNamespace = xmpregistry.Namespace{
+		Uri:             ExifUri,
+		PreferredPrefix: "exif",
+		SpecReference:   "XMP Specification Part 2",
+		Fields: map[string]interface{}{
+			"ExifVersion":     xmptype.TextFieldType{},
+			"FlashpixVersion": xmptype.TextFieldType{},
+			"ColorSpace":      xmptype.DefinedChoiceFieldType{Choices: exifColorSpaceChoices, IsClosed: true},
+
+			// This is a closed choice of "Seq Integer" (e.g. [1, 2, 3, 0] for
+			// RGB). We take the items as they are.
+			"ComponentsConfiguration": xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+
+			"CompressedBitsPerPixel":   xmptype.RationalFieldType{},
+			"PixelXDimension":          xmptype.IntegerFieldType{},
+			"PixelYDimension":          xmptype.IntegerFieldType{},
+			"UserComment":              xmptype.LanguageAlternativeArrayFieldType{},
+			"RelatedSoundFile":         xmptype.TextFieldType{},
+			"DateTimeOriginal":         xmptype.DateFieldType{},
+			"DateTimeDigitized":        xmptype.DateFieldType{},
+			"ExposureTime":             xmptype.RationalFieldType{},
+			"FNumber":                  xmptype.RationalFieldType{},
+			"ExposureProgram":          xmptype.DefinedChoiceFieldType{Choices: exifExposureProgramChoices, IsClosed: true},
+			"SpectralSensitivity":      xmptype.TextFieldType{},
+			"ISOSpeedRatings":          xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+			"OECF":                     xmptype.OecfFieldType{},
+			"ShutterSpeedValue":        xmptype.RationalFieldType{},
+			"ApertureValue":            xmptype.RationalFieldType{},
+			"BrightnessValue":          xmptype.RationalFieldType{},
+			"ExposureBiasValue":        xmptype.RationalFieldType{},
+			"MaxApertureValue":         xmptype.RationalFieldType{},
+			"SubjectDistance":          xmptype.RationalFieldType{},
+			"MeteringMode":             xmptype.DefinedChoiceFieldType{Choices: exifMeteringModeChoices, IsClosed: true},
+			"LightSource":              xmptype.DefinedChoiceFieldType{Choices: exifLightSourceChoices, IsClosed: true},
+			"Flash":                    xmptype.FlashFieldType{},
+			"FocalLength":              xmptype.RationalFieldType{},
+			"SubjectArea":              xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+			"FlashEnergy":              xmptype.RationalFieldType{},
+			"SpatialFrequencyResponse": xmptype.OecfFieldType{},
+			"FocalPlaneXResolution":    xmptype.RationalFieldType{},
+			"FocalPlaneYResolution":    xmptype.RationalFieldType{},
+			"FocalPlaneResolutionUnit": xmptype.DefinedChoiceFieldType{Choices: exifFocalPlaneResolutionUnitChoices, IsClosed: true},
+			"SubjectLocation":          xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+			"ExposureIndex":            xmptype.RationalFieldType{},
+			"SensingMethod":            xmptype.DefinedChoiceFieldType{Choices: exifSensingMethodChoices, IsClosed: true},
+			"FileSource":               xmptype.DefinedChoiceFieldType{Choices: exifFileSourceChoices, IsClosed: true},
+			"SceneType":                xmptype.DefinedChoiceFieldType{Choices: exifSceneTypeChoices, IsClosed: true},
+			"CFAPattern":               xmptype.CfaPatternFieldType{},
+			"CustomRendered":           xmptype.DefinedChoiceFieldType{Choices: exifCustomRenderedChoices, IsClosed: true},
+			"ExposureMode":             xmptype.DefinedChoiceFieldType{Choices: exifExposureModeChoices, IsClosed: true},
+			"WhiteBalance":             xmptype.DefinedChoiceFieldType{Choices: exifWhiteBalanceChoices, IsClosed: true},
+			"DigitalZoomRatio":         xmptype.RationalFieldType{},
+			"FocalLengthIn35mmFilm":    xmptype.IntegerFieldType{},
+			"SceneCaptureType":         xmptype.DefinedChoiceFieldType{Choices: exifSceneCaptureTypeChoices, IsClosed: true},
+			"GainControl":              xmptype.DefinedChoiceFieldType{Choices: exifGainControlChoices, IsClosed: true},
+			"Contrast":                 xmptype.DefinedChoiceFieldType{Choices: exifContrastChoices, IsClosed: true},
+			"Saturation":               xmptype.DefinedChoiceFieldType{Choices: exifSaturationChoices, IsClosed: true},
+			"Sharpness":                xmptype.DefinedChoiceFieldType{Choices: exifSharpnessChoices, IsClosed: true},
+			"DeviceSettingDescription": xmptype.DeviceSettingsFieldType{},
+			"SubjectDistanceRange":     xmptype.DefinedChoiceFieldType{Choices: exifSubjectDistanceRangeChoices, IsClosed: true},
+			"ImageUniqueID":            xmptype.TextFieldType{},
+			"NativeDigest":             xmptype.TextFieldType{},
+
+			// GPS
+
+			"GPSVersionID":        xmptype.TextFieldType{},
+			"GPSLatitude":         xmptype.GpsCoordinateFieldType{},
+			"GPSLongitude":        xmptype.GpsCoordinateFieldType{},
+			"GPSAltitudeRef":      xmptype.DefinedChoiceFieldType{Choices: exifGpsAltitudeRefChoices, IsClosed: true},
+			"GPSAltitude":         xmptype.RationalFieldType{},
+			"GPSTimeStamp":        xmptype.DateFieldType{},
+			"GPSSatellites":       xmptype.TextFieldType{},
+			"GPSStatus":           xmptype.DefinedChoiceFieldType{Choices: exifGpsStatusChoices, IsClosed: true},
+			"GPSMeasureMode":      xmptype.DefinedChoiceFieldType{Choices: exifGpsMeasureModeChoices, IsClosed: true},
+			"GPSDOP":              xmptype.RationalFieldType{},
+			"GPSSpeedRef":         xmptype.DefinedChoiceFieldType{Choices: exifGpsSpeedRefChoices, IsClosed: true},
+			"GPSSpeed":            xmptype.RationalFieldType{},
+			"GPSTrackRef":         xmptype.DefinedChoiceFieldType{Choices: exifGpsDirectionRefChoices, IsClosed: true},
+			"GPSTrack":            xmptype.RationalFieldType{},
+			"GPSImgDirectionRef":  xmptype.DefinedChoiceFieldType{Choices: exifGpsDirectionRefChoices, IsClosed: true},
+			"GPSImgDirection":     xmptype.RationalFieldType{},
+			"GPSMapDatum":         xmptype.TextFieldType{},
+			"GPSDestLatitude":     xmptype.GpsCoordinateFieldType{},
+			"GPSDestLongitude":    xmptype.GpsCoordinateFieldType{},
+			"GPSDestBearingRef":   xmptype.DefinedChoiceFieldType{Choices: exifGpsDirectionRefChoices, IsClosed: true},
+			"GPSDestBearing":      xmptype.RationalFieldType{},
+			"GPSDestDistanceRef":  xmptype.DefinedChoiceFieldType{Choices: exifGpsSpeedRefChoices, IsClosed: true},
+			"GPSDestDistance":     xmptype.RationalFieldType{},
+			"GPSProcessingMethod": xmptype.TextFieldType{},
+			"GPSAreaInformation":  xmptype.TextFieldType{},
+			"GPSDifferential":     xmptype.DefinedChoiceFieldType{Choices: exifGpsDifferentialChoices, IsClosed: true},
+		},
+
+		// ScopedFields resolves the fields of the struct-valued properties.
+		// The fields of the OECF/SFR, CFAPattern, and DeviceSettings structs
+		// share names but not types.
+		ScopedFields: map[xml.Name]map[string]interface{}{
+			{Space: ExifUri, Local: "OECF"}:                     exifOecfFields,
+			{Space: ExifUri, Local: "SpatialFrequencyResponse"}: exifOecfFields,
+
+			{Space: ExifUri, Local: "CFAPattern"}: {
+				"Columns": xmptype.IntegerFieldType{},
+				"Rows":    xmptype.IntegerFieldType{},
+				"Values":  xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+			},
+
+			{Space: ExifUri, Local: "DeviceSettingDescription"}: {
+				"Columns":  xmptype.IntegerFieldType{},
+				"Rows":     xmptype.IntegerFieldType{},
+				"Settings": xmptype.OrderedTextArrayFieldType{},
+			},
+
+			{Space: ExifUri, Local: "Flash"}: {
+				"Fired":      xmptype.BooleanFieldType{},
+				"Return":     xmptype.IntegerFieldType{},
+				"Mode":       xmptype.IntegerFieldType{},
+				"Function":   xmptype.BooleanFieldType{},
+				"RedEyeMode": xmptype.BooleanFieldType{},
+			},
+		},
+	}
+
+	// exifOecfFields are the fields of an OECF/SFR struct.
+	exifOecfFields = map[string]interface{}{
+		"Columns": xmptype.IntegerFieldType{},
+		"Rows":    xmptype.IntegerFieldType{},
+		"Names":   xmptype.OrderedTextArrayFieldType{},
+		"Values":  xmptype.OrderedArrayFieldType{ItemType: xmptype.RationalFieldType{}},
+	}
+
+	exifColorSpaceChoices = []xmptype.ChoiceDefinition{
+		{Code: "1", Label: "sRGB"},
+		{Code: "65535", Label: "Uncalibrated"},
+	}
+
+	exifExposureProgramChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Not defined"},
+		{Code: "1", Label: "Manual"},
+		{Code: "2", Label: "Normal program"},
+		{Code: "3", Label: "Aperture priority"},
+		{Code: "4", Label: "Shutter priority"},
+		{Code: "5", Label: "Creative program"},
+		{Code: "6", Label: "Action program"},
+		{Code: "7", Label: "Portrait mode"},
+		{Code: "8", Label: "Landscape mode"},
+	}
+
+	exifMeteringModeChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Unknown"},
+		{Code: "1", Label: "Average"},
+		{Code: "2", Label: "Center-weighted average"},
+		{Code: "3", Label: "Spot"},
+		{Code: "4", Label: "Multi-spot"},
+		{Code: "5", Label: "Pattern"},
+		{Code: "6", Label: "Partial"},
+		{Code: "255", Label: "Other"},
+	}
+
+	exifLightSourceChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Unknown"},
+		{Code: "1", Label: "Daylight"},
+		{Code: "2", Label: "Fluorescent"},
+		{Code: "3", Label: "Tungsten"},
+		{Code: "4", Label: "Flash"},
+		{Code: "9", Label: "Fine weather"},
+		{Code: "10", Label: "Cloudy weather"},
+		{Code: "11", Label: "Shade"},
+		{Code: "12", Label: "Daylight fluorescent"},
+		{Code: "13", Label: "Day white fluorescent"},
+		{Code: "14", Label: "Cool white fluorescent"},
+		{Code: "15", Label: "White fluorescent"},
+		{Code: "17", Label: "Standard light A"},
+		{Code: "18", Label: "Standard light B"},
+		{Code: "19", Label: "Standard light C"},
+		{Code: "20", Label: "D55"},
+		{Code: "21", Label: "D65"},
+		{Code: "22", Label: "D75"},
+		{Code: "23", Label: "D50"},
+		{Code: "24", Label: "ISO studio tungsten"},
+		{Code: "255", Label: "Other"},
+	}
+
+	exifFocalPlaneResolutionUnitChoices = []xmptype.ChoiceDefinition{
+		{Code: "2", Label: "Inches"},
+		{Code: "3", Label: "Centimeters"},
+	}
+
+	exifSensingMethodChoices = []xmptype.ChoiceDefinition{
+		{Code: "1", Label: "Not defined"},
+		{Code: "2", Label: "One-chip color area sensor"},
+		{Code: "3", Label: "Two-chip color area sensor"},
+		{Code: "4", Label: "Three-chip color area sensor"},
+		{Code: "5", Label: "Color sequential area sensor"},
+		{Code: "7", Label: "Trilinear sensor"},
+		{Code: "8", Label: "Color sequential linear sensor"},
+	}
+
+	exifFileSourceChoices = []xmptype.ChoiceDefinition{
+		{Code: "3", Label: "Digital still camera"},
+	}
+
+	exifSceneTypeChoices = []xmptype.ChoiceDefinition{
+		{Code: "1", Label: "Directly photographed"},
+	}
+
+	exifCustomRenderedChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Normal process"},
+		{Code: "1", Label: "Custom process"},
+	}
+
+	exifExposureModeChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Auto exposure"},
+		{Code: "1", Label: "Manual exposure"},
+		{Code: "2", Label: "Auto bracket"},
+	}
+
+	exifWhiteBalanceChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Auto white balance"},
+		{Code: "1", Label: "Manual white balance"},
+	}
+
+	exifSceneCaptureTypeChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Standard"},
+		{Code: "1", Label: "Landscape"},
+		{Code: "2", Label: "Portrait"},
+		{Code: "3", Label: "Night scene"},
+	}
+
+	exifGainControlChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "None"},
+		{Code: "1", Label: "Low gain up"},
+		{Code: "2", Label: "High gain up"},
+		{Code: "3", Label: "Low gain down"},
+		{Code: "4", Label: "High gain down"},
+	}
+
+	exifContrastChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Normal"},
+		{Code: "1", Label: "Soft"},
+		{Code: "2", Label: "Hard"},
+	}
+
+	exifSaturationChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Normal"},
+		{Code: "1", Label: "Low saturation"},
+		{Code: "2", Label: "High saturation"},
+	}
+
+	exifSharpnessChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Normal"},
+		{Code: "1", Label: "Soft"},
+		{Code: "2", Label: "Hard"},
+	}
+
+	exifSubjectDistanceRangeChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Unknown"},
+		{Code: "1", Label: "Macro"},
+		{Code: "2", Label: "Close view"},
+		{Code: "3", Label: "Distant view"},
+	}
+
+	exifGpsAltitudeRefChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Above sea level"},
+		{Code: "1", Label: "Below sea level"},
+	}
+
+	exifGpsStatusChoices = []xmptype.ChoiceDefinition{
+		{Code: "A", Label: "Measurement in progress"},
+		{Code: "V", Label: "Measurement is interoperability"},
+	}
+
+	exifGpsMeasureModeChoices = []xmptype.ChoiceDefinition{
+		{Code: "2", Label: "Two-dimensional measurement"},
+		{Code: "3", Label: "Three-dimensional measurement"},
+	}
+
+	// exifGpsSpeedRefChoices are the units of speeds and distances.
+	exifGpsSpeedRefChoices = []xmptype.ChoiceDefinition{
+		{Code: "K", Label: "Kilometers"},
+		{Code: "M", Label: "Miles"},
+		{Code: "N", Label: "Knots"},
+	}
+
+	exifGpsDirectionRefChoices = []xmptype.ChoiceDefinition{
+		{Code: "T", Label: "True direction"},
+		{Code: "M", Label: "Magnetic direction"},
+	}
+
+	exifGpsDifferentialChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Without correction"},
+		{Code: "1", Label: "Correction applied"},
+	}
+)
+
+func init() {
+	xmpregistry.Register(ExifNamespace)
+}
@@ -0,0 +1,60 @@
+package xmpnamespace
+
+import (
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// ExifExUri is the 'exifEX' namespace URI made a constant to support
+	// testing.
+	ExifExUri = "http://cipa.jp/exif/1.0/"
+)
+
+var (
+	// ExifExNamespace is the namespace descriptor for "exifEX" (the Exif 2.3
+	// properties that were added by CIPA).
+	ExifExNamespace = xmpregistry.Namespace{
+		Uri:             ExifExUri,
+		PreferredPrefix: "exifEX",
+		SpecReference:   "CIPA DC-010-2012",
+		Fields: map[string]interface{}{
+			"InteroperabilityIndex":     xmptype.DefinedChoiceFieldType{Choices: exifExInteroperabilityIndexChoices, IsClosed: false},
+			"Gamma":                     xmptype.RationalFieldType{},
+			"CameraOwnerName":           xmptype.ProperNameFieldType{},
+			"BodySerialNumber":          xmptype.TextFieldType{},
+			"LensSpecification":         xmptype.OrderedArrayFieldType{ItemType: xmptype.RationalFieldType{}},
+			"LensMake":                  xmptype.ProperNameFieldType{},
+			"LensModel":                 xmptype.ProperNameFieldType{},
+			"LensSerialNumber":          xmptype.TextFieldType{},
+			"PhotographicSensitivity":   xmptype.IntegerFieldType{},
+			"SensitivityType":           xmptype.DefinedChoiceFieldType{Choices: exifExSensitivityTypeChoices, IsClosed: true},
+			"StandardOutputSensitivity": xmptype.IntegerFieldType{},
+			"RecommendedExposureIndex":  xmptype.IntegerFieldType{},
+			"ISOSpeed":                  xmptype.IntegerFieldType{},
+			"ISOSpeedLatitudeyyy":       xmptype.IntegerFieldType{},
+			"ISOSpeedLatitudezzz":       xmptype.IntegerFieldType{},
+		},
+	}
+
+	exifExInteroperabilityIndexChoices = []xmptype.ChoiceDefinition{
+		{Code: "R98", Label: "ExifR98"},
+		{Code: "THM", Label: "DCF thumbnail"},
+		{Code: "R03", Label: "DCF option"},
+	}
+
+	exifExSensitivityTypeChoices = []xmptype.ChoiceDefinition{
+		{Code: "0", Label: "Unknown"},
+		{Code: "1", Label: "Standard output sensitivity"},
+		{Code: "2", Label: "Recommended exposure index"},
+		{Code: "3", Label: "ISO speed"},
+		{Code: "4", Label: "Standard output sensitivity and recommended exposure index"},
+		{Code: "5", Label: "Standard output sensitivity and ISO speed"},
+		{Code: "6", Label: "Recommended exposure index and ISO speed"},
+		{Code: "7", Label: "Standard output sensitivity, recommended exposure index, and ISO speed"},
+	}
+)
+
+func init() {
+	xmpregistry.Register(ExifExNamespace)
+}
@@ -0,0 +1,34 @@
+package xmpnamespace
+
+import (
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// LrUri is the 'lr' namespace URI made a constant to support testing.
+	LrUri = "http://ns.adobe.com/lightroom/1.0/"
+)
+
+var (
+	// LrNamespace is the namespace descriptor for "lr" (Lightroom).
+	LrNamespace = xmpregistry.Namespace{
+		Uri:             LrUri,
+		PreferredPrefix: "lr",
+		Fields: map[string]interface{}{
+			// Levels of a hierarchical keyword are separated by "|" (e.g.
+			// "Places|Europe|France").
+			"hierarchicalSubject": xmptype.UnorderedTextArrayFieldType{},
+
+			// Weighted keywords are written as "keyword" or "keyword:weight". We
+			// take the items as they are.
+			"weightedFlatSubject": xmptype.UnorderedTextArrayFieldType{},
+
+			"privateRTKInfo": xmptype.TextFieldType{},
+		},
+	}
+)
+
+func init() {
+	xmpregistry.Register(LrNamespace)
+}
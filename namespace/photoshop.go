@@ -11,10 +11,12 @@ const (
 	PhotoshopUri = "http://ns.adobe.com/photoshop/1.0/"
 )
 
-func init() {
-	namespace := xmpregistry.Namespace{
+var (
+	// PhotoshopNamespace is the namespace descriptor for "photoshop".
+	PhotoshopNamespace = xmpregistry.Namespace{
 		Uri:             PhotoshopUri,
 		PreferredPrefix: "photoshop",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			"AncestorID":        xmptype.UriFieldType{},
 			"LayerName":         xmptype.TextFieldType{},
@@ -23,7 +25,7 @@ func init() {
 			"CaptionWriter":     xmptype.TextFieldType{},
 			"Category":          xmptype.TextFieldType{},
 			"City":              xmptype.TextFieldType{},
-			"ColorMode":         xmptype.ClosedChoiceFieldType{},
+			"ColorMode":         xmptype.ColorModeFieldType{},
 			"Country":           xmptype.TextFieldType{},
 			"Credit":            xmptype.TextFieldType{},
 			"DateCreated":       xmptype.DateFieldType{},
@@ -44,6 +46,8 @@ func init() {
 			"Urgency":               xmptype.IntegerFieldType{},
 		},
 	}
+)
 
-	xmpregistry.Register(namespace)
+func init() {
+	xmpregistry.Register(PhotoshopNamespace)
 }
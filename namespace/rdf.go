@@ -33,12 +33,59 @@ var (
 		Local: "li",
 	}
 
+	// RdfSeqTag is the name for the "Seq" (ordered array) tag.
+	RdfSeqTag = xml.Name{
+		Space: RdfUri,
+		Local: "Seq",
+	}
+
+	// RdfBagTag is the name for the "Bag" (unordered array) tag.
+	RdfBagTag = xml.Name{
+		Space: RdfUri,
+		Local: "Bag",
+	}
+
+	// RdfAltTag is the name for the "Alt" (alternatives array) tag.
+	RdfAltTag = xml.Name{
+		Space: RdfUri,
+		Local: "Alt",
+	}
+
+	// RdfValueTag is the name for the "value" tag that carries the value of a
+	// qualified property.
+	RdfValueTag = xml.Name{
+		Space: RdfUri,
+		Local: "value",
+	}
+
+	// RdfAboutAttribute is the name of the attribute that gives the IRI of
+	// the resource that a description describes.
+	RdfAboutAttribute = xml.Name{
+		Space: RdfUri,
+		Local: "about",
+	}
+
+	// RdfParseTypeAttribute is the name of the attribute that says how the
+	// content of a property is to be read (e.g. "Resource" for a struct).
+	RdfParseTypeAttribute = xml.Name{
+		Space: RdfUri,
+		Local: "parseType",
+	}
+
+	// RdfResourceAttribute is the name of the attribute that gives an IRI as
+	// the value of a property.
+	RdfResourceAttribute = xml.Name{
+		Space: RdfUri,
+		Local: "resource",
+	}
+
 	// RdfNamespace is the namespace descriptor for "rdf". We do not define any
 	// fields for it because it defined no leaf nodes [that we have encountered]
 	// and therefore we require no parsing and no knowledge of types.
 	RdfNamespace = xmpregistry.Namespace{
 		Uri:             RdfUri,
 		PreferredPrefix: "rdf",
+		SpecReference:   "XMP Specification Part 1",
 	}
 )
 
@@ -15,10 +15,11 @@ func init() {
 	namespace := xmpregistry.Namespace{
 		Uri:             StDimUri,
 		PreferredPrefix: "stDim",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			"h":    xmptype.RealFieldType{},
 			"w":    xmptype.RealFieldType{},
-			"unit": xmptype.OpenChoiceFieldType{},
+			"unit": xmptype.DimensionUnitFieldType{},
 		},
 	}
 
@@ -10,13 +10,15 @@ import (
 const (
 	// StEvtUri is the 'stEvt' namespace URI made a constant to support
 	// testing.
-	StEvtUri = "http://ns.adobe.com/xap/1.0/sType/ResourceEvent#"
+	StEvtUri = xmptype.StEvtUri
 )
 
-func init() {
-	namespace := xmpregistry.Namespace{
+var (
+	// StEvtNamespace is the namespace descriptor for "stEvt".
+	StEvtNamespace = xmpregistry.Namespace{
 		Uri:             StEvtUri,
 		PreferredPrefix: "stEvt",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
 			"action":        xmptype.TextFieldType{},
 			"changed":       xmptype.TextFieldType{},
@@ -26,6 +28,8 @@ func init() {
 			"when":          xmptype.DateFieldType{},
 		},
 	}
+)
 
-	xmpregistry.Register(namespace)
+func init() {
+	xmpregistry.Register(StEvtNamespace)
 }
@@ -19,6 +19,7 @@ var (
 	StFntNamespace = xmpregistry.Namespace{
 		Uri:             StFntUri,
 		PreferredPrefix: "stFnt",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			"childFontFiles": xmptype.OrderedTextArrayFieldType{},
 			"composite":      xmptype.BooleanFieldType{},
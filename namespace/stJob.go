@@ -15,6 +15,7 @@ func init() {
 	namespace := xmpregistry.Namespace{
 		Uri:             StJobUri,
 		PreferredPrefix: "stJob",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			"id":   xmptype.TextFieldType{},
 			"name": xmptype.TextFieldType{},
@@ -8,7 +8,7 @@ import (
 const (
 	// StRefUri is the 'stRef' namespace URI made a constant to support
 	// testing.
-	StRefUri = "http://ns.adobe.com/xap/1.0/sType/ResourceRef#"
+	StRefUri = xmptype.StRefUri
 )
 
 var (
@@ -16,6 +16,7 @@ var (
 	StRefNamespace = xmpregistry.Namespace{
 		Uri:             StRefUri,
 		PreferredPrefix: "stRef",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
 			"alternatePaths":  xmptype.OrderedUriArrayFieldType{},
 			"documentID":      xmptype.UriFieldType{},
@@ -27,7 +28,7 @@ var (
 			"managerVariant":  xmptype.TextFieldType{},
 			"manageTo":        xmptype.UriFieldType{},
 			"manageUI":        xmptype.UriFieldType{},
-			"maskMarkers":     xmptype.ClosedChoiceFieldType{},
+			"maskMarkers":     xmptype.MaskMarkersFieldType{},
 			"partMapping":     xmptype.TextFieldType{},
 			"renditionClass":  xmptype.RenditionClassFieldType{},
 			"renditionParams": xmptype.TextFieldType{},
@@ -8,18 +8,25 @@ import (
 const (
 	// StVerUri is the 'stVer' namespace URI made a constant to support
 	// testing.
-	StVerUri = "http://ns.adobe.com/xap/1.0/sType/Version#"
+	StVerUri = xmptype.StVerUri
 )
 
-func init() {
-	namespace := xmpregistry.Namespace{
+var (
+	// StVerNamespace is the namespace descriptor for "stVer".
+	StVerNamespace = xmpregistry.Namespace{
 		Uri:             StVerUri,
 		PreferredPrefix: "stVer",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
-			"comments": xmptype.TextFieldType{},
-			// "event":    xmptype.ResourceEventFieldType{},
+			"comments":   xmptype.TextFieldType{},
+			"event":      xmptype.ResourceEventFieldType{},
+			"modifyDate": xmptype.DateFieldType{},
+			"modifier":   xmptype.ProperNameFieldType{},
+			"version":    xmptype.TextFieldType{},
 		},
 	}
+)
 
-	xmpregistry.Register(namespace)
+func init() {
+	xmpregistry.Register(StVerNamespace)
 }
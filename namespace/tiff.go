@@ -0,0 +1,92 @@
+package xmpnamespace
+
+import (
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	// TiffUri is the 'tiff' namespace URI made a constant to support testing.
+	TiffUri = "http://ns.adobe.com/tiff/1.0/"
+)
+
+var (
+	// TiffNamespace is the namespace descriptor for "tiff".
+	TiffNamespace = xmpregistry.Namespace{
+		Uri:             TiffUri,
+		PreferredPrefix: "tiff",
+		SpecReference:   "XMP Specification Part 2",
+		Fields: map[string]interface{}{
+			"ImageWidth":                xmptype.IntegerFieldType{},
+			"ImageLength":               xmptype.IntegerFieldType{},
+			"BitsPerSample":             xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+			"Compression":               xmptype.DefinedChoiceFieldType{Choices: tiffCompressionChoices, IsClosed: true},
+			"PhotometricInterpretation": xmptype.DefinedChoiceFieldType{Choices: tiffPhotometricInterpretationChoices, IsClosed: true},
+			"Orientation":               xmptype.DefinedChoiceFieldType{Choices: tiffOrientationChoices, IsClosed: true},
+			"SamplesPerPixel":           xmptype.IntegerFieldType{},
+			"PlanarConfiguration":       xmptype.DefinedChoiceFieldType{Choices: tiffPlanarConfigurationChoices, IsClosed: true},
+
+			// This is a closed choice of "Seq Integer" ([2, 1] for YCbCr4:2:2
+			// and [2, 2] for YCbCr4:2:0). We take the items as they are.
+			"YCbCrSubSampling": xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+
+			"YCbCrPositioning":      xmptype.DefinedChoiceFieldType{Choices: tiffYCbCrPositioningChoices, IsClosed: true},
+			"XResolution":           xmptype.RationalFieldType{},
+			"YResolution":           xmptype.RationalFieldType{},
+			"ResolutionUnit":        xmptype.DefinedChoiceFieldType{Choices: tiffResolutionUnitChoices, IsClosed: true},
+			"TransferFunction":      xmptype.OrderedArrayFieldType{ItemType: xmptype.IntegerFieldType{}},
+			"WhitePoint":            xmptype.OrderedArrayFieldType{ItemType: xmptype.RationalFieldType{}},
+			"PrimaryChromaticities": xmptype.OrderedArrayFieldType{ItemType: xmptype.RationalFieldType{}},
+			"YCbCrCoefficients":     xmptype.OrderedArrayFieldType{ItemType: xmptype.RationalFieldType{}},
+			"ReferenceBlackWhite":   xmptype.OrderedArrayFieldType{ItemType: xmptype.RationalFieldType{}},
+			"DateTime":              xmptype.DateFieldType{},
+			"ImageDescription":      xmptype.LanguageAlternativeArrayFieldType{},
+			"Make":                  xmptype.ProperNameFieldType{},
+			"Model":                 xmptype.ProperNameFieldType{},
+			"Software":              xmptype.AgentNameFieldType{},
+			"Artist":                xmptype.ProperNameFieldType{},
+			"Copyright":             xmptype.LanguageAlternativeArrayFieldType{},
+			"NativeDigest":          xmptype.TextFieldType{},
+		},
+	}
+
+	tiffCompressionChoices = []xmptype.ChoiceDefinition{
+		{Code: "1", Label: "Uncompressed"},
+		{Code: "6", Label: "JPEG"},
+	}
+
+	tiffPhotometricInterpretationChoices = []xmptype.ChoiceDefinition{
+		{Code: "2", Label: "RGB"},
+		{Code: "6", Label: "YCbCr"},
+	}
+
+	tiffOrientationChoices = []xmptype.ChoiceDefinition{
+		{Code: "1", Label: "Top, left"},
+		{Code: "2", Label: "Top, right"},
+		{Code: "3", Label: "Bottom, right"},
+		{Code: "4", Label: "Bottom, left"},
+		{Code: "5", Label: "Left, top"},
+		{Code: "6", Label: "Right, top"},
+		{Code: "7", Label: "Right, bottom"},
+		{Code: "8", Label: "Left, bottom"},
+	}
+
+	tiffPlanarConfigurationChoices = []xmptype.ChoiceDefinition{
+		{Code: "1", Label: "Chunky"},
+		{Code: "2", Label: "Planar"},
+	}
+
+	tiffYCbCrPositioningChoices = []xmptype.ChoiceDefinition{
+		{Code: "1", Label: "Centered"},
+		{Code: "2", Label: "Co-sited"},
+	}
+
+	tiffResolutionUnitChoices = []xmptype.ChoiceDefinition{
+		{Code: "2", Label: "Inches"},
+		{Code: "3", Label: "Centimeters"},
+	}
+)
+
+func init() {
+	xmpregistry.Register(TiffNamespace)
+}
@@ -14,6 +14,7 @@ var (
 	XNamespace = xmpregistry.Namespace{
 		Uri:             XUri,
 		PreferredPrefix: "x",
+		SpecReference:   "XMP Specification Part 1",
 	}
 )
 
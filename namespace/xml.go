@@ -1,6 +1,8 @@
 package xmpnamespace
 
 import (
+	"encoding/xml"
+
 	"github.com/dsoprea/go-xmp/registry"
 	"github.com/dsoprea/go-xmp/type"
 )
@@ -8,18 +10,32 @@ import (
 const (
 	// XmlUri is the 'xml' namespace URI made a constant to support testing.
 	XmlUri = "http://www.w3.org/XML/1998/namespace"
+
+	// DefaultLanguage is the "xml:lang" of the default item of an array of
+	// language alternatives.
+	DefaultLanguage = "x-default"
 )
 
 // We only define this type so that we parse xml:lang attributes.
 
-func init() {
-	namespace := xmpregistry.Namespace{
+var (
+	// XmlLangAttribute is the name of the "xml:lang" attribute.
+	XmlLangAttribute = xml.Name{
+		Space: XmlUri,
+		Local: "lang",
+	}
+
+	// XmlNamespace is the namespace descriptor for "xml".
+	XmlNamespace = xmpregistry.Namespace{
 		Uri:             XmlUri,
 		PreferredPrefix: "xml",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
 			"lang": xmptype.TextFieldType{},
 		},
 	}
+)
 
-	xmpregistry.Register(namespace)
+func init() {
+	xmpregistry.Register(XmlNamespace)
 }
@@ -15,6 +15,7 @@ var (
 	XmpNamespace = xmpregistry.Namespace{
 		Uri:             XmpUri,
 		PreferredPrefix: "xmp",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
 			"BaseURL":      xmptype.UrlFieldType{},
 			"CreateDate":   xmptype.DateFieldType{},
@@ -14,6 +14,7 @@ func init() {
 	namespace := xmpregistry.Namespace{
 		Uri:             XmpBJUri,
 		PreferredPrefix: "xmpBJ",
+		SpecReference:   "XMP Specification Part 2",
 		// Fields:          map[string]FieldType{
 		// 	// NOTE(dustin): Not implementing due to irrelevancy to how we process values.
 		// 	// "JobRef":,
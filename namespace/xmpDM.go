@@ -1,19 +1,23 @@
 package xmpnamespace
 
 import (
+	"encoding/xml"
+
 	"github.com/dsoprea/go-xmp/registry"
 	"github.com/dsoprea/go-xmp/type"
 )
 
 const (
 	// XmpDmUri is the 'xmpDM' namespace URI made a constant to support testing.
-	XmpDmUri = "http://ns.adobe.com/xmp/1.0/DynamicMedia/"
+	XmpDmUri = xmptype.XmpDmUri
 )
 
-func init() {
-	namespace := xmpregistry.Namespace{
+var (
+	// XmpDmNamespace is the namespace descriptor for "xmpDM".
+	XmpDmNamespace = xmpregistry.Namespace{
 		Uri:             XmpDmUri,
 		PreferredPrefix: "xmpDM",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			"riseInDecibel": xmptype.RealFieldType{},
 
@@ -21,77 +25,80 @@ func init() {
 			// "riseInTimeDuration": TimeFieldType,
 
 			"useFileBeatsMarker": xmptype.BooleanFieldType{},
+			"altTimecode":        xmptype.TimecodeFieldType{},
+			"startTimecode":      xmptype.TimecodeFieldType{},
 			"key":                xmptype.TextFieldType{},
 
-			// TODO(dustin): Contradiction in specification. We need to wait to observe real data. Similar to other comment, below.
-			//
-			// In XMP Specification (Part 2)...
-			// - 1.2.6.2 text
-			// - 1.2.6.9 integer
-			//
-			// "value":   TextFieldType{},
+			// This is text in a CuePointParam (1.2.6.2) but an integer in a Time
+			// (1.2.6.9). The latter is scoped below.
+			"value":   xmptype.TextFieldType{},
 			"comment": xmptype.TextFieldType{},
 
-			// Not a scalar type. Irrelevant here.
-			// "cuePointParams":,
-
-			"cuePointType": xmptype.TextFieldType{},
-
-			// TODO(dustin): Contradiction in specification. We need to wait to observe real data. Similar to other comment, below.
-			//
-			// In XMP Specification (Part 2)...
-			// - 1.2.6.5 FrameCount
-			// - 1.2.6.6 Time
-			// - 2.5 Time
-			//
-			// "duration":     FrameCountFieldType{},
+			"cuePointParams": xmptype.OrderedCuePointParamArrayFieldType{},
+			"cuePointType":   xmptype.TextFieldType{},
+
+			// This is a Time as a top-level property (2.5) but a FrameCount in a
+			// Marker (1.2.6.5). The latter is scoped below.
+			"duration":    xmptype.TimeFieldType{},
 			"location":    xmptype.UriFieldType{},
 			"name":        xmptype.TextFieldType{},
 			"probability": xmptype.RealFieldType{},
 			"speaker":     xmptype.TextFieldType{},
 
-			// TODO(dustin): Contradiction in specification. We need to wait to observe real data. Similar to other comment, below.
-			//
-			// In XMP Specification (Part 2)...
-			// - 1.2.6.5 FrameCount
-			// - 1.2.6.6 Time
-			//
-			// "startTime":    FrameCountFieldType{},
-			"target":       xmptype.TextFieldType{},
-			"type":         xmptype.OpenChoiceFieldType{},
+			// This is a FrameCount in a Marker (1.2.6.5). It is also listed under
+			// Time (1.2.6.6), which doesn't otherwise use it.
+			"startTime": xmptype.FrameCountFieldType{},
+			"target":    xmptype.TextFieldType{},
+
+			// This is an open choice in a Marker (1.2.6.5) but a closed choice in
+			// a ProjectLink (1.2.6.7). The latter is scoped below.
+			"type":         xmptype.MarkerTypeFieldType{},
 			"managed":      xmptype.BooleanFieldType{},
 			"path":         xmptype.UriFieldType{},
 			"track":        xmptype.TextFieldType{},
 			"webStatement": xmptype.UriFieldType{},
 
-			// TODO(dustin): We don't yet have enough information to handle this
-			// due to a contradiction.
-			//
-			// In XMP Specification (Part 2)...
-			// - 1.2.6.5, it's defined as a "open choice" or "comma-delimited list".
-			// - 1.2.6.7, it's defined as a "closed choice".
-			//
-			// If this can have different types of values based on context, it's not immediately clear how to distinguish, and such contradictions are rare occurrences. Therefore, we will just ignore it and come back later if this is actually used and someone complains.
-			//
-			// "type":                       ??{},
-
-			"scale": xmptype.RationalFieldType{},
-			// "value":                      IntegerFieldType{},
-			"timeFormat":                 xmptype.ClosedChoiceFieldType{},
+			"scale":                      xmptype.RationalFieldType{},
+			"timeFormat":                 xmptype.TimeFormatFieldType{},
 			"timeValue":                  xmptype.TextFieldType{},
 			"frameOverlappingPercentage": xmptype.RealFieldType{},
 			"frameSize":                  xmptype.RealFieldType{},
-			"quality":                    xmptype.ClosedChoiceFieldType{},
+			"quality":                    xmptype.QualityFieldType{},
 			"frameRate":                  xmptype.FrameRateFieldType{},
 
-			// Not a scalar type. Irrelevant here.
-			// "markers":,
+			"markers":   xmptype.OrderedMarkerArrayFieldType{},
+			"Tracks":    xmptype.UnorderedTrackArrayFieldType{},
 			"trackName": xmptype.TextFieldType{},
 
-			// TODO(dustin): Looks like this should be an inline list parsed and checked against an actual list. It'd help to see an example.
-			// "trackType": ChoiceFieldType{},
+			// This is an open choice (e.g. "Music" or "Speech") that may be a
+			// comma-delimited list. We take it as text until we see examples.
+			"trackType": xmptype.TextFieldType{},
+		},
+
+		// ScopedFields resolves the fields whose types depend on the struct
+		// that they appear in.
+		ScopedFields: map[xml.Name]map[string]interface{}{
+			{Space: XmpDmUri, Local: "duration"}: xmpDmTimeFields,
+
+			{Space: XmpDmUri, Local: "markers"}: {
+				"duration":  xmptype.FrameCountFieldType{},
+				"startTime": xmptype.FrameCountFieldType{},
+				"type":      xmptype.MarkerTypeFieldType{},
+			},
+
+			{Space: XmpDmUri, Local: "projectRef"}: {
+				"type": xmptype.ProjectTypeFieldType{},
+			},
 		},
 	}
 
-	xmpregistry.Register(namespace)
+	// xmpDmTimeFields are the fields of a Time struct (1.2.6.9).
+	xmpDmTimeFields = map[string]interface{}{
+		"value": xmptype.IntegerFieldType{},
+		"scale": xmptype.RationalFieldType{},
+	}
+)
+
+func init() {
+	xmpregistry.Register(XmpDmNamespace)
 }
@@ -15,6 +15,7 @@ func init() {
 	namespace := xmpregistry.Namespace{
 		Uri:             XmpGUri,
 		PreferredPrefix: "xmpG",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			"A":          xmptype.IntegerFieldType{},
 			"B":          xmptype.IntegerFieldType{},
@@ -26,9 +27,9 @@ func init() {
 			"blue":       xmptype.IntegerFieldType{},
 			"green":      xmptype.IntegerFieldType{},
 			"red":        xmptype.IntegerFieldType{},
-			"mode":       xmptype.ClosedChoiceFieldType{},
+			"mode":       xmptype.ColorantModeFieldType{},
 			"swatchName": xmptype.TextFieldType{},
-			"type":       xmptype.ClosedChoiceFieldType{},
+			"type":       xmptype.ColorantTypeFieldType{},
 		},
 	}
 
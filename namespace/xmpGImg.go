@@ -15,6 +15,7 @@ func init() {
 	namespace := xmpregistry.Namespace{
 		Uri:             XmpGImageUri,
 		PreferredPrefix: "xmpGImg",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			"format": xmptype.TextFieldType{},
 			"height": xmptype.IntegerFieldType{},
@@ -16,15 +16,15 @@ var (
 	XmpMmNamespace = xmpregistry.Namespace{
 		Uri:             XmpMmUri,
 		PreferredPrefix: "xmpMM",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
-			// "DerivedFrom": xmptype.ResourceRefFieldType{},
-			"DocumentID": xmptype.GuidFieldType{},
+			"DerivedFrom": xmptype.ResourceRefFieldType{},
+			"DocumentID":  xmptype.GuidFieldType{},
 
-			// TODO(dustin): ResourceEventFieldType type is not current implemented. Return to this.
 			"History": xmptype.OrderedResourceEventArrayFieldType{},
 
-			// "Ingredients":    xmptype.ResourceRefFieldType{},
-			// "ManagedFrom":    xmptype.ResourceRefFieldType{},
+			"Ingredients":    xmptype.UnorderedResourceRefArrayFieldType{},
+			"ManagedFrom":    xmptype.ResourceRefFieldType{},
 			"Manager":        xmptype.AgentNameFieldType{},
 			"ManageTo":       xmptype.UriFieldType{},
 			"ManageUI":       xmptype.UriFieldType{},
@@ -39,9 +39,7 @@ var (
 			"RenditionClass":  xmptype.RenditionClassFieldType{},
 			"RenditionParams": xmptype.TextFieldType{},
 			"VersionID":       xmptype.TextFieldType{},
-
-			// Not implemented due to irrelevancy because of how we handle values.
-			// "Versions":
+			"Versions":        xmptype.OrderedVersionArrayFieldType{},
 		},
 	}
 )
@@ -15,6 +15,7 @@ func init() {
 	namespace := xmpregistry.Namespace{
 		Uri:             XmpRightsUri,
 		PreferredPrefix: "xmpRights",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
 			"Certificate": xmptype.TextFieldType{},
 			"Marked":      xmptype.BooleanFieldType{},
@@ -15,6 +15,7 @@ func init() {
 	namespace := xmpregistry.Namespace{
 		Uri:             XmpTPgUri,
 		PreferredPrefix: "xmpTPg",
+		SpecReference:   "XMP Specification Part 2",
 		Fields: map[string]interface{}{
 			// NOTE(dustin): Not implemented
 			// "Colorants":,
@@ -11,14 +11,19 @@ const (
 	XmpidqUri = "http://ns.adobe.com/xmp/Identifier/qual/1.0/"
 )
 
-func init() {
-	namespace := xmpregistry.Namespace{
+var (
+	// XmpidqNamespace is the namespace descriptor for "xmpidq". Its only field
+	// is a qualifier of "xmp:Identifier" items.
+	XmpidqNamespace = xmpregistry.Namespace{
 		Uri:             XmpidqUri,
 		PreferredPrefix: "xmpidq",
+		SpecReference:   "XMP Specification Part 1",
 		Fields: map[string]interface{}{
 			"Scheme": xmptype.TextFieldType{},
 		},
 	}
+)
 
-	xmpregistry.Register(namespace)
+func init() {
+	xmpregistry.Register(XmpidqNamespace)
 }
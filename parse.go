@@ -11,6 +11,7 @@ import (
 	"github.com/dsoprea/go-unicode-byteorder"
 
 	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/rdf"
 	"github.com/dsoprea/go-xmp/registry"
 	"github.com/dsoprea/go-xmp/type"
 )
@@ -69,10 +70,17 @@ func (raa rawAttributeAssignment) parse() (string, string) {
 	return name, value
 }
 
-// EmbeddedArray describes an array found in another array.
-type EmbeddedArray struct {
-	name      xml.Name
-	collected []interface{}
+// qualifiedNode collects the qualifiers of an open property node until it is
+// closed.
+type qualifiedNode struct {
+	// qualifiers has the "xml:lang" of the node, if any.
+	qualifiers xmptype.Qualifiers
+
+	// rawValue is the char-data of an "rdf:value" child, if any.
+	rawValue *string
+
+	// isConsumed indicates that the qualifiers were attached to a value.
+	isConsumed bool
 }
 
 // Parser parses an XMP document.
@@ -87,6 +95,10 @@ type Parser struct {
 	rdfIsOpen            bool
 	rdfDescriptionIsOpen bool
 
+	// nestedDescriptionDepth is the number of "rdf:Description" nodes that are
+	// open within properties (the struct values of those properties).
+	nestedDescriptionDepth int
+
 	// nameStack is a stack comprised of xml.Name structs.
 	nameStack []xmpregistry.XmlName
 
@@ -94,10 +106,68 @@ type Parser struct {
 	lastToken    xml.Token
 
 	unfinishedArrayLayers [][]interface{}
+
+	// qualifiedNodes has the qualifiers of open nodes keyed by their depth in
+	// the name stack.
+	qualifiedNodes map[int]*qualifiedNode
+
+	// graphBuilder populates the RDF graph. Every token is given to it as it
+	// was read. The index is built from the graph.
+	graphBuilder *xmprdf.Builder
+
+	// rdfDepth is the number of elements open within "rdf:RDF", including
+	// itself. rdfTripleCount is the size of the graph when it was opened.
+	rdfDepth       int
+	rdfTripleCount int
+
+	// registry has the namespaces that values are interpreted with.
+	registry *xmpregistry.Registry
+
+	// openAlias is the top-level alias property that is currently open, if
+	// any.
+	openAlias *openAlias
+
+	// resolvedAliases are the aliases that were indexed as their base
+	// properties, in the order that they were encountered.
+	resolvedAliases []xmpregistry.Alias
 }
 
-// NewParser returns a new Parser struct.
+// openAlias tracks an alias property until it is closed.
+type openAlias struct {
+	alias xmpregistry.Alias
+
+	// depth is the length of the name stack while the node is open.
+	depth int
+
+	// isSkipped indicates that the alias is being dropped rather than
+	// resolved.
+	isSkipped bool
+
+	// skippedDepth is the number of nodes open within a skipped alias.
+	skippedDepth int
+
+	// closing are the tokens that close the base property.
+	closing []xml.Token
+
+	// isPending indicates that the alias is a simple alias of an array
+	// property and that it is not yet known whether its value is an array or
+	// a single value. The start element and the tokens read since are held
+	// in the meantime.
+	isPending bool
+	arrayKind xmpregistry.ArrayKind
+	start     xml.StartElement
+	pending   []xml.Token
+}
+
+// NewParser returns a new Parser struct that uses the default registry.
 func NewParser(r io.Reader) *Parser {
+	return NewParserWithRegistry(r, xmpregistry.Default())
+}
+
+// NewParserWithRegistry returns a new Parser struct that uses the given
+// registry. Parsers that do not share a registry are isolated from each
+// other's registrations.
+func NewParserWithRegistry(r io.Reader, registry *xmpregistry.Registry) *Parser {
 	xd := xml.NewDecoder(r)
 
 	nameStack := make([]xmpregistry.XmlName, 0)
@@ -108,9 +178,58 @@ func NewParser(r io.Reader) *Parser {
 		xd:                    xd,
 		nameStack:             nameStack,
 		unfinishedArrayLayers: unfinishedArrayLayers,
+		qualifiedNodes:        make(map[int]*qualifiedNode),
+		graphBuilder:          xmprdf.NewBuilder(xmprdf.NewGraph()),
+		registry:              registry,
 	}
 }
 
+// Graph returns the RDF graph of everything parsed so far. The index is built
+// from it when each "rdf:RDF" element is closed.
+func (xp *Parser) Graph() *xmprdf.Graph {
+	return xp.graphBuilder.Graph()
+}
+
+// ResolvedAliases returns the aliases that were found in the document and
+// indexed as their base properties.
+func (xp *Parser) ResolvedAliases() []xmpregistry.Alias {
+	return xp.resolvedAliases
+}
+
+// qualifiedNode returns the qualifiers being collected for the open node at
+// the given depth of the name stack, allocating them if necessary.
+func (xp *Parser) qualifiedNode(depth int) *qualifiedNode {
+	qn, found := xp.qualifiedNodes[depth]
+	if found == false {
+		qn = &qualifiedNode{
+			qualifiers: make(xmptype.Qualifiers),
+		}
+
+		xp.qualifiedNodes[depth] = qn
+	}
+
+	return qn
+}
+
+// consumeQualifiers returns the qualifiers collected for the current node so
+// that they can be attached to its value.
+func (xp *Parser) consumeQualifiers() xmptype.Qualifiers {
+	qn, found := xp.qualifiedNodes[len(xp.nameStack)]
+	if found == false {
+		return nil
+	}
+
+	qn.isConsumed = true
+
+	return qn.qualifiers
+}
+
+// parentName returns the name of the property that directly encloses the
+// current (innermost open) node.
+func (xp *Parser) parentName() xml.Name {
+	return xmptype.ParentName(xmpregistry.XmpPropertyName(xp.nameStack))
+}
+
 func (xp *Parser) isArrayNode(name xml.Name) (flag bool, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
@@ -121,7 +240,7 @@ func (xp *Parser) isArrayNode(name xml.Name) (flag bool, err error) {
 	nodeNamespaceUri := name.Space
 	nodeLocalName := name.Local
 
-	nodeNamespace, err := xmpregistry.Get(nodeNamespaceUri)
+	nodeNamespace, err := xp.registry.Get(nodeNamespaceUri)
 	if err != nil {
 		if err == xmpregistry.ErrNamespaceNotFound {
 			return false, nil
@@ -130,7 +249,7 @@ func (xp *Parser) isArrayNode(name xml.Name) (flag bool, err error) {
 		log.Panic(err)
 	}
 
-	flag, err = xmptype.IsArrayType(nodeNamespace, nodeLocalName)
+	flag, err = xmptype.IsArrayType(nodeNamespace, xp.parentName(), nodeLocalName)
 	if err != nil {
 		if err == xmptype.ErrChildFieldNotFound {
 			return false, nil
@@ -189,11 +308,17 @@ func (xp *Parser) parseStartElementToken(xpi *XmpPropertyIndex, t xml.StartEleme
 		return nil
 	} else if t.Name == xmpnamespace.RdfDescriptionTag {
 		if xp.rdfDescriptionIsOpen == true {
-			log.Panicf("RDF description is already open")
+			err := xp.parseNestedDescription(xpi, t)
+			log.PanicIf(err)
+
+			return nil
 		}
 
 		xp.rdfDescriptionIsOpen = true
 
+		err := xp.parseDescriptionAttributes(xpi, t)
+		log.PanicIf(err)
+
 		return nil
 	}
 
@@ -223,9 +348,19 @@ func (xp *Parser) parseStartElementToken(xpi *XmpPropertyIndex, t xml.StartEleme
 		// array. If it has tangible attributes, we'll represent it as a
 		// complex-node type and push to the index.
 
-		attributes, err := xmptype.ParseAttributes(t)
+		attributes, err := xmptype.ParseAttributes(xp.registry, t, t.Name)
 		log.PanicIf(err)
 
+		// A language is a qualifier of the value rather than an attribute.
+		// Hold it until we see whether there is a value to attach it to.
+
+		if language, found := attributes[xmpnamespace.XmlLangAttribute]; found == true {
+			delete(attributes, xmpnamespace.XmlLangAttribute)
+
+			qn := xp.qualifiedNode(len(xp.nameStack))
+			qn.qualifiers[xmpnamespace.XmlLangAttribute] = language
+		}
+
 		if len(attributes) > 0 {
 			xpn := xmpregistry.XmpPropertyName(xp.nameStack)
 
@@ -237,6 +372,140 @@ func (xp *Parser) parseStartElementToken(xpi *XmpPropertyIndex, t xml.StartEleme
 	return nil
 }
 
+// parseDescriptionAttributes indexes the properties that are expressed as
+// attributes of a top-level "rdf:Description" node (e.g.
+// `crs:Exposure2012="+0.50"`). Only simple values can be expressed this way.
+func (xp *Parser) parseDescriptionAttributes(xpi *XmpPropertyIndex, t xml.StartElement) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	for _, attribute := range t.Attr {
+		namespaceUri := attribute.Name.Space
+
+		if namespaceUri == "" || namespaceUri == "xmlns" || namespaceUri == xmpnamespace.RdfUri || namespaceUri == xmpnamespace.XmlUri {
+			continue
+		}
+
+		if _, found := xp.registry.ResolveAlias(attribute.Name); found == true {
+			err := xp.parseAliasAttribute(xpi, attribute)
+			log.PanicIf(err)
+
+			continue
+		}
+
+		namespace, err := xp.registry.Get(namespaceUri)
+		if err != nil {
+			if err == xmpregistry.ErrNamespaceNotFound {
+				continue
+			}
+
+			log.Panic(err)
+		}
+
+		xp.dropAliasedValue(xpi, attribute.Name)
+
+		xpn := make(xmpregistry.XmpPropertyName, len(xp.nameStack), len(xp.nameStack)+1)
+		copy(xpn, xp.nameStack)
+		xpn = append(xpn, xmpregistry.XmlName(attribute.Name))
+
+		parent := xmptype.ParentName(xpn)
+
+		isArray, err := xmptype.IsArrayType(namespace, parent, attribute.Name.Local)
+		if err != nil && err != xmptype.ErrChildFieldNotFound {
+			log.Panic(err)
+		} else if isArray == true {
+			parseLogger.Warningf(
+				nil,
+				"Ignoring array property expressed as a description attribute: [%s] [%s]",
+				namespaceUri, attribute.Name.Local)
+
+			continue
+		}
+
+		parsedValue, err := xmptype.ParseValue(namespace, parent, attribute.Name.Local, attribute.Value)
+		if err != nil {
+			if err == xmptype.ErrChildFieldNotFound {
+				parseLogger.Warningf(
+					nil,
+					"Could not parse description attribute [%s] [%s] value (field not found): [%s]",
+					namespaceUri, attribute.Name.Local, attribute.Value)
+
+				continue
+			} else if err == xmptype.ErrValueNotValid {
+				continue
+			}
+
+			log.Panic(err)
+		}
+
+		err = xpi.addScalarValue(xpn, parsedValue)
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+// parseNestedDescription processes an "rdf:Description" node within a
+// property. This describes the struct value of the property and is equivalent
+// to the property having "rdf:parseType='Resource'": the attributes of the
+// description are fields of the struct, as are its child nodes.
+func (xp *Parser) parseNestedDescription(xpi *XmpPropertyIndex, t xml.StartElement) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if len(xp.nameStack) == 0 || xp.nameStack[len(xp.nameStack)-1].Space == xmpnamespace.XUri {
+		log.Panicf("RDF description is already open")
+	}
+
+	xp.nestedDescriptionDepth++
+
+	if xp.isInArray() == true {
+		// Move the attributes to the enclosing node (e.g. the "rdf:li" of a
+		// struct-valued array item), which was the last node collected.
+
+		currentLayerNumber := len(xp.unfinishedArrayLayers) - 1
+		currentLayer := xp.unfinishedArrayLayers[currentLayerNumber]
+
+		if len(currentLayer) == 0 {
+			log.Panicf("RDF description is directly within an array")
+		}
+
+		se, ok := currentLayer[len(currentLayer)-1].(xml.StartElement)
+		if ok == false {
+			log.Panicf("RDF description does not directly follow the node that it describes")
+		}
+
+		attributes := make([]xml.Attr, len(se.Attr), len(se.Attr)+len(t.Attr))
+		copy(attributes, se.Attr)
+		se.Attr = append(attributes, t.Attr...)
+
+		currentLayer[len(currentLayer)-1] = se
+
+		return nil
+	}
+
+	nodeName := xp.nameStack[len(xp.nameStack)-1]
+
+	attributes, err := xmptype.ParseAttributes(xp.registry, t, xml.Name(nodeName))
+	log.PanicIf(err)
+
+	if len(attributes) > 0 {
+		xpn := make(xmpregistry.XmpPropertyName, len(xp.nameStack))
+		copy(xpn, xp.nameStack)
+
+		err := xpi.addComplexValue(xpn, attributes)
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
 func (xp *Parser) parseEndElementToken(xpi *XmpPropertyIndex, t xml.EndElement) (err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
@@ -246,6 +515,19 @@ func (xp *Parser) parseEndElementToken(xpi *XmpPropertyIndex, t xml.EndElement)
 
 	// TODO(dustin): Expand the unit-tests.
 
+	if t.Name == xmpnamespace.RdfDescriptionTag && xp.nestedDescriptionDepth > 0 {
+		xp.nestedDescriptionDepth--
+
+		// If the description was empty, the enclosing node is treated like a
+		// self-closing node.
+		if _, ok := xp.lastToken.(xml.StartElement); ok == true {
+			emptyCharData := ""
+			xp.lastCharData = &emptyCharData
+		}
+
+		return nil
+	}
+
 	isRootRdfTag, err := xp.processNodeCloseRootRdfTags(xpi, t.Name)
 	log.PanicIf(err)
 
@@ -286,8 +568,8 @@ func (xp *Parser) parseEndElementToken(xpi *XmpPropertyIndex, t xml.EndElement)
 
 	var arrayType xmptype.ArrayFieldType
 
-	if nodeNamespace, err := xmpregistry.Get(nodeNamespaceUri); err == nil {
-		if ft, found := nodeNamespace.Fields[nodeLocalName]; found == true {
+	if nodeNamespace, err := xp.registry.Get(nodeNamespaceUri); err == nil {
+		if ft, found := nodeNamespace.FieldType(xp.parentName(), nodeLocalName); found == true {
 			if t, ok := ft.(xmptype.ArrayFieldType); ok == true {
 				arrayType = t
 			}
@@ -308,6 +590,9 @@ func (xp *Parser) parseEndElementToken(xpi *XmpPropertyIndex, t xml.EndElement)
 		xp.collectForCurrentArray(t)
 	}
 
+	err = xp.processNodeCloseQualifiers(xpi)
+	log.PanicIf(err)
+
 	// Go already validates that the tags are balanced.
 	xp.nameStack = xp.nameStack[:len(xp.nameStack)-1]
 
@@ -375,6 +660,19 @@ func (xp *Parser) processNodeCloseCharData(xpi *XmpPropertyIndex, nodeName xml.N
 				"We encountered an array item that wasn't in an array, likely because it is in an unregistered namespace (or because its parent types should have an array type and do not): [%s]",
 				xpn)
 		}
+	} else if nodeName == xmpnamespace.RdfValueTag {
+		if xp.isInArray() == true {
+			// This is the value of a qualified array item. Like any other
+			// item, defer to our array management.
+
+			xp.collectForCurrentArray(charData)
+		} else if len(xp.nameStack) > 1 {
+			// This is the value of the parent node. It is parsed once the
+			// parent is closed and all of its qualifiers are known.
+
+			qn := xp.qualifiedNode(len(xp.nameStack) - 1)
+			qn.rawValue = &charData
+		}
 	} else {
 		err := xp.parseCharData(xpi, nodeName, charData)
 		log.PanicIf(err)
@@ -383,6 +681,93 @@ func (xp *Parser) processNodeCloseCharData(xpi *XmpPropertyIndex, nodeName xml.N
 	return nil
 }
 
+// processNodeCloseQualifiers attaches any qualifiers collected for the node
+// being closed. If the node had an "rdf:value" child, the value is parsed
+// according to the node's own type and the node's other children become its
+// qualifiers.
+func (xp *Parser) processNodeCloseQualifiers(xpi *XmpPropertyIndex) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	depth := len(xp.nameStack)
+
+	qn, found := xp.qualifiedNodes[depth]
+	if found == false {
+		return nil
+	}
+
+	delete(xp.qualifiedNodes, depth)
+
+	if xp.isInArray() == true {
+		return nil
+	}
+
+	xpn := make(xmpregistry.XmpPropertyName, depth)
+	copy(xpn, xp.nameStack)
+
+	if qn.rawValue == nil {
+		if qn.isConsumed == false && len(qn.qualifiers) > 0 {
+			// There was no value to attach the language to. Keep it as an
+			// attribute so that it isn't lost.
+
+			err := xpi.addComplexValue(xpn, qn.qualifiers)
+			log.PanicIf(err)
+		}
+
+		return nil
+	}
+
+	nodeName := xpn[len(xpn)-1]
+
+	namespace, err := xp.registry.Get(nodeName.Space)
+	if err != nil {
+		if err == xmpregistry.ErrNamespaceNotFound {
+			return nil
+		}
+
+		log.Panic(err)
+	}
+
+	parsedValue, err := xmptype.ParseValue(namespace, xmptype.ParentName(xpn), nodeName.Local, *qn.rawValue)
+	if err != nil {
+		if err == xmptype.ErrChildFieldNotFound || err == xmptype.ErrValueNotValid {
+			parseLogger.Warningf(
+				nil,
+				"Could not parse qualified value under node [%s] [%s] (%s): [%s]",
+				nodeName.Space, nodeName.Local, err.Error(), *qn.rawValue)
+
+			return nil
+		}
+
+		log.Panic(err)
+	}
+
+	// The siblings of the value were indexed as children of this node. Move
+	// them to the qualifiers.
+
+	if subindex := xpi.subindex(xpn); subindex != nil {
+		qualifiers, ok := subindex.scalarQualifiers()
+		if ok == false {
+			parseLogger.Warningf(nil, "Qualifiers of node are not all simple values: [%s]", xpn)
+			return nil
+		}
+
+		for name, value := range qualifiers {
+			qn.qualifiers[name] = value
+		}
+
+		xpi.removeSubindex(xpn)
+	}
+
+	err = xpi.addQualifiedScalarValue(xpn, parsedValue, qn.qualifiers)
+	log.PanicIf(err)
+
+	return nil
+}
+
 func (xp *Parser) processNodeCloseArrayClose(xpi *XmpPropertyIndex, nodeName xml.Name, arrayType xmptype.ArrayFieldType) (err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
@@ -395,23 +780,33 @@ func (xp *Parser) processNodeCloseArrayClose(xpi *XmpPropertyIndex, nodeName xml
 	currentUnfinishedLayerNumber := len(xp.unfinishedArrayLayers) - 1
 	finishedArray := xp.unfinishedArrayLayers[currentUnfinishedLayerNumber]
 
-	ea := EmbeddedArray{
-		name:      nodeName,
-		collected: finishedArray,
-	}
-
 	xp.unfinishedArrayLayers = xp.unfinishedArrayLayers[:currentUnfinishedLayerNumber]
 
 	if xp.isInArray() == true {
-		newUnfinishedLayerNumber := len(xp.unfinishedArrayLayers) - 1
-		xp.unfinishedArrayLayers[newUnfinishedLayerNumber] = append(
-			xp.unfinishedArrayLayers[newUnfinishedLayerNumber],
-			ea)
+		// This array is a field of an item of a higher array (e.g. the
+		// "xmpDM:markers" of an "xmpDM:Tracks" item). Give its elements to the
+		// higher array, wrapped in its own node, so that the item can parse it
+		// with the rest of its fields.
+
+		xp.collectForCurrentArray(xml.StartElement{Name: nodeName})
+
+		for _, element := range finishedArray {
+			xp.collectForCurrentArray(element)
+		}
+
+		xp.collectForCurrentArray(xml.EndElement{Name: nodeName})
+
+		return nil
 	}
 
-	xpn := xmpregistry.XmpPropertyName(xp.nameStack)
+	// The array keeps its name. Copy it so that it isn't changed as the name
+	// stack is reused.
+	xpn := make(xmpregistry.XmpPropertyName, len(xp.nameStack))
+	copy(xpn, xp.nameStack)
 
-	wrappedArray := arrayType.New(xpn, finishedArray)
+	wrappedArray := arrayType.New(xp.registry, xpn, finishedArray)
+
+	xp.reportArrayItems(wrappedArray)
 
 	err = xpi.addArrayValue(xpn, wrappedArray)
 	log.PanicIf(err)
@@ -419,6 +814,29 @@ func (xp *Parser) processNodeCloseArrayClose(xpi *XmpPropertyIndex, nodeName xml
 	return nil
 }
 
+// reportArrayItems logs every item of the array that can not be parsed as the
+// declared item-type. The array is indexed either way since the raw items are
+// still available.
+func (xp *Parser) reportArrayItems(av xmptype.ArrayValue) {
+	apil, ok := av.(xmptype.ArrayParsedItemLister)
+	if ok == false {
+		return
+	}
+
+	items, err := apil.ParsedItems()
+	if err != nil {
+		if err != xmptype.ErrArrayItemTypeNotDeclared {
+			parseLogger.Warningf(nil, "Could not enumerate array items under [%s]: %s", av.FullName(), err.Error())
+		}
+
+		return
+	}
+
+	if err := xmptype.ParsedItemsError(av.FullName(), items); err != nil {
+		parseLogger.Warningf(nil, "%s", err.Error())
+	}
+}
+
 // parseCharData parses the char-data that exists in leaf-nodes (not in nodes
 // that have child-nodes).
 func (xp *Parser) parseCharData(xpi *XmpPropertyIndex, nodeName xml.Name, rawValue string) (err error) {
@@ -437,7 +855,7 @@ func (xp *Parser) parseCharData(xpi *XmpPropertyIndex, nodeName xml.Name, rawVal
 	namespaceUri := nodeName.Space
 	localName := nodeName.Local
 
-	namespace, err := xmpregistry.Get(namespaceUri)
+	namespace, err := xp.registry.Get(namespaceUri)
 	if err != nil {
 		if err == xmpregistry.ErrNamespaceNotFound {
 			return nil
@@ -446,14 +864,30 @@ func (xp *Parser) parseCharData(xpi *XmpPropertyIndex, nodeName xml.Name, rawVal
 		log.Panic(err)
 	}
 
+	parent := xp.parentName()
+	ft, _ := namespace.FieldType(parent, localName)
+
 	// Since we ensure that all leaf nodes have char-data we'll periodically end-
 	// up with char-data that is empty for nodes in namespaces that don't
 	// identify that node with a type. In this case, just silently skip.
-	if rawValue == "" && namespace.Fields[localName] == nil {
+	if rawValue == "" && ft == nil {
 		return nil
 	}
 
-	parsedValue, err := xmptype.ParseValue(namespace, localName, rawValue)
+	// Struct values are described by their attributes and child nodes, which
+	// are indexed separately.
+	if _, ok := ft.(xmptype.StructFieldType); ok == true {
+		if strings.TrimSpace(rawValue) != "" {
+			parseLogger.Warningf(
+				nil,
+				"Ignoring char-data under struct node [%s] [%s]: [%s]",
+				namespaceUri, localName, rawValue)
+		}
+
+		return nil
+	}
+
+	parsedValue, err := xmptype.ParseValue(namespace, parent, localName, rawValue)
 	if err != nil {
 		if err == xmptype.ErrChildFieldNotFound {
 			parseLogger.Warningf(
@@ -482,7 +916,9 @@ func (xp *Parser) parseCharData(xpi *XmpPropertyIndex, nodeName xml.Name, rawVal
 	} else {
 		// This is a non-array-item value-node.
 
-		err := xpi.addScalarValue(xpn, parsedValue)
+		qualifiers := xp.consumeQualifiers()
+
+		err := xpi.addQualifiedScalarValue(xpn, parsedValue, qualifiers)
 		log.PanicIf(err)
 	}
 
@@ -593,6 +1029,109 @@ func (xp *Parser) parseToken(xpi *XmpPropertyIndex, token xml.Token) (err error)
 		}
 	}()
 
+	err = xp.graphBuilder.Process(token)
+	log.PanicIf(err)
+
+	// The content of "rdf:RDF" is only given to the graph. Once it is closed,
+	// the index is built from what the graph has, so that the two can not
+	// disagree.
+
+	switch t := token.(type) {
+	case xml.StartElement:
+		if xp.rdfDepth > 0 {
+			xp.rdfDepth++
+			return nil
+		} else if t.Name == xmpnamespace.RdfTag {
+			xp.rdfDepth = 1
+			xp.rdfTripleCount = xp.Graph().Len()
+		}
+	case xml.EndElement:
+		if xp.rdfDepth > 1 {
+			xp.rdfDepth--
+			return nil
+		} else if xp.rdfDepth == 1 {
+			xp.rdfDepth = 0
+
+			err := xp.indexGraph(xpi, xp.Graph().Since(xp.rdfTripleCount))
+			log.PanicIf(err)
+		}
+	default:
+		if xp.rdfDepth > 0 {
+			return nil
+		}
+	}
+
+	for _, token := range xp.resolveAliases(xpi, token) {
+		err := xp.indexToken(xpi, token)
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+// indexGraph indexes the content of "rdf:RDF" from the graph as it is written
+// in RDF/XML. An IRI given by "rdf:resource" is indexed as the value of its
+// property.
+func (xp *Parser) indexGraph(xpi *XmpPropertyIndex, graph *xmprdf.Graph) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	// The "rdf:RDF" element itself is indexed as it is read.
+	tokens := graph.RdfXmlTokens()
+
+	for _, token := range tokens[1 : len(tokens)-1] {
+		tokens := []xml.Token{token}
+
+		if t, ok := token.(xml.StartElement); ok == true {
+			tokens = resourceValueTokens(t)
+		}
+
+		for _, token := range tokens {
+			for _, resolved := range xp.resolveAliases(xpi, token) {
+				err := xp.indexToken(xpi, resolved)
+				log.PanicIf(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceValueTokens returns the element followed by its "rdf:resource" IRI
+// as char-data if it has one.
+func resourceValueTokens(t xml.StartElement) []xml.Token {
+	for i, attribute := range t.Attr {
+		if attribute.Name != xmpnamespace.RdfResourceAttribute {
+			continue
+		}
+
+		attributes := make([]xml.Attr, 0, len(t.Attr)-1)
+		attributes = append(attributes, t.Attr[:i]...)
+		attributes = append(attributes, t.Attr[i+1:]...)
+
+		start := xml.StartElement{
+			Name: t.Name,
+			Attr: attributes,
+		}
+
+		return []xml.Token{start, xml.CharData(attribute.Value)}
+	}
+
+	return []xml.Token{t}
+}
+
+// indexToken gives a single token to the index. Aliases must already be
+// resolved.
+func (xp *Parser) indexToken(xpi *XmpPropertyIndex, token xml.Token) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
 	switch t := token.(type) {
 	case xml.StartElement:
 		err := xp.parseStartElementToken(xpi, t)
@@ -616,6 +1155,289 @@ func (xp *Parser) parseToken(xpi *XmpPropertyIndex, token xml.Token) (err error)
 	return nil
 }
 
+// aliasTokens returns the tokens that open and close the base property of
+// the given alias in place of the alias node. Simple values of an alias of an
+// array item are wrapped in the array.
+func aliasTokens(alias xmpregistry.Alias, start xml.StartElement) (opening, closing []xml.Token) {
+	switch alias.Form {
+	case xmpregistry.AliasFormOrderedFirstItem:
+		return arrayItemTokens(alias.Base, xmpregistry.ArrayKindOrdered, start)
+	case xmpregistry.AliasFormLanguageDefault:
+		return arrayItemTokens(alias.Base, xmpregistry.ArrayKindLanguageAlternative, start)
+	}
+
+	base := xml.StartElement{
+		Name: alias.Base,
+		Attr: start.Attr,
+	}
+
+	opening = []xml.Token{base}
+	closing = []xml.Token{base.End()}
+
+	return opening, closing
+}
+
+// arrayItemTokens returns the tokens that open and close the given property as
+// an array of the given kind having a single item. The attributes of the start
+// element are given to the item, and an item of language alternatives is the
+// default language unless it has a language.
+func arrayItemTokens(baseName xml.Name, kind xmpregistry.ArrayKind, start xml.StartElement) (opening, closing []xml.Token) {
+	base := xml.StartElement{
+		Name: baseName,
+	}
+
+	var arrayName xml.Name
+
+	switch kind {
+	case xmpregistry.ArrayKindOrdered:
+		arrayName = xmpnamespace.RdfSeqTag
+	case xmpregistry.ArrayKindUnordered:
+		arrayName = xmpnamespace.RdfBagTag
+	case xmpregistry.ArrayKindAlternative, xmpregistry.ArrayKindLanguageAlternative:
+		arrayName = xmpnamespace.RdfAltTag
+	default:
+		log.Panicf("array kind not valid: [%s]", kind)
+	}
+
+	item := xml.StartElement{
+		Name: xmpnamespace.RdfLiTag,
+		Attr: start.Attr,
+	}
+
+	if kind == xmpregistry.ArrayKindLanguageAlternative {
+		hasLanguage := false
+		for _, attribute := range start.Attr {
+			if attribute.Name == xmpnamespace.XmlLangAttribute {
+				hasLanguage = true
+				break
+			}
+		}
+
+		if hasLanguage == false {
+			language := xml.Attr{
+				Name:  xmpnamespace.XmlLangAttribute,
+				Value: xmpnamespace.DefaultLanguage,
+			}
+
+			item.Attr = append([]xml.Attr{language}, start.Attr...)
+		}
+	}
+
+	array := xml.StartElement{
+		Name: arrayName,
+	}
+
+	opening = []xml.Token{base, array, item}
+	closing = []xml.Token{item.End(), array.End(), base.End()}
+
+	return opening, closing
+}
+
+// arrayKind returns the kind of array of the given top-level property or
+// ArrayKindNone if it is not a registered array.
+func (xp *Parser) arrayKind(name xml.Name) xmpregistry.ArrayKind {
+	namespace, err := xp.registry.Get(name.Space)
+	if err != nil {
+		return xmpregistry.ArrayKindNone
+	}
+
+	ft, _ := namespace.FieldType(xml.Name{}, name.Local)
+
+	if aftd, ok := ft.(xmpregistry.ArrayFieldTypeDescriber); ok == true {
+		return aftd.ArrayKind()
+	}
+
+	return xmpregistry.ArrayKindNone
+}
+
+// resolvePendingAlias handles a token read while the value of a simple alias
+// of an array property is not yet known to be an array or a single value. The
+// tokens are held until a child node (the array) or the end of the alias is
+// found. A single value becomes the only item of the base array.
+func (xp *Parser) resolvePendingAlias(token xml.Token) []xml.Token {
+	oa := xp.openAlias
+
+	switch t := token.(type) {
+	case xml.StartElement:
+		opening, closing := aliasTokens(oa.alias, oa.start)
+
+		oa.isPending = false
+		oa.depth = len(xp.nameStack) + len(opening)
+		oa.closing = closing
+
+		tokens := append(opening, oa.pending...)
+		return append(tokens, t)
+
+	case xml.EndElement:
+		xp.openAlias = nil
+
+		opening, closing := arrayItemTokens(oa.alias.Base, oa.arrayKind, oa.start)
+
+		tokens := append(opening, oa.pending...)
+		return append(tokens, closing...)
+	}
+
+	// The decoder reuses the buffers of the tokens that it returns.
+
+	oa.pending = append(oa.pending, xml.CopyToken(token))
+
+	return nil
+}
+
+// resolveAliases returns the tokens to process in place of the given one.
+// Top-level properties that are registered as aliases are replaced by their
+// base properties. If the base property was already found, the alias is
+// dropped. If the base property is found after the alias, it replaces the
+// value of the alias. A single value of a simple alias of an array property
+// becomes the only item of the array.
+func (xp *Parser) resolveAliases(xpi *XmpPropertyIndex, token xml.Token) []xml.Token {
+	if oa := xp.openAlias; oa != nil {
+		if oa.isSkipped == true {
+			switch token.(type) {
+			case xml.StartElement:
+				oa.skippedDepth++
+			case xml.EndElement:
+				if oa.skippedDepth == 0 {
+					xp.openAlias = nil
+				} else {
+					oa.skippedDepth--
+				}
+			}
+
+			return nil
+		} else if oa.isPending == true {
+			return xp.resolvePendingAlias(token)
+		}
+
+		if t, ok := token.(xml.EndElement); ok == true && t.Name == oa.alias.Name && len(xp.nameStack) == oa.depth {
+			xp.openAlias = nil
+
+			return oa.closing
+		}
+
+		return []xml.Token{token}
+	}
+
+	t, ok := token.(xml.StartElement)
+	if ok == false || xp.rdfDescriptionIsOpen == false || xp.isInArray() == true {
+		return []xml.Token{token}
+	}
+
+	// Only top-level properties can be aliases.
+
+	for _, name := range xp.nameStack {
+		if name.Space != xmpnamespace.XUri {
+			return []xml.Token{token}
+		}
+	}
+
+	alias, found := xp.registry.ResolveAlias(t.Name)
+	if found == false {
+		xp.dropAliasedValue(xpi, t.Name)
+
+		return []xml.Token{token}
+	}
+
+	if _, err := xpi.GetProperty(xmpregistry.XmlName(alias.Base)); err == nil {
+		parseLogger.Warningf(
+			nil,
+			"Property [%s] is an alias of [%s], which was already found. Ignoring the alias.",
+			xpi.namePhrase(xmpregistry.XmlName(alias.Name)), xpi.namePhrase(xmpregistry.XmlName(alias.Base)))
+
+		xp.openAlias = &openAlias{
+			alias:     alias,
+			isSkipped: true,
+		}
+
+		return nil
+	}
+
+	parseLogger.Warningf(
+		nil,
+		"Property [%s] is an alias of [%s]. Indexing it as the latter.",
+		xpi.namePhrase(xmpregistry.XmlName(alias.Name)), xpi.namePhrase(xmpregistry.XmlName(alias.Base)))
+
+	xp.resolvedAliases = append(xp.resolvedAliases, alias)
+
+	if alias.Form == xmpregistry.AliasFormSimple {
+		if kind := xp.arrayKind(alias.Base); kind != xmpregistry.ArrayKindNone {
+			xp.openAlias = &openAlias{
+				alias:     alias,
+				isPending: true,
+				arrayKind: kind,
+				start:     t.Copy(),
+			}
+
+			return nil
+		}
+	}
+
+	opening, closing := aliasTokens(alias, t)
+
+	xp.openAlias = &openAlias{
+		alias:   alias,
+		depth:   len(xp.nameStack) + len(opening),
+		closing: closing,
+	}
+
+	return opening
+}
+
+// dropAliasedValue removes the value that was indexed for an alias of the
+// given top-level property, if any, so that the property itself replaces it.
+func (xp *Parser) dropAliasedValue(xpi *XmpPropertyIndex, name xml.Name) {
+	for i, alias := range xp.resolvedAliases {
+		if alias.Base != name {
+			continue
+		}
+
+		parseLogger.Warningf(
+			nil,
+			"Property [%s] was already indexed from its alias [%s]. Replacing it.",
+			xpi.namePhrase(xmpregistry.XmlName(alias.Base)), xpi.namePhrase(xmpregistry.XmlName(alias.Name)))
+
+		xpn := make(xmpregistry.XmpPropertyName, len(xp.nameStack), len(xp.nameStack)+1)
+		copy(xpn, xp.nameStack)
+		xpn = append(xpn, xmpregistry.XmlName(name))
+
+		xpi.removeProperty(xpn)
+
+		xp.resolvedAliases = append(xp.resolvedAliases[:i], xp.resolvedAliases[i+1:]...)
+
+		return
+	}
+}
+
+// parseAliasAttribute indexes a property that is registered as an alias and
+// expressed as an attribute of a top-level "rdf:Description" node. It is
+// resolved the same way as the equivalent node.
+func (xp *Parser) parseAliasAttribute(xpi *XmpPropertyIndex, attribute xml.Attr) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	start := xml.StartElement{
+		Name: attribute.Name,
+	}
+
+	tokens := []xml.Token{
+		start,
+		xml.CharData(attribute.Value),
+		start.End(),
+	}
+
+	for _, token := range tokens {
+		for _, resolved := range xp.resolveAliases(xpi, token) {
+			err := xp.indexToken(xpi, resolved)
+			log.PanicIf(err)
+		}
+	}
+
+	return nil
+}
+
 // Parse parses the XMP document.
 func (xp *Parser) Parse() (xpi *XmpPropertyIndex, err error) {
 	defer func() {
@@ -624,7 +1446,8 @@ func (xp *Parser) Parse() (xpi *XmpPropertyIndex, err error) {
 		}
 	}()
 
-	xpi = newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi = newXmpPropertyIndex(xp.registry, xmpregistry.XmlName{})
+	xpi.graph = xp.Graph()
 
 	for {
 		token, err := xp.xd.Token()
@@ -3,7 +3,9 @@ package xmp
 import (
 	"bytes"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"encoding/xml"
 
@@ -11,7 +13,9 @@ import (
 	"github.com/dsoprea/go-unicode-byteorder"
 
 	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/rdf"
 	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
 )
 
 var (
@@ -66,6 +70,1395 @@ func TestParser_Parse_Complex(t *testing.T) {
 	}
 }
 
+func TestParser_Parse_Graph(t *testing.T) {
+	data := GetTestData()
+	b := bytes.NewBuffer(data)
+	xp := NewParser(b)
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	graph := xpi.Graph()
+
+	if graph != xp.Graph() {
+		t.Fatalf("Index graph is not the parser graph.")
+	}
+
+	// Attributes of the description are not represented in the index but are
+	// in the graph.
+
+	subject := xmprdf.NewIri("")
+	predicate := xmprdf.NewIri(xmpnamespace.DcUri + "format")
+
+	objects := graph.Objects(subject, predicate)
+
+	if len(objects) != 1 {
+		t.Fatalf("Expected one format: %v", objects)
+	} else if objects[0] != xmprdf.NewLiteral("image/jpeg", "", "") {
+		t.Fatalf("Format not correct: [%s]", objects[0])
+	}
+
+	// Array items are container members.
+
+	predicate = xmprdf.NewIri(xmpnamespace.PhotoshopUri + "DocumentAncestors")
+
+	objects = graph.Objects(subject, predicate)
+
+	if len(objects) != 1 {
+		t.Fatalf("Expected one ancestors container: %v", objects)
+	}
+
+	members := graph.ContainerMembers(objects[0])
+
+	if len(members) == 0 {
+		t.Fatalf("Expected container members.")
+	} else if members[0] != xmprdf.NewLiteral("09E6D0F0F1566931425946F327E43067", "", "") {
+		t.Fatalf("First member not correct: [%s]", members[0])
+	}
+}
+
+func TestParser_Parse_ResourceReference(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.Iptc4xmpExtNamespace)
+
+	xpi := parseTestDocument(`
+<Iptc4xmpExt:DigitalSourceType xmlns:Iptc4xmpExt="http://iptc.org/std/Iptc4xmpExt/2008-02-29/" rdf:resource="http://cv.iptc.org/newscodes/digitalsourcetype/digitalCapture"/>`)
+
+	// The index agrees with the graph: the reference is the value.
+
+	code := "http://cv.iptc.org/newscodes/digitalsourcetype/digitalCapture"
+
+	subject := xmprdf.NewIri("")
+	predicate := xmprdf.NewIri(xmpnamespace.Iptc4xmpExtUri + "DigitalSourceType")
+
+	objects := xpi.Graph().Objects(subject, predicate)
+
+	if len(objects) != 1 || objects[0] != xmprdf.NewIri(code) {
+		t.Fatalf("Graph not correct: %v", objects)
+	}
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[Iptc4xmpExt]DigitalSourceType"})
+	log.PanicIf(err)
+
+	digitalSourceType := results[0].(ScalarLeafNode).ParsedValue.(xmptype.Choice)
+
+	if digitalSourceType.Code != code {
+		t.Fatalf("Digital source-type not correct: [%v]", digitalSourceType)
+	}
+}
+
+func TestParser_Parse_NodeReference(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	// The struct is described by a separate node element. The index is built
+	// from the graph, so the struct is found where it is referenced.
+
+	document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/">
+      <xmpMM:DerivedFrom rdf:nodeID="ref"/>
+    </rdf:Description>
+    <rdf:Description rdf:nodeID="ref" xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#" stRef:documentID="xmp.did:1"/>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	xpi, err := NewParser(bytes.NewBufferString(document)).Parse()
+	log.PanicIf(err)
+
+	rootName := xmpregistry.XmlName{Space: xmpnamespace.XUri, Local: "xmpmeta"}
+	derivedFromName := xmpregistry.XmlName{Space: xmpnamespace.XmpMmUri, Local: "DerivedFrom"}
+
+	parsed, err := xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, derivedFromName})
+	log.PanicIf(err)
+
+	if rr := parsed.(xmptype.ResourceRef); rr.DocumentID != "xmp.did:1" {
+		t.Fatalf("Resource reference not correct: %v", parsed)
+	}
+}
+
+func TestParser_Parse_Qualifiers(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.XmpidqNamespace)
+
+	xpi := parseTestDocument(`
+<dc:source xml:lang="en">some source</dc:source>
+<dc:identifier rdf:parseType="Resource" xmlns:xmpidq="http://ns.adobe.com/xmp/Identifier/qual/1.0/">
+  <rdf:value>some identifier</rdf:value>
+  <xmpidq:Scheme>some scheme</xmpidq:Scheme>
+</dc:identifier>
+<xmp:Identifier xmlns:xmpidq="http://ns.adobe.com/xmp/Identifier/qual/1.0/">
+  <rdf:Bag>
+    <rdf:li>plain identifier</rdf:li>
+    <rdf:li rdf:parseType="Resource">
+      <rdf:value>978-3-16-148410-0</rdf:value>
+      <xmpidq:Scheme>ISBN</xmpidq:Scheme>
+    </rdf:li>
+  </rdf:Bag>
+</xmp:Identifier>`)
+
+	// Language on a simple value.
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[dc]source"})
+	log.PanicIf(err)
+
+	if len(results) != 1 {
+		t.Fatalf("Expected exactly one source: %v", results)
+	}
+
+	sln := results[0].(ScalarLeafNode)
+
+	if sln.ParsedValue != "some source" {
+		t.Fatalf("Source not correct: [%v]", sln.ParsedValue)
+	} else if sln.Language() != "en" {
+		t.Fatalf("Source language not correct: [%s]", sln.Language())
+	}
+
+	// General qualifier form on a simple value. The qualifier is not indexed
+	// as a child.
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[dc]identifier"})
+	log.PanicIf(err)
+
+	if len(results) != 1 {
+		t.Fatalf("Expected exactly one identifier: %v", results)
+	}
+
+	sln = results[0].(ScalarLeafNode)
+
+	if sln.ParsedValue != "some identifier" {
+		t.Fatalf("Identifier not correct: [%v]", sln.ParsedValue)
+	} else if scheme, found := sln.Qualifier(xmpnamespace.XmpidqUri, "Scheme"); found != true || scheme != "some scheme" {
+		t.Fatalf("Identifier scheme not correct: [%v]", scheme)
+	} else if sln.Language() != "" {
+		t.Fatalf("Identifier should not have a language: [%s]", sln.Language())
+	}
+
+	_, err = xpi.Get([]string{"[x]xmpmeta", "[dc]identifier", "[xmpidq]Scheme"})
+	if err != ErrFieldNotFound {
+		t.Fatalf("Expected qualifier to not be indexed as a child: %v", err)
+	}
+
+	// General qualifier form on array items.
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[xmp]Identifier"})
+	log.PanicIf(err)
+
+	items, err := results[0].(xmptype.ArrayItemLister).Items()
+	log.PanicIf(err)
+
+	if len(items) != 2 {
+		t.Fatalf("Expected two identifiers: %v", items)
+	} else if items[0].CharData != "plain identifier" || items[0].Qualifiers != nil {
+		t.Fatalf("First identifier not correct: %s", items[0])
+	} else if items[1].CharData != "978-3-16-148410-0" {
+		t.Fatalf("Second identifier not correct: %s", items[1])
+	} else if scheme, found := items[1].Qualifiers.Get(xmpnamespace.XmpidqUri, "Scheme"); found != true || scheme != "ISBN" {
+		t.Fatalf("Second identifier scheme not correct: %s", items[1])
+	}
+}
+
+func TestParser_Parse_Qualifiers_LanguageWithoutValue(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xpi := parseTestDocument(`
+<xmpMM:DerivedFrom xml:lang="en" rdf:parseType="Resource">
+  <xmpMM:DocumentID>xmp.did:1</xmpMM:DocumentID>
+</xmpMM:DerivedFrom>`)
+
+	// With no value to attach it to, the language is kept as an attribute.
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[xmpMM]DerivedFrom"})
+	log.PanicIf(err)
+
+	expected := []interface{}{
+		ComplexLeafNode{
+			xmpnamespace.XmlLangAttribute: "en",
+		},
+	}
+
+	if reflect.DeepEqual(results, expected) != true {
+		t.Fatalf("Language not correct: %v", results)
+	}
+}
+
+func TestParser_Parse_TypedArrayItems(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	namespace := xmpregistry.Namespace{
+		Uri:             "http://test/typed/",
+		PreferredPrefix: "typed",
+		Fields: map[string]interface{}{
+			"Reals": xmptype.OrderedArrayFieldType{ItemType: xmptype.RealFieldType{}},
+		},
+	}
+
+	xmpregistry.Register(namespace)
+
+	xpi := parseTestDocument(`
+<typed:Reals xmlns:typed="http://test/typed/">
+  <rdf:Seq>
+    <rdf:li>1.5</rdf:li>
+    <rdf:li>not a real</rdf:li>
+    <rdf:li>3</rdf:li>
+  </rdf:Seq>
+</typed:Reals>`)
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[typed]Reals"})
+	log.PanicIf(err)
+
+	av := results[0].(xmptype.ArrayValue)
+
+	// The invalid item doesn't prevent the array from being indexed.
+
+	items, err := av.(xmptype.ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	if len(items) != 3 {
+		t.Fatalf("Expected three items: %v", items)
+	} else if items[0].Value != 1.5 || items[2].Value != float64(3) {
+		t.Fatalf("Items not correct: %v", items)
+	} else if items[1].Err != xmptype.ErrValueNotValid {
+		t.Fatalf("Expected second item to be invalid: %v", items[1])
+	}
+
+	_, err = xmptype.RealItems(av)
+	if _, ok := err.(xmptype.ArrayItemsError); ok != true {
+		t.Fatalf("Expected items error: %v", err)
+	}
+}
+
+func TestParser_Parse_History(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xpi := parseTestDocument(`
+<xmpMM:History>
+  <rdf:Seq>
+    <rdf:li stEvt:action="created" stEvt:instanceID="xmp.iid:1" stEvt:when="2013-09-23T10:09:46+02:00" stEvt:softwareAgent="Agent 1"/>
+    <rdf:li rdf:parseType="Resource">
+      <stEvt:action>saved</stEvt:action>
+      <stEvt:instanceID>xmp.iid:2</stEvt:instanceID>
+      <stEvt:when>2014-09-22T10:56:35+02:00</stEvt:when>
+      <stEvt:softwareAgent>Agent 2</stEvt:softwareAgent>
+      <stEvt:changed>/metadata</stEvt:changed>
+    </rdf:li>
+  </rdf:Seq>
+</xmpMM:History>`)
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[xmpMM]History"})
+	log.PanicIf(err)
+
+	oreav := results[0].(xmptype.OrderedResourceEventArrayValue)
+
+	events, err := oreav.Events()
+	log.PanicIf(err)
+
+	if len(events) != 2 {
+		t.Fatalf("Expected two events: %v", events)
+	}
+
+	zone := time.FixedZone("", 7200)
+
+	expected := []xmptype.ResourceEvent{
+		{
+			Action:        "created",
+			InstanceID:    "xmp.iid:1",
+			SoftwareAgent: "Agent 1",
+			When:          xmptype.NewXmpDate(time.Date(2013, 9, 23, 10, 9, 46, 0, zone), xmptype.DatePrecisionSecond, true),
+		},
+		{
+			Action:        "saved",
+			Changed:       "/metadata",
+			InstanceID:    "xmp.iid:2",
+			SoftwareAgent: "Agent 2",
+			When:          xmptype.NewXmpDate(time.Date(2014, 9, 22, 10, 56, 35, 0, zone), xmptype.DatePrecisionSecond, true),
+		},
+	}
+
+	for i, re := range events {
+		if re.When.Equal(expected[i].When) != true {
+			t.Fatalf("Event (%d) time not correct: %s", i, re)
+		}
+
+		re.When = expected[i].When
+
+		if re != expected[i] {
+			t.Fatalf("Event (%d) not correct: %s", i, re)
+		}
+	}
+}
+
+func TestParser_Parse_ResourceRefs(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xpi := parseTestDocument(`
+<xmpMM:DerivedFrom stRef:documentID="xmp.did:1" stRef:instanceID="xmp.iid:1"/>
+<xmpMM:ManagedFrom rdf:parseType="Resource">
+  <stRef:documentID>xmp.did:2</stRef:documentID>
+  <stRef:lastModifyDate>2014-09-22T10:56:35+02:00</stRef:lastModifyDate>
+  <stRef:alternatePaths>
+    <rdf:Seq>
+      <rdf:li>file:///a.psd</rdf:li>
+      <rdf:li>file:///b.psd</rdf:li>
+    </rdf:Seq>
+  </stRef:alternatePaths>
+</xmpMM:ManagedFrom>
+<xmpMM:Ingredients>
+  <rdf:Bag>
+    <rdf:li stRef:documentID="xmp.did:3" stRef:filePath="placed.png" stRef:maskMarkers="None"/>
+    <rdf:li rdf:parseType="Resource">
+      <stRef:documentID>xmp.did:4</stRef:documentID>
+      <stRef:fromPart>/metadata</stRef:fromPart>
+      <stRef:toPart>/metadata</stRef:toPart>
+    </rdf:li>
+  </rdf:Bag>
+</xmpMM:Ingredients>`)
+
+	rootName := xmpregistry.XmlName{Space: xmpnamespace.XUri, Local: "xmpmeta"}
+	derivedFromName := xmpregistry.XmlName{Space: xmpnamespace.XmpMmUri, Local: "DerivedFrom"}
+
+	parsed, err := xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, derivedFromName})
+	log.PanicIf(err)
+
+	derivedFrom := parsed.(xmptype.ResourceRef)
+
+	if reflect.DeepEqual(derivedFrom, xmptype.ResourceRef{DocumentID: "xmp.did:1", InstanceID: "xmp.iid:1"}) != true {
+		t.Fatalf("DerivedFrom not correct: %s", derivedFrom)
+	}
+
+	managedFromName := xmpregistry.XmlName{Space: xmpnamespace.XmpMmUri, Local: "ManagedFrom"}
+
+	parsed, err = xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, managedFromName})
+	log.PanicIf(err)
+
+	managedFrom := parsed.(xmptype.ResourceRef)
+
+	lastModifyDate := xmptype.NewXmpDate(time.Date(2014, 9, 22, 10, 56, 35, 0, time.FixedZone("", 7200)), xmptype.DatePrecisionSecond, true)
+
+	if managedFrom.DocumentID != "xmp.did:2" {
+		t.Fatalf("ManagedFrom document-ID not correct: %s", managedFrom)
+	} else if managedFrom.LastModifyDate.Equal(lastModifyDate) != true {
+		t.Fatalf("ManagedFrom modify-date not correct: [%s]", managedFrom.LastModifyDate)
+	} else if reflect.DeepEqual(managedFrom.AlternatePaths, []string{"file:///a.psd", "file:///b.psd"}) != true {
+		t.Fatalf("ManagedFrom alternate paths not correct: %v", managedFrom.AlternatePaths)
+	}
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[xmpMM]Ingredients"})
+	log.PanicIf(err)
+
+	urrav := results[0].(xmptype.UnorderedResourceRefArrayValue)
+
+	refs, err := urrav.Refs()
+	log.PanicIf(err)
+
+	expected := []xmptype.ResourceRef{
+		{
+			DocumentID:  "xmp.did:3",
+			FilePath:    "placed.png",
+			MaskMarkers: "None",
+		},
+		{
+			DocumentID: "xmp.did:4",
+			FromPart:   "/metadata",
+			ToPart:     "/metadata",
+		},
+	}
+
+	if reflect.DeepEqual(refs, expected) != true {
+		t.Fatalf("Ingredients not correct: %v", refs)
+	}
+}
+
+func TestParser_Parse_Versions(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xpi := parseTestDocument(`
+<xmpMM:Versions>
+  <rdf:Seq>
+    <rdf:li stVer:version="1" stVer:modifier="Some Person">
+      <stVer:event stEvt:action="checked-in" stEvt:instanceID="xmp.iid:1"/>
+    </rdf:li>
+    <rdf:li rdf:parseType="Resource">
+      <stVer:version>2</stVer:version>
+      <stVer:comments>Second check-in</stVer:comments>
+      <stVer:modifyDate>2014-09-22T10:56:35+02:00</stVer:modifyDate>
+      <stVer:event rdf:parseType="Resource">
+        <stEvt:action>checked-in</stEvt:action>
+        <stEvt:instanceID>xmp.iid:2</stEvt:instanceID>
+      </stVer:event>
+    </rdf:li>
+  </rdf:Seq>
+</xmpMM:Versions>`)
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[xmpMM]Versions"})
+	log.PanicIf(err)
+
+	ovav := results[0].(xmptype.OrderedVersionArrayValue)
+
+	versions, err := ovav.Versions()
+	log.PanicIf(err)
+
+	if len(versions) != 2 {
+		t.Fatalf("Expected two versions: %v", versions)
+	}
+
+	modifyDate := xmptype.NewXmpDate(time.Date(2014, 9, 22, 10, 56, 35, 0, time.FixedZone("", 7200)), xmptype.DatePrecisionSecond, true)
+
+	if versions[1].ModifyDate.Equal(modifyDate) != true {
+		t.Fatalf("Version modify-date not correct: %s", versions[1])
+	}
+
+	versions[1].ModifyDate = modifyDate
+
+	expected := []xmptype.Version{
+		{
+			Event: xmptype.ResourceEvent{
+				Action:     "checked-in",
+				InstanceID: "xmp.iid:1",
+			},
+			Modifier: "Some Person",
+			Version:  "1",
+		},
+		{
+			Comments: "Second check-in",
+			Event: xmptype.ResourceEvent{
+				Action:     "checked-in",
+				InstanceID: "xmp.iid:2",
+			},
+			ModifyDate: modifyDate,
+			Version:    "2",
+		},
+	}
+
+	if reflect.DeepEqual(versions, expected) != true {
+		t.Fatalf("Versions not correct: %v", versions)
+	}
+}
+
+func TestParser_Parse_Choice(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.PhotoshopNamespace)
+
+	xpi := parseTestDocument(`
+<photoshop:ColorMode xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/">3</photoshop:ColorMode>`)
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[photoshop]ColorMode"})
+	log.PanicIf(err)
+
+	sln := results[0].(ScalarLeafNode)
+
+	if sln.ParsedValue != (xmptype.Choice{Code: "3", Label: "RGB color"}) {
+		t.Fatalf("Color mode not correct: [%v]", sln.ParsedValue)
+	}
+}
+
+func TestParser_Parse_TimeAndTimecode(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.XmpDmNamespace)
+
+	xpi := parseTestDocument(`
+<xmpDM:duration xmlns:xmpDM="http://ns.adobe.com/xmp/1.0/DynamicMedia/" xmpDM:value="60" xmpDM:scale="1001/30000" />
+<xmpDM:startTimecode xmlns:xmpDM="http://ns.adobe.com/xmp/1.0/DynamicMedia/" rdf:parseType="Resource">
+	<xmpDM:timeFormat>2997DropTimecode</xmpDM:timeFormat>
+	<xmpDM:timeValue>00;01;00;02</xmpDM:timeValue>
+</xmpDM:startTimecode>`)
+
+	rootName := xmpregistry.XmlName{Space: xmpnamespace.XUri, Local: "xmpmeta"}
+	durationName := xmpregistry.XmlName{Space: xmpnamespace.XmpDmUri, Local: "duration"}
+
+	parsed, err := xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, durationName})
+	log.PanicIf(err)
+
+	duration := parsed.(xmptype.Time)
+
+	d, err := duration.Duration()
+	log.PanicIf(err)
+
+	if d != 2002*time.Millisecond {
+		t.Fatalf("Duration not correct: [%s]", d)
+	}
+
+	startTimecodeName := xmpregistry.XmlName{Space: xmpnamespace.XmpDmUri, Local: "startTimecode"}
+
+	parsed, err = xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, startTimecodeName})
+	log.PanicIf(err)
+
+	startTimecode := parsed.(xmptype.Timecode)
+
+	frames, err := startTimecode.Frames()
+	log.PanicIf(err)
+
+	if frames != 1800 {
+		t.Fatalf("Start-timecode frames not correct: (%d)", frames)
+	}
+}
+
+func TestParser_Parse_Tracks(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.XmpDmNamespace)
+
+	xpi := parseTestDocument(`
+<xmpDM:Tracks xmlns:xmpDM="http://ns.adobe.com/xmp/1.0/DynamicMedia/">
+	<rdf:Bag>
+		<rdf:li rdf:parseType="Resource">
+			<xmpDM:trackName>Chapters</xmpDM:trackName>
+			<xmpDM:trackType>Chapter</xmpDM:trackType>
+			<xmpDM:frameRate>f25</xmpDM:frameRate>
+			<xmpDM:markers>
+				<rdf:Seq>
+					<rdf:li xmpDM:startTime="250" xmpDM:name="Intro" xmpDM:type="Chapter" />
+					<rdf:li rdf:parseType="Resource">
+						<xmpDM:startTime>1001f30000s1001</xmpDM:startTime>
+						<xmpDM:name>Cue</xmpDM:name>
+						<xmpDM:cuePointType>Event</xmpDM:cuePointType>
+						<xmpDM:cuePointParams>
+							<rdf:Seq>
+								<rdf:li xmpDM:key="scene" xmpDM:value="2" />
+							</rdf:Seq>
+						</xmpDM:cuePointParams>
+					</rdf:li>
+				</rdf:Seq>
+			</xmpDM:markers>
+		</rdf:li>
+	</rdf:Bag>
+</xmpDM:Tracks>`)
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[xmpDM]Tracks"})
+	log.PanicIf(err)
+
+	if len(results) != 1 {
+		t.Fatalf("Expected exactly one tracks array: %v", results)
+	}
+
+	utav := results[0].(xmptype.UnorderedTrackArrayValue)
+
+	tracks, err := utav.Tracks()
+	log.PanicIf(err)
+
+	if len(tracks) != 1 {
+		t.Fatalf("Expected exactly one track: %v", tracks)
+	}
+
+	track := tracks[0]
+
+	if track.String() != "Track<NAME=[Chapters] TYPE=[Chapter] FRAME-RATE=[f25] MARKERS=(2)>" {
+		t.Fatalf("Track not correct: %s", track)
+	}
+
+	expected := []xmptype.Marker{
+		{
+			StartTime: xmptype.FrameCount{Count: 250, Rate: xmptype.FrameRate{Numerator: 25, Denominator: 1}},
+			Type:      xmptype.Choice{Code: "Chapter", Label: "Chapter"},
+			Name:      "Intro",
+		},
+		{
+			StartTime:    xmptype.FrameCount{Count: 1001, Rate: xmptype.FrameRate{Numerator: 30000, Denominator: 1001}},
+			Name:         "Cue",
+			CuePointType: "Event",
+			CuePointParams: []xmptype.CuePointParam{
+				{Key: "scene", Value: "2"},
+			},
+		},
+	}
+
+	if reflect.DeepEqual(track.Markers, expected) != true {
+		t.Fatalf("Markers not correct: %v", track.Markers)
+	}
+
+	start, err := track.Markers[0].Start()
+	log.PanicIf(err)
+
+	if start != 10*time.Second {
+		t.Fatalf("Start of first marker not correct: [%s]", start)
+	}
+
+	start, err = track.Markers[1].Start()
+	log.PanicIf(err)
+
+	if start != 33400033333*time.Nanosecond {
+		t.Fatalf("Start of second marker not correct: [%s]", start)
+	}
+}
+
+func TestParser_Parse_ScopedFields(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.XmpDmNamespace)
+
+	xpi := parseTestDocument(`
+<xmpDM:duration xmlns:xmpDM="http://ns.adobe.com/xmp/1.0/DynamicMedia/" xmpDM:value="50" xmpDM:scale="1/25" />
+<xmpDM:markers xmlns:xmpDM="http://ns.adobe.com/xmp/1.0/DynamicMedia/">
+	<rdf:Seq>
+		<rdf:li xmpDM:startTime="25" xmpDM:duration="50f25" xmpDM:name="Intro" />
+	</rdf:Seq>
+</xmpDM:markers>`)
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[xmpDM]duration"})
+	log.PanicIf(err)
+
+	value, found := results[0].(ComplexLeafNode).Get(xmpnamespace.XmpDmUri, "value")
+
+	if found != true || value != int64(50) {
+		t.Fatalf("Time value not correct: [%v]", value)
+	}
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[xmpDM]markers"})
+	log.PanicIf(err)
+
+	omav := results[0].(xmptype.OrderedMarkerArrayValue)
+
+	markers, err := omav.Markers()
+	log.PanicIf(err)
+
+	expected := []xmptype.Marker{
+		{
+			StartTime: xmptype.FrameCount{Count: 25},
+			Duration:  xmptype.FrameCount{Count: 50, Rate: xmptype.FrameRate{Numerator: 25, Denominator: 1}},
+			Name:      "Intro",
+		},
+	}
+
+	if reflect.DeepEqual(markers, expected) != true {
+		t.Fatalf("Markers not correct: %v", markers)
+	}
+}
+
+func TestParser_Parse_Camera(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.TiffNamespace)
+	xmpregistry.Register(xmpnamespace.ExifNamespace)
+	xmpregistry.Register(xmpnamespace.ExifExNamespace)
+	xmpregistry.Register(xmpnamespace.AuxNamespace)
+
+	xpi := parseTestDocument(`
+<tiff:Make xmlns:tiff="http://ns.adobe.com/tiff/1.0/">Canon</tiff:Make>
+<tiff:Orientation xmlns:tiff="http://ns.adobe.com/tiff/1.0/">6</tiff:Orientation>
+<exif:FNumber xmlns:exif="http://ns.adobe.com/exif/1.0/">28/10</exif:FNumber>
+<exif:ISOSpeedRatings xmlns:exif="http://ns.adobe.com/exif/1.0/">
+	<rdf:Seq>
+		<rdf:li>400</rdf:li>
+	</rdf:Seq>
+</exif:ISOSpeedRatings>
+<exif:GPSLatitude xmlns:exif="http://ns.adobe.com/exif/1.0/">40,26.7666N</exif:GPSLatitude>
+<exif:Flash xmlns:exif="http://ns.adobe.com/exif/1.0/" rdf:parseType="Resource">
+	<exif:Fired>True</exif:Fired>
+	<exif:Return>0</exif:Return>
+	<exif:Mode>1</exif:Mode>
+	<exif:Function>False</exif:Function>
+	<exif:RedEyeMode>False</exif:RedEyeMode>
+</exif:Flash>
+<exif:CFAPattern xmlns:exif="http://ns.adobe.com/exif/1.0/" rdf:parseType="Resource">
+	<exif:Columns>2</exif:Columns>
+	<exif:Rows>2</exif:Rows>
+	<exif:Values>
+		<rdf:Seq>
+			<rdf:li>0</rdf:li>
+			<rdf:li>1</rdf:li>
+			<rdf:li>1</rdf:li>
+			<rdf:li>2</rdf:li>
+		</rdf:Seq>
+	</exif:Values>
+</exif:CFAPattern>
+<exifEX:LensModel xmlns:exifEX="http://cipa.jp/exif/1.0/">EF24-70mm f/2.8L USM</exifEX:LensModel>
+<aux:SerialNumber xmlns:aux="http://ns.adobe.com/exif/1.0/aux/">0123456789</aux:SerialNumber>`)
+
+	expectedScalars := map[string]interface{}{
+		"[tiff]Make":        "Canon",
+		"[tiff]Orientation": xmptype.Choice{Code: "6", Label: "Right, top"},
+		"[exif]FNumber":     xmptype.Rational{Numerator: 28, Denominator: 10},
+		"[exif]GPSLatitude": xmptype.GpsCoordinate{Degrees: 40, Minutes: 26.7666, Direction: "N"},
+		"[exifEX]LensModel": "EF24-70mm f/2.8L USM",
+		"[aux]SerialNumber": "0123456789",
+	}
+
+	for phrase, expected := range expectedScalars {
+		results, err := xpi.Get([]string{"[x]xmpmeta", phrase})
+		log.PanicIf(err)
+
+		if results[0].(ScalarLeafNode).ParsedValue != expected {
+			t.Fatalf("Value for [%s] not correct: [%v]", phrase, results[0].(ScalarLeafNode).ParsedValue)
+		}
+	}
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[exif]ISOSpeedRatings"})
+	log.PanicIf(err)
+
+	ratings, err := xmptype.IntegerItems(results[0].(xmptype.ArrayValue))
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(ratings, []int64{400}) != true {
+		t.Fatalf("ISO speed-ratings not correct: %v", ratings)
+	}
+
+	rootName := xmpregistry.XmlName{Space: xmpnamespace.XUri, Local: "xmpmeta"}
+	flashName := xmpregistry.XmlName{Space: xmpnamespace.ExifUri, Local: "Flash"}
+
+	parsed, err := xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, flashName})
+	log.PanicIf(err)
+
+	if parsed != (xmptype.Flash{Fired: true, Mode: 1}) {
+		t.Fatalf("Flash not correct: %v", parsed)
+	}
+
+	cfaPatternName := xmpregistry.XmlName{Space: xmpnamespace.ExifUri, Local: "CFAPattern"}
+
+	parsed, err = xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, cfaPatternName})
+	log.PanicIf(err)
+
+	expectedCfaPattern := xmptype.CfaPattern{
+		Columns: 2,
+		Rows:    2,
+		Values:  []int64{0, 1, 1, 2},
+	}
+
+	if reflect.DeepEqual(parsed, expectedCfaPattern) != true {
+		t.Fatalf("CFA pattern not correct: %v", parsed)
+	}
+}
+
+func TestParser_Parse_Iptc(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.ExifNamespace)
+	xmpregistry.Register(xmpnamespace.Iptc4xmpCoreNamespace)
+	xmpregistry.Register(xmpnamespace.Iptc4xmpExtNamespace)
+
+	xpi := parseTestDocument(`
+<Iptc4xmpCore:CreatorContactInfo xmlns:Iptc4xmpCore="http://iptc.org/std/Iptc4xmpCore/1.0/xmlns/" rdf:parseType="Resource">
+	<Iptc4xmpCore:CiAdrCity>Springfield</Iptc4xmpCore:CiAdrCity>
+	<Iptc4xmpCore:CiEmailWork>photo@example.com</Iptc4xmpCore:CiEmailWork>
+</Iptc4xmpCore:CreatorContactInfo>
+<Iptc4xmpExt:DigitalSourceType xmlns:Iptc4xmpExt="http://iptc.org/std/Iptc4xmpExt/2008-02-29/">http://cv.iptc.org/newscodes/digitalsourcetype/trainedAlgorithmicMedia</Iptc4xmpExt:DigitalSourceType>
+<Iptc4xmpExt:LocationShown xmlns:Iptc4xmpExt="http://iptc.org/std/Iptc4xmpExt/2008-02-29/" xmlns:exif="http://ns.adobe.com/exif/1.0/">
+	<rdf:Bag>
+		<rdf:li rdf:parseType="Resource">
+			<Iptc4xmpExt:City>Paris</Iptc4xmpExt:City>
+			<Iptc4xmpExt:LocationName>
+				<rdf:Alt>
+					<rdf:li xml:lang="x-default">Eiffel Tower</rdf:li>
+				</rdf:Alt>
+			</Iptc4xmpExt:LocationName>
+			<exif:GPSLatitude>48,51.5N</exif:GPSLatitude>
+		</rdf:li>
+	</rdf:Bag>
+</Iptc4xmpExt:LocationShown>
+<Iptc4xmpExt:ImageRegion xmlns:Iptc4xmpExt="http://iptc.org/std/Iptc4xmpExt/2008-02-29/">
+	<rdf:Bag>
+		<rdf:li rdf:parseType="Resource">
+			<Iptc4xmpExt:rId>area-1</Iptc4xmpExt:rId>
+			<Iptc4xmpExt:RegionBoundary rdf:parseType="Resource">
+				<Iptc4xmpExt:rbShape>polygon</Iptc4xmpExt:rbShape>
+				<Iptc4xmpExt:rbUnit>relative</Iptc4xmpExt:rbUnit>
+				<Iptc4xmpExt:rbVertices>
+					<rdf:Seq>
+						<rdf:li Iptc4xmpExt:rbX="0.1" Iptc4xmpExt:rbY="0.2" />
+						<rdf:li Iptc4xmpExt:rbX="0.3" Iptc4xmpExt:rbY="0.4" />
+					</rdf:Seq>
+				</Iptc4xmpExt:rbVertices>
+			</Iptc4xmpExt:RegionBoundary>
+		</rdf:li>
+	</rdf:Bag>
+</Iptc4xmpExt:ImageRegion>`)
+
+	rootName := xmpregistry.XmlName{Space: xmpnamespace.XUri, Local: "xmpmeta"}
+	contactInfoName := xmpregistry.XmlName{Space: xmpnamespace.Iptc4xmpCoreUri, Local: "CreatorContactInfo"}
+
+	parsed, err := xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, contactInfoName})
+	log.PanicIf(err)
+
+	if parsed != (xmptype.ContactInfo{City: "Springfield", Email: "photo@example.com"}) {
+		t.Fatalf("Contact info not correct: %v", parsed)
+	}
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[Iptc4xmpExt]DigitalSourceType"})
+	log.PanicIf(err)
+
+	digitalSourceType := results[0].(ScalarLeafNode).ParsedValue.(xmptype.Choice)
+
+	if digitalSourceType.Label != "Trained algorithmic media" {
+		t.Fatalf("Digital source-type not correct: [%v]", digitalSourceType)
+	} else if xmptype.IsGenerativeDigitalSourceType(digitalSourceType.Code) != true {
+		t.Fatalf("Expected digital source-type to be generative.")
+	}
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[Iptc4xmpExt]LocationShown"})
+	log.PanicIf(err)
+
+	items, err := results[0].(xmptype.ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	expectedLocation := xmptype.LocationDetails{
+		Name:        map[string]string{"x-default": "Eiffel Tower"},
+		City:        "Paris",
+		GpsLatitude: xmptype.GpsCoordinate{Degrees: 48, Minutes: 51.5, Direction: "N"},
+	}
+
+	if len(items) != 1 || items[0].Err != nil {
+		t.Fatalf("Expected exactly one valid location: %v", items)
+	} else if reflect.DeepEqual(items[0].Value, expectedLocation) != true {
+		t.Fatalf("Location not correct: %v", items[0].Value)
+	}
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[Iptc4xmpExt]ImageRegion"})
+	log.PanicIf(err)
+
+	items, err = results[0].(xmptype.ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	expectedRegion := xmptype.ImageRegion{
+		Id: "area-1",
+		Boundary: xmptype.RegionBoundary{
+			Shape: xmptype.Choice{Code: "polygon", Label: "Polygon"},
+			Unit:  xmptype.Choice{Code: "relative", Label: "Relative"},
+			Vertices: []xmptype.RegionBoundaryPoint{
+				{X: 0.1, Y: 0.2},
+				{X: 0.3, Y: 0.4},
+			},
+		},
+	}
+
+	if len(items) != 1 || items[0].Err != nil {
+		t.Fatalf("Expected exactly one valid region: %v", items)
+	} else if reflect.DeepEqual(items[0].Value, expectedRegion) != true {
+		t.Fatalf("Region not correct: %v", items[0].Value)
+	}
+}
+
+func TestParser_Parse_CameraRaw(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.CrsNamespace)
+	xmpregistry.Register(xmpnamespace.LrNamespace)
+
+	document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+        xmlns:crs="http://ns.adobe.com/camera-raw-settings/1.0/"
+        xmlns:lr="http://ns.adobe.com/lightroom/1.0/"
+        crs:Version="15.0"
+        crs:WhiteBalance="As Shot"
+        crs:Exposure2012="+0.50"
+        crs:Contrast2012="-12"
+        crs:HasSettings="True"
+        crs:Unknown="ignored">
+      <crs:ToneCurvePV2012>
+        <rdf:Seq>
+          <rdf:li>0, 0</rdf:li>
+          <rdf:li>128, 140</rdf:li>
+          <rdf:li>255, 255</rdf:li>
+        </rdf:Seq>
+      </crs:ToneCurvePV2012>
+      <crs:GradientBasedCorrections>
+        <rdf:Seq>
+          <rdf:li>
+            <rdf:Description
+                crs:What="Correction"
+                crs:CorrectionAmount="1.000000"
+                crs:CorrectionActive="true"
+                crs:LocalExposure2012="-0.700000">
+              <crs:CorrectionMasks>
+                <rdf:Seq>
+                  <rdf:li
+                      crs:What="Mask/Gradient"
+                      crs:MaskValue="1.000000"
+                      crs:ZeroX="0.5"
+                      crs:ZeroY="0.1"
+                      crs:FullX="0.5"
+                      crs:FullY="0.4"/>
+                </rdf:Seq>
+              </crs:CorrectionMasks>
+            </rdf:Description>
+          </rdf:li>
+        </rdf:Seq>
+      </crs:GradientBasedCorrections>
+      <crs:RetouchAreas>
+        <rdf:Seq>
+          <rdf:li>
+            <rdf:Description
+                crs:SpotType="heal"
+                crs:Method="gaussian"
+                crs:Opacity="1"/>
+          </rdf:li>
+        </rdf:Seq>
+      </crs:RetouchAreas>
+      <lr:hierarchicalSubject>
+        <rdf:Bag>
+          <rdf:li>Places|Europe|France</rdf:li>
+        </rdf:Bag>
+      </lr:hierarchicalSubject>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	b := bytes.NewBufferString(document)
+	xp := NewParser(b)
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	expectedSettings := map[string]interface{}{
+		"Version":      "15.0",
+		"WhiteBalance": xmptype.Choice{Code: "As Shot", Label: "As Shot"},
+		"Exposure2012": 0.5,
+		"Contrast2012": int64(-12),
+		"HasSettings":  true,
+	}
+
+	for name, expected := range expectedSettings {
+		results, err := xpi.Get([]string{"[x]xmpmeta", "[crs]" + name})
+		log.PanicIf(err)
+
+		if value := results[0].(ScalarLeafNode).ParsedValue; value != expected {
+			t.Fatalf("Setting [%s] not correct: [%v]", name, value)
+		}
+	}
+
+	_, err = xpi.Get([]string{"[x]xmpmeta", "[crs]Unknown"})
+	if err != ErrFieldNotFound {
+		t.Fatalf("Expected unknown setting to not be indexed: %v", err)
+	}
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[crs]ToneCurvePV2012"})
+	log.PanicIf(err)
+
+	items, err := results[0].(xmptype.ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	if len(items) != 3 || items[1].Value != (xmptype.ToneCurvePoint{Input: 128, Output: 140}) {
+		t.Fatalf("Tone curve not correct: %v", items)
+	}
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[crs]GradientBasedCorrections"})
+	log.PanicIf(err)
+
+	items, err = results[0].(xmptype.ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	expectedCorrection := xmptype.LocalCorrection{
+		What:   "Correction",
+		Active: true,
+		Amount: 1,
+		Adjustments: map[string]float64{
+			"LocalExposure2012": -0.7,
+		},
+		Masks: []xmptype.CorrectionMask{
+			{
+				What:  "Mask/Gradient",
+				Value: 1,
+				ZeroX: 0.5,
+				ZeroY: 0.1,
+				FullX: 0.5,
+				FullY: 0.4,
+			},
+		},
+	}
+
+	if len(items) != 1 || items[0].Err != nil {
+		t.Fatalf("Expected exactly one valid correction: %v", items)
+	} else if reflect.DeepEqual(items[0].Value, expectedCorrection) != true {
+		t.Fatalf("Correction not correct: %v", items[0].Value)
+	}
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[crs]RetouchAreas"})
+	log.PanicIf(err)
+
+	items, err = results[0].(xmptype.ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	expectedRetouchArea := xmptype.RetouchArea{
+		SpotType: "heal",
+		Method:   "gaussian",
+		Opacity:  1,
+	}
+
+	if len(items) != 1 || items[0].Err != nil {
+		t.Fatalf("Expected exactly one valid retouch area: %v", items)
+	} else if reflect.DeepEqual(items[0].Value, expectedRetouchArea) != true {
+		t.Fatalf("Retouch area not correct: %v", items[0].Value)
+	}
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[lr]hierarchicalSubject"})
+	log.PanicIf(err)
+
+	subjects, err := results[0].(xmptype.ArrayStringValueLister).StringItems()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(subjects, []string{"Places|Europe|France"}) != true {
+		t.Fatalf("Subjects not correct: %v", subjects)
+	}
+}
+
+func TestParser_Parse_NestedDescription(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xpi := parseTestDocument(`
+<xmpMM:DerivedFrom>
+	<rdf:Description stRef:instanceID="xmp.iid:1234" stRef:documentID="xmp.did:5678" />
+</xmpMM:DerivedFrom>`)
+
+	rootName := xmpregistry.XmlName{Space: xmpnamespace.XUri, Local: "xmpmeta"}
+	derivedFromName := xmpregistry.XmlName{Space: xmpnamespace.XmpMmUri, Local: "DerivedFrom"}
+
+	parsed, err := xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, derivedFromName})
+	log.PanicIf(err)
+
+	rr := parsed.(xmptype.ResourceRef)
+
+	if rr.InstanceID != "xmp.iid:1234" || rr.DocumentID != "xmp.did:5678" {
+		t.Fatalf("Resource reference not correct: %v", rr)
+	}
+}
+
+func TestParser_Parse_Aliases(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.PhotoshopNamespace)
+
+	for _, alias := range xmpnamespace.StandardAliases {
+		xmpregistry.RegisterAlias(alias)
+	}
+
+	document := getTestDocument(`
+<xmp:Author>some author</xmp:Author>
+<photoshop:Caption xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/">some caption</photoshop:Caption>
+<photoshop:Keywords xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/">
+	<rdf:Bag>
+		<rdf:li>aa</rdf:li>
+		<rdf:li>bb</rdf:li>
+	</rdf:Bag>
+</photoshop:Keywords>
+<dc:title>
+	<rdf:Alt>
+		<rdf:li xml:lang="x-default">some title</rdf:li>
+	</rdf:Alt>
+</dc:title>
+<photoshop:Title xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/">other title</photoshop:Title>`)
+
+	xp := NewParser(bytes.NewBufferString(document))
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	expectedAliases := []xmpregistry.Alias{
+		xmpnamespace.StandardAliases[0],
+		xmpnamespace.StandardAliases[16],
+		xmpnamespace.StandardAliases[18],
+	}
+
+	if reflect.DeepEqual(xp.ResolvedAliases(), expectedAliases) != true {
+		t.Fatalf("Resolved aliases not correct: %v", xp.ResolvedAliases())
+	}
+
+	// The first-item alias becomes the only item of the base array.
+
+	value, err := xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.DcUri, Local: "creator"})
+	log.PanicIf(err)
+
+	items, err := xmptype.TextItems(value.(xmptype.ArrayValue))
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"some author"}) != true {
+		t.Fatalf("Creator not correct: %v", items)
+	}
+
+	// The default-language alias becomes the default alternative.
+
+	value, err = xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.PhotoshopUri, Local: "Caption"})
+	log.PanicIf(err)
+
+	laav := value.(xmptype.LanguageAlternativeArrayValue)
+
+	stringItems, err := laav.StringItems()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(stringItems, []string{"{[xml]lang=[x-default]} [some caption]"}) != true {
+		t.Fatalf("Description not correct: %v", stringItems)
+	}
+
+	// The simple alias is the base array. It is found by either name.
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[photoshop]Keywords"})
+	log.PanicIf(err)
+
+	items, err = xmptype.TextItems(results[0].(xmptype.ArrayValue))
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"aa", "bb"}) != true {
+		t.Fatalf("Subject not correct: %v", items)
+	}
+
+	_, err = xpi.Get([]string{"[x]xmpmeta", "[dc]subject"})
+	log.PanicIf(err)
+
+	// The alias of a base property that is already present is dropped.
+
+	results, err = xpi.Get([]string{"[x]xmpmeta", "[dc]title"})
+	log.PanicIf(err)
+
+	if len(results) != 1 {
+		t.Fatalf("Expected the title alias to be dropped: (%d)", len(results))
+	}
+
+	for _, name := range []string{"[xmp]Author", "[photoshop]Caption", "[photoshop]Title"} {
+		if _, found := xpi.subindices["[x]xmpmeta"].leaves[name]; found == true {
+			t.Fatalf("Alias was indexed: [%s]", name)
+		}
+	}
+}
+
+func TestParser_Parse_Aliases_BaseAfterAlias(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.PhotoshopNamespace)
+
+	for _, alias := range xmpnamespace.StandardAliases {
+		xmpregistry.RegisterAlias(alias)
+	}
+
+	document := getTestDocument(`
+<photoshop:Author xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/">some author</photoshop:Author>
+<dc:creator>
+	<rdf:Seq>
+		<rdf:li>aa</rdf:li>
+		<rdf:li>bb</rdf:li>
+	</rdf:Seq>
+</dc:creator>`)
+
+	xp := NewParser(bytes.NewBufferString(document))
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	if len(xp.ResolvedAliases()) != 0 {
+		t.Fatalf("Expected the replaced alias to not be reported: %v", xp.ResolvedAliases())
+	}
+
+	// The base property replaces the value of the alias.
+
+	results, err := xpi.Get([]string{"[x]xmpmeta", "[dc]creator"})
+	log.PanicIf(err)
+
+	if len(results) != 1 {
+		t.Fatalf("Expected one creator: (%d)", len(results))
+	}
+
+	items, err := xmptype.TextItems(results[0].(xmptype.ArrayValue))
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"aa", "bb"}) != true {
+		t.Fatalf("Creator not correct: %v", items)
+	}
+
+	// The graph has both properties as they were written.
+
+	graph := xpi.Graph()
+	subject := xmprdf.NewIri("")
+
+	objects := graph.Objects(subject, xmprdf.NewIri(xmpnamespace.PhotoshopUri+"Author"))
+
+	if len(objects) != 1 || objects[0] != xmprdf.NewLiteral("some author", "", "") {
+		t.Fatalf("Alias not in graph: %v", objects)
+	} else if objects := graph.Objects(subject, xmprdf.NewIri(xmpnamespace.DcUri+"creator")); len(objects) != 1 {
+		t.Fatalf("Expected one creator in graph: %v", objects)
+	}
+}
+
+func TestParser_Parse_AliasAttributes(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.PhotoshopNamespace)
+
+	for _, alias := range xmpnamespace.StandardAliases {
+		xmpregistry.RegisterAlias(alias)
+	}
+
+	document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/" photoshop:Author="some author" photoshop:Caption="some caption" />
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	xp := NewParser(bytes.NewBufferString(document))
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	if len(xp.ResolvedAliases()) != 2 {
+		t.Fatalf("Resolved aliases not correct: %v", xp.ResolvedAliases())
+	}
+
+	value, err := xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.DcUri, Local: "creator"})
+	log.PanicIf(err)
+
+	items, err := xmptype.TextItems(value.(xmptype.ArrayValue))
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"some author"}) != true {
+		t.Fatalf("Creator not correct: %v", items)
+	}
+
+	value, err = xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.DcUri, Local: "description"})
+	log.PanicIf(err)
+
+	stringItems, err := value.(xmptype.LanguageAlternativeArrayValue).StringItems()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(stringItems, []string{"{[xml]lang=[x-default]} [some caption]"}) != true {
+		t.Fatalf("Description not correct: %v", stringItems)
+	}
+}
+
+func TestParser_Parse_Aliases_SimpleAliasOfArray(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	xmpregistry.Register(xmpnamespace.TiffNamespace)
+
+	for _, alias := range xmpnamespace.StandardAliases {
+		xmpregistry.RegisterAlias(alias)
+	}
+
+	testCases := []struct {
+		attributes string
+		properties string
+		expected   string
+	}{
+		{`tiff:ImageDescription="some description"`, ``, "{[xml]lang=[x-default]} [some description]"},
+		{`xmp:Description="some description"`, ``, "{[xml]lang=[x-default]} [some description]"},
+		{``, `<tiff:ImageDescription>some description</tiff:ImageDescription>`, "{[xml]lang=[x-default]} [some description]"},
+		{``, `<xmp:Description>some description</xmp:Description>`, "{[xml]lang=[x-default]} [some description]"},
+		{``, `<xmp:Description xml:lang="de">some description</xmp:Description>`, "{[xml]lang=[de]} [some description]"},
+		{``, `<xmp:Description><rdf:Alt><rdf:li xml:lang="en">some description</rdf:li></rdf:Alt></xmp:Description>`, "{[xml]lang=[en]} [some description]"},
+	}
+
+	for _, tc := range testCases {
+		document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+        xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+        xmlns:tiff="http://ns.adobe.com/tiff/1.0/" ` + tc.attributes + `>
+` + tc.properties + `
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+		xp := NewParser(bytes.NewBufferString(document))
+
+		xpi, err := xp.Parse()
+		log.PanicIf(err)
+
+		value, err := xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.DcUri, Local: "description"})
+		log.PanicIf(err)
+
+		stringItems, err := value.(xmptype.LanguageAlternativeArrayValue).StringItems()
+		log.PanicIf(err)
+
+		if reflect.DeepEqual(stringItems, []string{tc.expected}) != true {
+			t.Fatalf("Description not correct for [%s%s]: %v", tc.attributes, tc.properties, stringItems)
+		}
+	}
+
+	// A single value of an alias of an unordered array becomes its only item.
+
+	xpi := parseTestDocument(`<xmp:Keywords>aa</xmp:Keywords>`)
+
+	value, err := xpi.GetProperty(xmpregistry.XmlName{Space: xmpnamespace.DcUri, Local: "subject"})
+	log.PanicIf(err)
+
+	items, err := xmptype.TextItems(value.(xmptype.ArrayValue))
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"aa"}) != true {
+		t.Fatalf("Subject not correct: %v", items)
+	}
+}
+
+func TestNewParserWithRegistry(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestDocumentNamespaces()
+
+	registry := xmpregistry.Default().Clone()
+
+	tenantNamespace := xmpregistry.Namespace{
+		Uri:             "http://some/uri/tenant/",
+		PreferredPrefix: "tenant",
+		Fields: map[string]interface{}{
+			"Count": xmptype.IntegerFieldType{},
+		},
+	}
+
+	err := registry.Register(tenantNamespace)
+	log.PanicIf(err)
+
+	document := getTestDocument(`<tenant:Count xmlns:tenant="http://some/uri/tenant/">12</tenant:Count>`)
+
+	// Parse concurrently with both registries.
+
+	wg := new(sync.WaitGroup)
+	indices := make([]*XmpPropertyIndex, 10)
+
+	for i := range indices {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			xp := NewParser(bytes.NewBufferString(document))
+			if i%2 == 0 {
+				xp = NewParserWithRegistry(bytes.NewBufferString(document), registry)
+			}
+
+			xpi, err := xp.Parse()
+			log.PanicIf(err)
+
+			indices[i] = xpi
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, xpi := range indices {
+		if i%2 == 0 {
+			if xpi.Registry() != registry {
+				t.Fatalf("Index does not have the parser's registry.")
+			}
+
+			results, err := xpi.Get([]string{"[x]xmpmeta", "[tenant]Count"})
+			log.PanicIf(err)
+
+			if len(results) != 1 || results[0].(ScalarLeafNode).ParsedValue != int64(12) {
+				t.Fatalf("Value not parsed with the given registry: %v", results)
+			}
+		} else if xpi.Count() != 0 {
+			t.Fatalf("Namespace registered with another registry was used: (%d)", xpi.Count())
+		}
+	}
+}
+
 func TestRawAttributeAssignment_parse_doubleQuotes(t *testing.T) {
 	phrase := `aa="bb"`
 
@@ -195,7 +1588,7 @@ func TestParser_parseEndElementToken_pushToIndex(t *testing.T) {
 		Local: "Label",
 	}
 
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 
 	err := xp.parseStartElementToken(xpi, xml.StartElement{Name: name})
 	log.PanicIf(err)
@@ -258,7 +1651,7 @@ func TestParser_parseToken_ProcInst(t *testing.T) {
 
 	// Test ProcInst.
 
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 
 	token1 := xml.ProcInst{
 		Target: "xpacket",
@@ -279,7 +1672,7 @@ func TestParser_parseToken_StartElement(t *testing.T) {
 		Name: xmpnamespace.RdfTag,
 	}
 
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 
 	xp := NewParser(nil)
 
@@ -296,7 +1689,7 @@ func TestParser_parseToken_EndElement(t *testing.T) {
 		Name: xmpnamespace.RdfTag,
 	}
 
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 
 	xp := NewParser(nil)
 	xp.rdfIsOpen = true
@@ -315,7 +1708,7 @@ func TestParser_parseToken_CharData(t *testing.T) {
 
 	xmpregistry.Register(xmpnamespace.XmpNamespace)
 
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 
 	xp := NewParser(nil)
 	xp.rdfIsOpen = true
@@ -350,7 +1743,7 @@ func TestParser_parseCharData(t *testing.T) {
 
 	xmpregistry.Register(xmpnamespace.XmpNamespace)
 
-	xpi := newXmpPropertyIndex(xmpregistry.XmlName{})
+	xpi := newXmpPropertyIndex(xmpregistry.Default(), xmpregistry.XmlName{})
 
 	xp := NewParser(nil)
 	xp.rdfIsOpen = true
@@ -0,0 +1,558 @@
+package xmprdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+var (
+	builderLogger = log.NewLogger("xmprdf.builder")
+)
+
+var (
+	rdfTag            = xml.Name{Space: RdfUri, Local: "RDF"}
+	rdfDescriptionTag = xml.Name{Space: RdfUri, Local: "Description"}
+	rdfLiTag          = xml.Name{Space: RdfUri, Local: "li"}
+
+	rdfAboutName     = xml.Name{Space: RdfUri, Local: "about"}
+	rdfIdName        = xml.Name{Space: RdfUri, Local: "ID"}
+	rdfNodeIdName    = xml.Name{Space: RdfUri, Local: "nodeID"}
+	rdfResourceName  = xml.Name{Space: RdfUri, Local: "resource"}
+	rdfParseTypeName = xml.Name{Space: RdfUri, Local: "parseType"}
+	rdfDatatypeName  = xml.Name{Space: RdfUri, Local: "datatype"}
+	rdfTypeName      = xml.Name{Space: RdfUri, Local: "type"}
+
+	xmlLangName = xml.Name{Space: XmlUri, Local: "lang"}
+)
+
+// frameKind describes what the children of an element are expected to be.
+type frameKind int
+
+const (
+	// frameIgnore is an element outside of "rdf:RDF" or content that is not
+	// allowed where it was found. Its children are ignored.
+	frameIgnore frameKind = iota
+
+	// frameRdf is the "rdf:RDF" element. Its children are node elements.
+	frameRdf
+
+	// frameNode is a node element (or a property element having
+	// parseType="Resource"). Its children are property elements.
+	frameNode
+
+	// frameProperty is a property element whose value is either char-data or
+	// a single node element.
+	frameProperty
+
+	// frameEmptyProperty is a property element whose value was fully
+	// described by its attributes.
+	frameEmptyProperty
+
+	// frameLiteral is a property element having parseType="Literal". Its
+	// content is collected verbatim.
+	frameLiteral
+)
+
+// builderFrame tracks the state of a single open element.
+type builderFrame struct {
+	kind frameKind
+
+	// subject is the node of a node frame or the subject of a property frame.
+	subject Term
+
+	// predicate is the predicate of a property frame.
+	predicate Term
+
+	// language is the "xml:lang" in scope.
+	language string
+
+	// datatype is the "rdf:datatype" of a property frame.
+	datatype string
+
+	// text is the char-data of a property frame.
+	text *strings.Builder
+
+	// hasObject indicates that a node element was found under a property
+	// frame.
+	hasObject bool
+
+	// memberCount is the number of "rdf:li" elements found under a node
+	// frame.
+	memberCount int
+
+	// literalBuffer and literalEncoder collect the content of a literal
+	// frame. literalDepth is the number of open elements within it.
+	literalBuffer  *bytes.Buffer
+	literalEncoder *xml.Encoder
+	literalDepth   int
+}
+
+// Builder populates a graph from a stream of RDF/XML tokens. Content outside
+// of "rdf:RDF" is ignored. The builder is lenient: anything that is not valid
+// RDF/XML is skipped rather than failing, since XMP writers do not always
+// conform.
+type Builder struct {
+	graph *Graph
+	stack []*builderFrame
+
+	// blankNodes maps the "rdf:nodeID" values found in the document to the
+	// blank-nodes allocated for them.
+	blankNodes map[string]Term
+}
+
+// NewBuilder returns a builder that adds to the given graph.
+func NewBuilder(graph *Graph) *Builder {
+	return &Builder{
+		graph:      graph,
+		stack:      make([]*builderFrame, 0),
+		blankNodes: make(map[string]Term),
+	}
+}
+
+// Graph returns the graph being populated.
+func (builder *Builder) Graph() *Graph {
+	return builder.graph
+}
+
+func (builder *Builder) top() *builderFrame {
+	if len(builder.stack) == 0 {
+		return nil
+	}
+
+	return builder.stack[len(builder.stack)-1]
+}
+
+func (builder *Builder) push(frame *builderFrame) {
+	builder.stack = append(builder.stack, frame)
+}
+
+// language returns the "xml:lang" of the element or the one in scope.
+func (builder *Builder) language(t xml.StartElement) string {
+	for _, attribute := range t.Attr {
+		if attribute.Name == xmlLangName {
+			return attribute.Value
+		}
+	}
+
+	if top := builder.top(); top != nil {
+		return top.language
+	}
+
+	return ""
+}
+
+// declareLanguage remembers the "xml:lang" of the element that describes the
+// node, if it has one.
+func (builder *Builder) declareLanguage(node Term, t xml.StartElement) {
+	for _, attribute := range t.Attr {
+		if attribute.Name == xmlLangName {
+			builder.graph.languages[node] = attribute.Value
+		}
+	}
+}
+
+// blankNode returns the blank-node for the given "rdf:nodeID".
+func (builder *Builder) blankNode(nodeId string) Term {
+	if node, found := builder.blankNodes[nodeId]; found == true {
+		return node
+	}
+
+	node := builder.graph.NewBlankNode()
+	builder.blankNodes[nodeId] = node
+
+	return node
+}
+
+// isPropertyAttribute returns true if the attribute describes a property
+// rather than being syntax.
+func isPropertyAttribute(name xml.Name) bool {
+	if name.Space == "" || name.Space == "xmlns" || name.Space == XmlUri {
+		return false
+	}
+
+	if name.Space == RdfUri {
+		switch name.Local {
+		case "about", "ID", "nodeID", "resource", "parseType", "datatype", "aboutEach", "aboutEachPrefix", "bagID":
+			return false
+		}
+	}
+
+	return true
+}
+
+// addPropertyAttributes adds a triple for each property attribute.
+func (builder *Builder) addPropertyAttributes(subject Term, t xml.StartElement, language string) {
+	for _, attribute := range t.Attr {
+		if attribute.Name == rdfTypeName {
+			builder.graph.addAttribute(subject, RdfType, NewIri(attribute.Value))
+		} else if isPropertyAttribute(attribute.Name) == true {
+			builder.graph.addAttribute(subject, builder.graph.nameIri(attribute.Name), NewLiteral(attribute.Value, language, ""))
+		}
+	}
+}
+
+// startNode processes a node element. If parent is not nil, the node is the
+// object of that property.
+func (builder *Builder) startNode(t xml.StartElement, parent *builderFrame) {
+	language := builder.language(t)
+
+	var subject Term
+	found := false
+
+	for _, attribute := range t.Attr {
+		switch attribute.Name {
+		case rdfAboutName:
+			subject = NewIri(attribute.Value)
+			found = true
+		case rdfIdName:
+			subject = NewIri("#" + attribute.Value)
+			found = true
+		case rdfNodeIdName:
+			subject = builder.blankNode(attribute.Value)
+			found = true
+		}
+	}
+
+	if found == false {
+		subject = builder.graph.NewBlankNode()
+	}
+
+	if parent != nil {
+		builder.graph.Add(parent.subject, parent.predicate, subject)
+	}
+
+	if t.Name != rdfDescriptionTag {
+		// A typed node element is equivalent to an "rdf:type" attribute.
+		builder.graph.addAttribute(subject, RdfType, builder.graph.nameIri(t.Name))
+	}
+
+	builder.declareLanguage(subject, t)
+
+	builder.addPropertyAttributes(subject, t, language)
+
+	frame := &builderFrame{
+		kind:     frameNode,
+		subject:  subject,
+		language: language,
+	}
+
+	builder.push(frame)
+}
+
+// startProperty processes a property element of the given node.
+func (builder *Builder) startProperty(t xml.StartElement, node *builderFrame) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	language := builder.language(t)
+
+	var predicate Term
+	if t.Name == rdfLiTag {
+		node.memberCount++
+		predicate = MemberPredicate(node.memberCount)
+	} else {
+		predicate = builder.graph.nameIri(t.Name)
+	}
+
+	var parseType, resource, nodeId, datatype string
+	var hasResource, hasNodeId, hasPropertyAttributes bool
+
+	for _, attribute := range t.Attr {
+		switch attribute.Name {
+		case rdfParseTypeName:
+			parseType = attribute.Value
+		case rdfResourceName:
+			resource = attribute.Value
+			hasResource = true
+		case rdfNodeIdName:
+			nodeId = attribute.Value
+			hasNodeId = true
+		case rdfDatatypeName:
+			datatype = attribute.Value
+		default:
+			if attribute.Name == rdfTypeName || isPropertyAttribute(attribute.Name) == true {
+				hasPropertyAttributes = true
+			}
+		}
+	}
+
+	if parseType == "Resource" {
+		object := builder.graph.NewBlankNode()
+		builder.graph.Add(node.subject, predicate, object)
+		builder.declareLanguage(object, t)
+
+		frame := &builderFrame{
+			kind:     frameNode,
+			subject:  object,
+			language: language,
+		}
+
+		builder.push(frame)
+
+		return nil
+	} else if parseType != "" {
+		// "Literal" and any unknown parse-type are treated as literals.
+
+		b := new(bytes.Buffer)
+
+		frame := &builderFrame{
+			kind:           frameLiteral,
+			subject:        node.subject,
+			predicate:      predicate,
+			language:       language,
+			literalBuffer:  b,
+			literalEncoder: xml.NewEncoder(b),
+		}
+
+		builder.push(frame)
+
+		return nil
+	} else if hasResource == true || hasNodeId == true || hasPropertyAttributes == true {
+		var object Term
+		if hasResource == true {
+			object = NewIri(resource)
+		} else if hasNodeId == true {
+			object = builder.blankNode(nodeId)
+		} else {
+			object = builder.graph.NewBlankNode()
+		}
+
+		builder.graph.Add(node.subject, predicate, object)
+		builder.declareLanguage(object, t)
+		builder.addPropertyAttributes(object, t, language)
+
+		frame := &builderFrame{
+			kind:     frameEmptyProperty,
+			language: language,
+		}
+
+		// Strictly, a property element having property attributes is empty.
+		// XMP writers put the remaining fields of the struct in child
+		// elements, though, so they are read as further properties of the
+		// same node.
+		if hasResource == false && hasNodeId == false {
+			frame.kind = frameNode
+			frame.subject = object
+		}
+
+		builder.push(frame)
+
+		return nil
+	}
+
+	frame := &builderFrame{
+		kind:      frameProperty,
+		subject:   node.subject,
+		predicate: predicate,
+		language:  language,
+		datatype:  datatype,
+		text:      new(strings.Builder),
+	}
+
+	builder.push(frame)
+
+	return nil
+}
+
+func (builder *Builder) processStartElement(t xml.StartElement) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	top := builder.top()
+
+	if top == nil || top.kind == frameIgnore {
+		kind := frameIgnore
+		if t.Name == rdfTag {
+			kind = frameRdf
+		}
+
+		frame := &builderFrame{
+			kind:     kind,
+			language: builder.language(t),
+		}
+
+		builder.push(frame)
+
+		return nil
+	}
+
+	switch top.kind {
+	case frameRdf:
+		builder.startNode(t, nil)
+	case frameNode:
+		err := builder.startProperty(t, top)
+		log.PanicIf(err)
+	case frameProperty:
+		if top.hasObject == true {
+			builderLogger.Warningf(nil, "Property element has more than one node: [%s]", top.predicate)
+		}
+
+		top.hasObject = true
+		builder.startNode(t, top)
+	case frameEmptyProperty:
+		builderLogger.Warningf(nil, "Ignoring content of empty property element: [%s] [%s]", t.Name.Space, t.Name.Local)
+
+		builder.push(&builderFrame{kind: frameIgnore})
+	case frameLiteral:
+		err := top.literalEncoder.EncodeToken(t.Copy())
+		log.PanicIf(err)
+
+		top.literalDepth++
+	default:
+		log.Panicf("frame kind not handled: [%s]", top.kind)
+	}
+
+	return nil
+}
+
+func (builder *Builder) processEndElement(t xml.EndElement) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	top := builder.top()
+	if top == nil {
+		// The decoder guarantees balance. This only happens if we were given
+		// a partial stream.
+
+		return nil
+	}
+
+	if top.kind == frameLiteral && top.literalDepth > 0 {
+		err := top.literalEncoder.EncodeToken(t)
+		log.PanicIf(err)
+
+		top.literalDepth--
+
+		return nil
+	}
+
+	builder.stack = builder.stack[:len(builder.stack)-1]
+
+	switch top.kind {
+	case frameProperty:
+		if top.hasObject == false {
+			object := NewLiteral(strings.TrimSpace(top.text.String()), top.language, top.datatype)
+			builder.graph.Add(top.subject, top.predicate, object)
+		}
+	case frameLiteral:
+		err := top.literalEncoder.Flush()
+		log.PanicIf(err)
+
+		object := NewLiteral(top.literalBuffer.String(), "", XmlLiteralDatatype)
+		builder.graph.Add(top.subject, top.predicate, object)
+	}
+
+	return nil
+}
+
+func (builder *Builder) processCharData(t xml.CharData) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	top := builder.top()
+	if top == nil {
+		return nil
+	}
+
+	switch top.kind {
+	case frameProperty:
+		top.text.Write(t)
+	case frameLiteral:
+		err := top.literalEncoder.EncodeToken(t.Copy())
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+// Process processes the next token of the document.
+func (builder *Builder) Process(token xml.Token) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	switch t := token.(type) {
+	case xml.StartElement:
+		err := builder.processStartElement(t)
+		log.PanicIf(err)
+	case xml.EndElement:
+		err := builder.processEndElement(t)
+		log.PanicIf(err)
+	case xml.CharData:
+		err := builder.processCharData(t)
+		log.PanicIf(err)
+	}
+
+	return nil
+}
+
+// ParseGraph reads an entire RDF/XML document into a new graph.
+func ParseGraph(r io.Reader) (graph *Graph, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	graph = NewGraph()
+	builder := NewBuilder(graph)
+
+	xd := xml.NewDecoder(r)
+
+	for {
+		token, err := xd.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			log.Panic(err)
+		}
+
+		err = builder.Process(token)
+		log.PanicIf(err)
+	}
+
+	return graph, nil
+}
+
+// String returns a string representation of the frame kind. Supports
+// debugging.
+func (kind frameKind) String() string {
+	switch kind {
+	case frameIgnore:
+		return "ignore"
+	case frameRdf:
+		return "rdf"
+	case frameNode:
+		return "node"
+	case frameProperty:
+		return "property"
+	case frameEmptyProperty:
+		return "empty-property"
+	case frameLiteral:
+		return "literal"
+	}
+
+	return fmt.Sprintf("frameKind<%d>", int(kind))
+}
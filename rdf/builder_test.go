@@ -0,0 +1,237 @@
+package xmprdf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func parseTestGraph(body string) *Graph {
+	document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+           xmlns:dc="http://purl.org/dc/elements/1.1/"
+           xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+           xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/"
+           xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#">
+` + body + `
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	graph, err := ParseGraph(strings.NewReader(document))
+	log.PanicIf(err)
+
+	return graph
+}
+
+func getTriplePhrases(graph *Graph) []string {
+	phrases := make([]string, graph.Len())
+	for i, triple := range graph.Triples() {
+		phrases[i] = triple.String()
+	}
+
+	return phrases
+}
+
+func checkTriples(t *testing.T, graph *Graph, expected []string) {
+	actual := getTriplePhrases(graph)
+
+	if reflect.DeepEqual(actual, expected) != true {
+		t.Fatalf("Triples not correct:\n%s\n\nExpected:\n%s", strings.Join(actual, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestParseGraph_DescriptionAttributes(t *testing.T) {
+	graph := parseTestGraph(`<rdf:Description rdf:about="" dc:format="image/jpeg" xmp:Rating="3"/>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://purl.org/dc/elements/1.1/format> "image/jpeg" .`,
+		`<> <http://ns.adobe.com/xap/1.0/Rating> "3" .`,
+	})
+}
+
+func TestParseGraph_PropertyElements(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <xmp:Label>some label</xmp:Label>
+  <xmp:Rating rdf:datatype="http://www.w3.org/2001/XMLSchema#integer">3</xmp:Rating>
+</rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://ns.adobe.com/xap/1.0/Label> "some label" .`,
+		`<> <http://ns.adobe.com/xap/1.0/Rating> "3"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+	})
+}
+
+func TestParseGraph_Containers(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <dc:title>
+    <rdf:Alt>
+      <rdf:li xml:lang="x-default">default title</rdf:li>
+      <rdf:li xml:lang="de">Titel</rdf:li>
+    </rdf:Alt>
+  </dc:title>
+</rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://purl.org/dc/elements/1.1/title> _:b1 .`,
+		`_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/1999/02/22-rdf-syntax-ns#Alt> .`,
+		`_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#_1> "default title"@x-default .`,
+		`_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#_2> "Titel"@de .`,
+	})
+}
+
+func TestParseGraph_ParseTypeResource(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <xmpMM:DerivedFrom rdf:parseType="Resource">
+    <stRef:documentID>xmp.did:1</stRef:documentID>
+  </xmpMM:DerivedFrom>
+</rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://ns.adobe.com/xap/1.0/mm/DerivedFrom> _:b1 .`,
+		`_:b1 <http://ns.adobe.com/xap/1.0/sType/ResourceRef#documentID> "xmp.did:1" .`,
+	})
+}
+
+func TestParseGraph_EmptyPropertyWithAttributes(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <xmpMM:DerivedFrom stRef:documentID="xmp.did:1" stRef:instanceID="xmp.iid:1"/>
+</rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://ns.adobe.com/xap/1.0/mm/DerivedFrom> _:b1 .`,
+		`_:b1 <http://ns.adobe.com/xap/1.0/sType/ResourceRef#documentID> "xmp.did:1" .`,
+		`_:b1 <http://ns.adobe.com/xap/1.0/sType/ResourceRef#instanceID> "xmp.iid:1" .`,
+	})
+}
+
+func TestParseGraph_PropertyAttributesAndElements(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <xmpMM:DerivedFrom stRef:documentID="xmp.did:1">
+    <stRef:instanceID>xmp.iid:1</stRef:instanceID>
+  </xmpMM:DerivedFrom>
+</rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://ns.adobe.com/xap/1.0/mm/DerivedFrom> _:b1 .`,
+		`_:b1 <http://ns.adobe.com/xap/1.0/sType/ResourceRef#documentID> "xmp.did:1" .`,
+		`_:b1 <http://ns.adobe.com/xap/1.0/sType/ResourceRef#instanceID> "xmp.iid:1" .`,
+	})
+}
+
+func TestParseGraph_ResourceAndNodeId(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <xmp:BaseURL rdf:resource="http://some/url"/>
+  <xmpMM:DerivedFrom rdf:nodeID="ref"/>
+</rdf:Description>
+<rdf:Description rdf:nodeID="ref" stRef:documentID="xmp.did:1"/>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://ns.adobe.com/xap/1.0/BaseURL> <http://some/url> .`,
+		`<> <http://ns.adobe.com/xap/1.0/mm/DerivedFrom> _:b1 .`,
+		`_:b1 <http://ns.adobe.com/xap/1.0/sType/ResourceRef#documentID> "xmp.did:1" .`,
+	})
+}
+
+func TestParseGraph_Qualifiers(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <dc:creator>
+    <rdf:Seq>
+      <rdf:li rdf:parseType="Resource">
+        <rdf:value>Some Author</rdf:value>
+        <xmp:Label>qualifier</xmp:Label>
+      </rdf:li>
+    </rdf:Seq>
+  </dc:creator>
+</rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://purl.org/dc/elements/1.1/creator> _:b1 .`,
+		`_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/1999/02/22-rdf-syntax-ns#Seq> .`,
+		`_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#_1> _:b2 .`,
+		`_:b2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#value> "Some Author" .`,
+		`_:b2 <http://ns.adobe.com/xap/1.0/Label> "qualifier" .`,
+	})
+}
+
+func TestParseGraph_ParseTypeLiteral(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <xmp:Label rdf:parseType="Literal">some <b>bold</b> text</xmp:Label>
+</rdf:Description>`)
+
+	triples := graph.Triples()
+
+	if len(triples) != 1 {
+		t.Fatalf("Expected one triple: %v", getTriplePhrases(graph))
+	}
+
+	object := triples[0].Object
+
+	if object.Datatype != XmlLiteralDatatype {
+		t.Fatalf("Datatype not correct: [%s]", object.Datatype)
+	} else if object.Value != "some <b>bold</b> text" {
+		t.Fatalf("Literal not correct: [%s]", object.Value)
+	}
+}
+
+func TestParseGraph_TypedNodeAndInheritedLanguage(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="" xml:lang="fr">
+  <xmp:Label>étiquette</xmp:Label>
+  <xmp:Thumbnails>
+    <xmp:Thumbnail xmp:Format="JPEG"/>
+  </xmp:Thumbnails>
+</rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://ns.adobe.com/xap/1.0/Label> "étiquette"@fr .`,
+		`<> <http://ns.adobe.com/xap/1.0/Thumbnails> _:b1 .`,
+		`_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://ns.adobe.com/xap/1.0/Thumbnail> .`,
+		`_:b1 <http://ns.adobe.com/xap/1.0/Format> "JPEG"@fr .`,
+	})
+}
+
+func TestParseGraph_OutsideRdfIgnored(t *testing.T) {
+	graph, err := ParseGraph(strings.NewReader(`<root><xmp:Label xmlns:xmp="http://ns.adobe.com/xap/1.0/">ignored</xmp:Label></root>`))
+	log.PanicIf(err)
+
+	if graph.Len() != 0 {
+		t.Fatalf("Expected no triples: %v", getTriplePhrases(graph))
+	}
+}
+
+func TestParseGraph_MultipleDescriptions(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="" dc:format="image/jpeg"/>
+<rdf:Description rdf:about=""><xmp:Label>some label</xmp:Label></rdf:Description>`)
+
+	checkTriples(t, graph, []string{
+		`<> <http://purl.org/dc/elements/1.1/format> "image/jpeg" .`,
+		`<> <http://ns.adobe.com/xap/1.0/Label> "some label" .`,
+	})
+}
+
+func TestBuilder_Process_PartialStream(t *testing.T) {
+	graph := NewGraph()
+	builder := NewBuilder(graph)
+
+	err := builder.Process(xml.EndElement{Name: rdfTag})
+	log.PanicIf(err)
+
+	if builder.Graph() != graph {
+		t.Fatalf("Graph not correct.")
+	} else if graph.Len() != 0 {
+		t.Fatalf("Expected no triples.")
+	}
+}
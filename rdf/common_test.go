@@ -0,0 +1,48 @@
+package xmprdf
+
+import (
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+const (
+	testDcUri  = "http://purl.org/dc/elements/1.1/"
+	testXmpUri = "http://ns.adobe.com/xap/1.0/"
+)
+
+func registerTestNamespaces() {
+	xmpregistry.Clear()
+
+	xmpregistry.Register(xmpregistry.Namespace{Uri: testDcUri, PreferredPrefix: "dc"})
+	xmpregistry.Register(xmpregistry.Namespace{Uri: testXmpUri, PreferredPrefix: "xmp"})
+}
+
+// getTestRegistry returns a new registry having the namespaces of the test
+// graphs.
+func getTestRegistry() *xmpregistry.Registry {
+	registry := xmpregistry.NewRegistry()
+
+	err := registry.Register(xmpregistry.Namespace{Uri: testDcUri, PreferredPrefix: "dc"})
+	log.PanicIf(err)
+
+	err = registry.Register(xmpregistry.Namespace{Uri: testXmpUri, PreferredPrefix: "xmp"})
+	log.PanicIf(err)
+
+	return registry
+}
+
+// getTestExportGraph returns a graph with a literal, a language-tagged
+// container, and a reference.
+func getTestExportGraph() *Graph {
+	return parseTestGraph(`
+<rdf:Description rdf:about="" dc:format="image/jpeg">
+  <dc:title>
+    <rdf:Alt>
+      <rdf:li xml:lang="x-default">some "title"</rdf:li>
+    </rdf:Alt>
+  </dc:title>
+  <xmp:BaseURL rdf:resource="http://some/url"/>
+  <xmp:Rating rdf:datatype="http://www.w3.org/2001/XMLSchema#integer">3</xmp:Rating>
+</rdf:Description>`)
+}
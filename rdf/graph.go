@@ -0,0 +1,416 @@
+package xmprdf
+
+import (
+	"fmt"
+	"strings"
+
+	"encoding/xml"
+	"net/url"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// RdfUri is the RDF syntax namespace URI.
+	RdfUri = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+
+	// XmlUri is the namespace URI of the "xml" prefix.
+	XmlUri = "http://www.w3.org/XML/1998/namespace"
+
+	// XmlLiteralDatatype is the datatype of "rdf:parseType='Literal'"
+	// properties.
+	XmlLiteralDatatype = RdfUri + "XMLLiteral"
+)
+
+var (
+	// RdfType is the "rdf:type" predicate.
+	RdfType = NewIri(RdfUri + "type")
+
+	// RdfValue is the "rdf:value" predicate.
+	RdfValue = NewIri(RdfUri + "value")
+)
+
+// TermKind describes the kind of a term.
+type TermKind int
+
+const (
+	// TermIri is a term identified by an IRI.
+	TermIri TermKind = iota
+
+	// TermBlankNode is an unnamed node identified only within its graph.
+	TermBlankNode
+
+	// TermLiteral is a literal value.
+	TermLiteral
+)
+
+// String returns a string representation of the kind.
+func (kind TermKind) String() string {
+	switch kind {
+	case TermIri:
+		return "iri"
+	case TermBlankNode:
+		return "blank"
+	case TermLiteral:
+		return "literal"
+	}
+
+	return fmt.Sprintf("TermKind<%d>", int(kind))
+}
+
+// Term is a subject, predicate, or object.
+type Term struct {
+	// Kind is the kind of the term.
+	Kind TermKind
+
+	// Value is the IRI, the blank-node ID, or the lexical form of the
+	// literal.
+	Value string
+
+	// Language is the language tag of a literal, if any.
+	Language string
+
+	// Datatype is the datatype IRI of a literal, if any.
+	Datatype string
+}
+
+// NewIri returns a new IRI term.
+func NewIri(iri string) Term {
+	return Term{
+		Kind:  TermIri,
+		Value: iri,
+	}
+}
+
+// NewBlankNode returns a new blank-node term.
+func NewBlankNode(id string) Term {
+	return Term{
+		Kind:  TermBlankNode,
+		Value: id,
+	}
+}
+
+// NewLiteral returns a new literal term. The language and datatype may be
+// empty.
+func NewLiteral(value, language, datatype string) Term {
+	return Term{
+		Kind:     TermLiteral,
+		Value:    value,
+		Language: language,
+		Datatype: datatype,
+	}
+}
+
+// IsIri returns true if the term is an IRI.
+func (term Term) IsIri() bool {
+	return term.Kind == TermIri
+}
+
+// IsBlankNode returns true if the term is a blank-node.
+func (term Term) IsBlankNode() bool {
+	return term.Kind == TermBlankNode
+}
+
+// IsLiteral returns true if the term is a literal.
+func (term Term) IsLiteral() bool {
+	return term.Kind == TermLiteral
+}
+
+// escapeString escapes a string for inclusion in double-quotes in N-Triples.
+// Characters having a short escape (ECHAR) use it and the remaining control
+// characters are written as "\u" escapes (UCHAR).
+func escapeString(s string) string {
+	b := new(strings.Builder)
+
+	for _, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString("\\\\")
+		case '"':
+			b.WriteString("\\\"")
+		case '\'':
+			b.WriteString("\\'")
+		case '\n':
+			b.WriteString("\\n")
+		case '\r':
+			b.WriteString("\\r")
+		case '\t':
+			b.WriteString("\\t")
+		case '\b':
+			b.WriteString("\\b")
+		case '\f':
+			b.WriteString("\\f")
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(b, "\\u%04X", r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// escapeIri escapes the characters that may not appear in an IRI in
+// N-Triples as "\u" escapes (UCHAR).
+func escapeIri(iri string) string {
+	b := new(strings.Builder)
+
+	for _, r := range iri {
+		if r <= 0x20 || strings.ContainsRune("<>\"{}|^`\\", r) == true {
+			fmt.Fprintf(b, "\\u%04X", r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// Resolve returns the term with a relative IRI resolved against the given
+// base IRI. Other terms are returned unchanged.
+func (term Term) Resolve(base *url.URL) (resolved Term, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if term.Kind != TermIri {
+		return term, nil
+	}
+
+	reference, err := url.Parse(term.Value)
+	log.PanicIf(err)
+
+	if reference.IsAbs() == true {
+		return term, nil
+	}
+
+	return NewIri(base.ResolveReference(reference).String()), nil
+}
+
+// String returns the term in N-Triples form. IRIs are written as they are, so
+// relative IRIs must be resolved first (see Resolve) for the result to be
+// valid N-Triples.
+func (term Term) String() string {
+	switch term.Kind {
+	case TermIri:
+		return "<" + escapeIri(term.Value) + ">"
+	case TermBlankNode:
+		return "_:" + term.Value
+	}
+
+	literal := "\"" + escapeString(term.Value) + "\""
+
+	if term.Language != "" {
+		return literal + "@" + term.Language
+	} else if term.Datatype != "" {
+		return literal + "^^<" + escapeIri(term.Datatype) + ">"
+	}
+
+	return literal
+}
+
+// Triple is a single statement.
+type Triple struct {
+	Subject   Term
+	Predicate Term
+	Object    Term
+}
+
+// String returns the triple in N-Triples form (without the newline).
+func (triple Triple) String() string {
+	return fmt.Sprintf("%s %s %s .", triple.Subject, triple.Predicate, triple.Object)
+}
+
+// Graph is an ordered collection of triples. Triples are kept in the order
+// that they were added, which is document order for parsed graphs.
+type Graph struct {
+	triples []Triple
+
+	// blankNodeCount is used to allocate blank-node IDs.
+	blankNodeCount int
+
+	// names maps the IRIs of predicates and types to the element or attribute
+	// names that they were written with, so that the graph can be written
+	// with the same names again.
+	names map[string]xml.Name
+
+	// attributeTriples are the triples that were written as property
+	// attributes rather than property elements.
+	attributeTriples map[Triple]bool
+
+	// languages are the "xml:lang" declared by the elements that described
+	// nodes.
+	languages map[Term]string
+}
+
+// NewGraph returns a new, empty graph.
+func NewGraph() *Graph {
+	return &Graph{
+		triples:          make([]Triple, 0),
+		names:            make(map[string]xml.Name),
+		attributeTriples: make(map[Triple]bool),
+		languages:        make(map[Term]string),
+	}
+}
+
+// Add adds a triple.
+func (graph *Graph) Add(subject, predicate, object Term) {
+	triple := Triple{
+		Subject:   subject,
+		Predicate: predicate,
+		Object:    object,
+	}
+
+	graph.triples = append(graph.triples, triple)
+}
+
+// addAttribute adds a triple that was written as a property attribute.
+func (graph *Graph) addAttribute(subject, predicate, object Term) {
+	graph.Add(subject, predicate, object)
+
+	triple := Triple{
+		Subject:   subject,
+		Predicate: predicate,
+		Object:    object,
+	}
+
+	graph.attributeTriples[triple] = true
+}
+
+// nameIri returns the IRI of the given name and remembers the name that it
+// was written with.
+func (graph *Graph) nameIri(name xml.Name) Term {
+	iri := name.Space + name.Local
+	graph.names[iri] = name
+
+	return NewIri(iri)
+}
+
+// Since returns a graph having the triples that were added after the first
+// count triples. It shares the blank-nodes and the names of this graph.
+func (graph *Graph) Since(count int) *Graph {
+	triples := make([]Triple, len(graph.triples)-count)
+	copy(triples, graph.triples[count:])
+
+	since := &Graph{
+		triples:          triples,
+		blankNodeCount:   graph.blankNodeCount,
+		names:            graph.names,
+		attributeTriples: graph.attributeTriples,
+		languages:        graph.languages,
+	}
+
+	return since
+}
+
+// NewBlankNode allocates a blank-node having an ID that is unique within
+// the graph.
+func (graph *Graph) NewBlankNode() Term {
+	graph.blankNodeCount++
+	return NewBlankNode(fmt.Sprintf("b%d", graph.blankNodeCount))
+}
+
+// Triples returns all triples in the order that they were added.
+func (graph *Graph) Triples() []Triple {
+	return graph.triples
+}
+
+// Len returns the number of triples.
+func (graph *Graph) Len() int {
+	return len(graph.triples)
+}
+
+// Match returns all triples matching the given terms. A nil term matches
+// anything.
+func (graph *Graph) Match(subject, predicate, object *Term) (matches []Triple) {
+	matches = make([]Triple, 0)
+
+	for _, triple := range graph.triples {
+		if subject != nil && triple.Subject != *subject {
+			continue
+		} else if predicate != nil && triple.Predicate != *predicate {
+			continue
+		} else if object != nil && triple.Object != *object {
+			continue
+		}
+
+		matches = append(matches, triple)
+	}
+
+	return matches
+}
+
+// Objects returns the objects of all triples having the given subject and
+// predicate.
+func (graph *Graph) Objects(subject, predicate Term) (objects []Term) {
+	objects = make([]Term, 0)
+
+	for _, triple := range graph.Match(&subject, &predicate, nil) {
+		objects = append(objects, triple.Object)
+	}
+
+	return objects
+}
+
+// Subjects returns the distinct subjects in the order that they were first
+// seen.
+func (graph *Graph) Subjects() (subjects []Term) {
+	subjects = make([]Term, 0)
+	seen := make(map[Term]bool)
+
+	for _, triple := range graph.triples {
+		if seen[triple.Subject] == true {
+			continue
+		}
+
+		seen[triple.Subject] = true
+		subjects = append(subjects, triple.Subject)
+	}
+
+	return subjects
+}
+
+// IsContainer returns true if the given node is typed as an RDF Bag, Seq, or
+// Alt. The local name of the container type is returned.
+func (graph *Graph) IsContainer(node Term) (containerType string, ok bool) {
+	for _, object := range graph.Objects(node, RdfType) {
+		if object.IsIri() == false || strings.HasPrefix(object.Value, RdfUri) == false {
+			continue
+		}
+
+		local := object.Value[len(RdfUri):]
+
+		if local == "Bag" || local == "Seq" || local == "Alt" {
+			return local, true
+		}
+	}
+
+	return "", false
+}
+
+// ContainerMembers returns the members of a container in order. Members are
+// described by the "rdf:_n" predicates.
+func (graph *Graph) ContainerMembers(node Term) (members []Term) {
+	members = make([]Term, 0)
+
+	for i := 1; ; i++ {
+		objects := graph.Objects(node, MemberPredicate(i))
+		if len(objects) == 0 {
+			break
+		}
+
+		members = append(members, objects...)
+	}
+
+	return members
+}
+
+// MemberPredicate returns the "rdf:_n" predicate for the nth (one-based)
+// member of a container.
+func MemberPredicate(n int) Term {
+	return NewIri(fmt.Sprintf("%s_%d", RdfUri, n))
+}
@@ -0,0 +1,164 @@
+package xmprdf
+
+import (
+	"reflect"
+	"testing"
+
+	"net/url"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestTerm_String(t *testing.T) {
+	testCases := []struct {
+		term     Term
+		expected string
+	}{
+		{NewIri("http://some/iri"), "<http://some/iri>"},
+		{NewBlankNode("b1"), "_:b1"},
+		{NewLiteral("some value", "", ""), `"some value"`},
+		{NewLiteral("some value", "en", ""), `"some value"@en`},
+		{NewLiteral("1", "", "http://www.w3.org/2001/XMLSchema#integer"), `"1"^^<http://www.w3.org/2001/XMLSchema#integer>`},
+		{NewLiteral("a \"b\"\n\\c\x01", "", ""), `"a \"b\"\n\\c\u0001"`},
+		{NewLiteral("'a'\b\f\t\r\x7f", "", ""), `"\'a\'\b\f\t\r\u007F"`},
+		{NewIri("http://some/iri?a=<b> c{d}|^`\\\""), `<http://some/iri?a=\u003Cb\u003E\u0020c\u007Bd\u007D\u007C\u005E\u0060\u005C\u0022>`},
+	}
+
+	for _, tc := range testCases {
+		if tc.term.String() != tc.expected {
+			t.Fatalf("String not correct: [%s] != [%s]", tc.term.String(), tc.expected)
+		}
+	}
+}
+
+func TestTerm_Resolve(t *testing.T) {
+	base, err := url.Parse("http://some/path/image.jpg")
+	log.PanicIf(err)
+
+	testCases := []struct {
+		term     Term
+		expected Term
+	}{
+		{NewIri(""), NewIri("http://some/path/image.jpg")},
+		{NewIri("other.jpg"), NewIri("http://some/path/other.jpg")},
+		{NewIri("http://other/iri"), NewIri("http://other/iri")},
+		{NewBlankNode("b1"), NewBlankNode("b1")},
+		{NewLiteral("other.jpg", "", ""), NewLiteral("other.jpg", "", "")},
+	}
+
+	for _, tc := range testCases {
+		resolved, err := tc.term.Resolve(base)
+		log.PanicIf(err)
+
+		if resolved != tc.expected {
+			t.Fatalf("Resolved term not correct: [%s] != [%s]", resolved, tc.expected)
+		}
+	}
+}
+
+func TestTermKind_String(t *testing.T) {
+	if TermLiteral.String() != "literal" {
+		t.Fatalf("String not correct: [%s]", TermLiteral.String())
+	} else if TermKind(99).String() != "TermKind<99>" {
+		t.Fatalf("String not correct for unknown kind: [%s]", TermKind(99).String())
+	}
+}
+
+func TestTriple_String(t *testing.T) {
+	triple := Triple{
+		Subject:   NewIri(""),
+		Predicate: NewIri("http://purl.org/dc/elements/1.1/format"),
+		Object:    NewLiteral("image/jpeg", "", ""),
+	}
+
+	if triple.String() != `<> <http://purl.org/dc/elements/1.1/format> "image/jpeg" .` {
+		t.Fatalf("String not correct: [%s]", triple.String())
+	}
+}
+
+func getTestGraph() *Graph {
+	graph := NewGraph()
+
+	subject := NewIri("")
+	subjectsPredicate := NewIri("http://purl.org/dc/elements/1.1/subject")
+
+	bag := graph.NewBlankNode()
+
+	graph.Add(subject, subjectsPredicate, bag)
+	graph.Add(bag, RdfType, NewIri(RdfUri+"Bag"))
+	graph.Add(bag, MemberPredicate(1), NewLiteral("aa", "", ""))
+	graph.Add(bag, MemberPredicate(2), NewLiteral("bb", "", ""))
+
+	return graph
+}
+
+func TestGraph_NewBlankNode(t *testing.T) {
+	graph := NewGraph()
+
+	if graph.NewBlankNode() != NewBlankNode("b1") {
+		t.Fatalf("First blank-node not correct.")
+	} else if graph.NewBlankNode() != NewBlankNode("b2") {
+		t.Fatalf("Second blank-node not correct.")
+	}
+}
+
+func TestGraph_Match(t *testing.T) {
+	graph := getTestGraph()
+
+	if graph.Len() != 4 {
+		t.Fatalf("Len not correct: (%d)", graph.Len())
+	}
+
+	bag := NewBlankNode("b1")
+
+	matches := graph.Match(&bag, nil, nil)
+	if len(matches) != 3 {
+		t.Fatalf("Expected three matches for subject: (%d)", len(matches))
+	}
+
+	object := NewLiteral("bb", "", "")
+
+	matches = graph.Match(nil, nil, &object)
+	if len(matches) != 1 {
+		t.Fatalf("Expected one match for object: (%d)", len(matches))
+	} else if matches[0].Predicate != MemberPredicate(2) {
+		t.Fatalf("Matched predicate not correct: [%s]", matches[0].Predicate)
+	}
+}
+
+func TestGraph_Subjects(t *testing.T) {
+	graph := getTestGraph()
+
+	expected := []Term{NewIri(""), NewBlankNode("b1")}
+
+	if subjects := graph.Subjects(); reflect.DeepEqual(subjects, expected) != true {
+		t.Fatalf("Subjects not correct: %v", subjects)
+	}
+}
+
+func TestGraph_IsContainer(t *testing.T) {
+	graph := getTestGraph()
+
+	containerType, ok := graph.IsContainer(NewBlankNode("b1"))
+	if ok != true || containerType != "Bag" {
+		t.Fatalf("Container not correct: [%s] (%v)", containerType, ok)
+	}
+
+	_, ok = graph.IsContainer(NewIri(""))
+	if ok != false {
+		t.Fatalf("Expected subject to not be a container.")
+	}
+}
+
+func TestGraph_ContainerMembers(t *testing.T) {
+	graph := getTestGraph()
+
+	expected := []Term{
+		NewLiteral("aa", "", ""),
+		NewLiteral("bb", "", ""),
+	}
+
+	if members := graph.ContainerMembers(NewBlankNode("b1")); reflect.DeepEqual(members, expected) != true {
+		t.Fatalf("Members not correct: %v", members)
+	}
+}
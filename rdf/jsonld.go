@@ -0,0 +1,126 @@
+package xmprdf
+
+import (
+	"io"
+
+	"encoding/json"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// jsonLdIri returns the IRI as a compact IRI if possible.
+func jsonLdIri(iri string, prefixes Prefixes) string {
+	if compacted, ok := prefixes.Compact(iri); ok == true {
+		return compacted
+	}
+
+	return iri
+}
+
+// jsonLdObject returns the JSON-LD value of an object.
+func jsonLdObject(term Term, prefixes Prefixes) interface{} {
+	switch term.Kind {
+	case TermIri:
+		return map[string]interface{}{
+			"@id": jsonLdIri(term.Value, prefixes),
+		}
+	case TermBlankNode:
+		return map[string]interface{}{
+			"@id": "_:" + term.Value,
+		}
+	}
+
+	if term.Language != "" {
+		return map[string]interface{}{
+			"@value":    term.Value,
+			"@language": term.Language,
+		}
+	} else if term.Datatype != "" {
+		return map[string]interface{}{
+			"@value": term.Value,
+			"@type":  jsonLdIri(term.Datatype, prefixes),
+		}
+	}
+
+	return term.Value
+}
+
+// appendJsonLdValue adds a value under the given key, converting to a list
+// when there is more than one.
+func appendJsonLdValue(node map[string]interface{}, key string, value interface{}) {
+	existing, found := node[key]
+	if found == false {
+		node[key] = value
+		return
+	}
+
+	if list, ok := existing.([]interface{}); ok == true {
+		node[key] = append(list, value)
+	} else {
+		node[key] = []interface{}{existing, value}
+	}
+}
+
+// JsonLd returns the graph as a JSON-LD document having a context of the given
+// prefixes and one node object per subject in the order that the subjects
+// were first seen. The prefixes are required (see RegisteredPrefixes); they
+// may be empty to write every IRI in full. Panics with ErrPrefixesNotGiven if
+// they are nil.
+func (graph *Graph) JsonLd(prefixes Prefixes) map[string]interface{} {
+	if prefixes == nil {
+		log.Panic(ErrPrefixesNotGiven)
+	}
+
+	context := make(map[string]interface{})
+	for name, uri := range prefixes {
+		context[name] = uri
+	}
+
+	nodes := make([]interface{}, 0)
+
+	for _, subject := range graph.Subjects() {
+		node := make(map[string]interface{})
+
+		if subject.IsBlankNode() == true {
+			node["@id"] = "_:" + subject.Value
+		} else {
+			node["@id"] = jsonLdIri(subject.Value, prefixes)
+		}
+
+		for _, triple := range graph.Match(&subject, nil, nil) {
+			if triple.Predicate == RdfType && triple.Object.IsIri() == true {
+				appendJsonLdValue(node, "@type", jsonLdIri(triple.Object.Value, prefixes))
+				continue
+			}
+
+			key := jsonLdIri(triple.Predicate.Value, prefixes)
+			appendJsonLdValue(node, key, jsonLdObject(triple.Object, prefixes))
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	document := map[string]interface{}{
+		"@context": context,
+		"@graph":   nodes,
+	}
+
+	return document
+}
+
+// WriteJsonLd writes the graph as indented JSON-LD (see JsonLd).
+func (graph *Graph) WriteJsonLd(w io.Writer, prefixes Prefixes) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	encoded, err := json.MarshalIndent(graph.JsonLd(prefixes), "", "  ")
+	log.PanicIf(err)
+
+	_, err = w.Write(append(encoded, '\n'))
+	log.PanicIf(err)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package xmprdf
+
+import (
+	"bytes"
+	"testing"
+
+	"encoding/json"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestGraph_JsonLd(t *testing.T) {
+	graph := getTestExportGraph()
+	graph.Add(NewIri(""), NewIri(testDcUri+"subject"), NewLiteral("aa", "", ""))
+	graph.Add(NewIri(""), NewIri(testDcUri+"subject"), NewLiteral("bb", "", ""))
+
+	encoded, err := json.Marshal(graph.JsonLd(RegisteredPrefixes(graph, getTestRegistry())))
+	log.PanicIf(err)
+
+	expected := `{` +
+		`"@context":{"dc":"http://purl.org/dc/elements/1.1/","rdf":"http://www.w3.org/1999/02/22-rdf-syntax-ns#","xmp":"http://ns.adobe.com/xap/1.0/"},` +
+		`"@graph":[` +
+		`{"@id":"","dc:format":"image/jpeg","dc:subject":["aa","bb"],"dc:title":{"@id":"_:b1"},"xmp:BaseURL":{"@id":"http://some/url"},"xmp:Rating":{"@type":"http://www.w3.org/2001/XMLSchema#integer","@value":"3"}},` +
+		`{"@id":"_:b1","@type":"rdf:Alt","rdf:_1":{"@language":"x-default","@value":"some \"title\""}}` +
+		`]}`
+
+	if string(encoded) != expected {
+		t.Fatalf("JSON-LD not correct:\n%s", string(encoded))
+	}
+}
+
+func TestGraph_WriteJsonLd(t *testing.T) {
+	graph := NewGraph()
+	graph.Add(NewIri(""), NewIri(testDcUri+"format"), NewLiteral("image/jpeg", "", ""))
+
+	prefixes := Prefixes{
+		"dc": testDcUri,
+	}
+
+	b := new(bytes.Buffer)
+
+	err := graph.WriteJsonLd(b, prefixes)
+	log.PanicIf(err)
+
+	expected := `{
+  "@context": {
+    "dc": "http://purl.org/dc/elements/1.1/"
+  },
+  "@graph": [
+    {
+      "@id": "",
+      "dc:format": "image/jpeg"
+    }
+  ]
+}
+`
+
+	if b.String() != expected {
+		t.Fatalf("JSON-LD not correct:\n%s", b.String())
+	}
+}
+
+func TestGraph_WriteJsonLd_PrefixesNotGiven(t *testing.T) {
+	graph := getTestExportGraph()
+
+	err := graph.WriteJsonLd(new(bytes.Buffer), nil)
+	if err == nil {
+		t.Fatalf("Expected error for missing prefixes.")
+	} else if log.Is(err, ErrPrefixesNotGiven) != true {
+		t.Fatalf("Error not correct: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package xmprdf
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"net/url"
+
+	"github.com/dsoprea/go-logging"
+)
+
+var (
+	// ErrBaseIriNotAbsolute indicates that the base IRI that relative IRIs
+	// are resolved against is not absolute.
+	ErrBaseIriNotAbsolute = errors.New("base IRI not absolute")
+)
+
+// WriteNTriples writes the graph as N-Triples, one triple per line in the
+// order that they were added. Since N-Triples only allows absolute IRIs,
+// relative IRIs (e.g. the empty "rdf:about" of the XMP packet) are resolved
+// against the given base IRI, which must be absolute.
+func (graph *Graph) WriteNTriples(w io.Writer, baseIri string) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	base, err := url.Parse(baseIri)
+	log.PanicIf(err)
+
+	if base.IsAbs() == false {
+		log.Panic(ErrBaseIriNotAbsolute)
+	}
+
+	bw := bufio.NewWriter(w)
+
+	for _, triple := range graph.triples {
+		triple.Subject, err = triple.Subject.Resolve(base)
+		log.PanicIf(err)
+
+		triple.Predicate, err = triple.Predicate.Resolve(base)
+		log.PanicIf(err)
+
+		triple.Object, err = triple.Object.Resolve(base)
+		log.PanicIf(err)
+
+		_, err := bw.WriteString(triple.String() + "\n")
+		log.PanicIf(err)
+	}
+
+	err = bw.Flush()
+	log.PanicIf(err)
+
+	return nil
+}
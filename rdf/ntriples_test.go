@@ -0,0 +1,56 @@
+package xmprdf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestGraph_WriteNTriples(t *testing.T) {
+	graph := getTestExportGraph()
+
+	b := new(bytes.Buffer)
+
+	err := graph.WriteNTriples(b, "file:///some/image.jpg")
+	log.PanicIf(err)
+
+	expected := `<file:///some/image.jpg> <http://purl.org/dc/elements/1.1/format> "image/jpeg" .
+<file:///some/image.jpg> <http://purl.org/dc/elements/1.1/title> _:b1 .
+_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/1999/02/22-rdf-syntax-ns#Alt> .
+_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#_1> "some \"title\""@x-default .
+<file:///some/image.jpg> <http://ns.adobe.com/xap/1.0/BaseURL> <http://some/url> .
+<file:///some/image.jpg> <http://ns.adobe.com/xap/1.0/Rating> "3"^^<http://www.w3.org/2001/XMLSchema#integer> .
+`
+
+	if b.String() != expected {
+		t.Fatalf("N-Triples not correct:\n%s", b.String())
+	}
+}
+
+func TestGraph_WriteNTriples_RelativeReference(t *testing.T) {
+	graph := NewGraph()
+	graph.Add(NewIri("#thumbnail"), NewIri("http://some/predicate"), NewIri("other.jpg"))
+
+	b := new(bytes.Buffer)
+
+	err := graph.WriteNTriples(b, "http://some/path/image.jpg")
+	log.PanicIf(err)
+
+	expected := "<http://some/path/image.jpg#thumbnail> <http://some/predicate> <http://some/path/other.jpg> .\n"
+
+	if b.String() != expected {
+		t.Fatalf("N-Triples not correct:\n%s", b.String())
+	}
+}
+
+func TestGraph_WriteNTriples_BaseNotAbsolute(t *testing.T) {
+	graph := getTestExportGraph()
+
+	err := graph.WriteNTriples(new(bytes.Buffer), "")
+	if err == nil {
+		t.Fatalf("Expected error for relative base IRI.")
+	} else if log.Is(err, ErrBaseIriNotAbsolute) != true {
+		t.Fatalf("Error not correct: [%v]", err)
+	}
+}
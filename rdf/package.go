@@ -0,0 +1,7 @@
+// Package xmprdf describes the RDF graph of an XMP document: subjects,
+// predicates, and IRI, blank-node, or literal objects. The graph is populated
+// from the RDF/XML token stream first, and the property index is built from
+// the graph as it is written back as RDF/XML (see RdfXmlTokens). The graph
+// keeps how each triple was written (names, attributes, and languages) so that
+// the index has the same shape as the document.
+package xmprdf
@@ -0,0 +1,104 @@
+package xmprdf
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+var (
+	// ErrPrefixesNotGiven indicates that a graph was written without the
+	// prefixes to compact its IRIs with. They are usually those of the registry
+	// that the graph was parsed with (see RegisteredPrefixes).
+	ErrPrefixesNotGiven = errors.New("prefixes not given")
+)
+
+var (
+	// localNameRe matches local names that can be written as prefixed names
+	// in both Turtle and JSON-LD without escaping.
+	localNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+)
+
+// Prefixes maps prefixes to namespace URIs.
+type Prefixes map[string]string
+
+// splitIri splits an IRI after the last "#" or "/".
+func splitIri(iri string) (namespaceUri, local string) {
+	i := strings.LastIndexAny(iri, "#/")
+	if i == -1 {
+		return "", iri
+	}
+
+	return iri[:i+1], iri[i+1:]
+}
+
+// RegisteredPrefixes returns the preferred prefixes of the namespaces
+// registered with the given registry that are used by the predicates and
+// types of the graph. The RDF namespace is always included.
+func RegisteredPrefixes(graph *Graph, registry *xmpregistry.Registry) Prefixes {
+	prefixes := Prefixes{
+		"rdf": RdfUri,
+	}
+
+	add := func(term Term) {
+		if term.IsIri() == false {
+			return
+		}
+
+		namespaceUri, _ := splitIri(term.Value)
+		if namespaceUri == "" {
+			return
+		}
+
+		namespace, err := registry.Get(namespaceUri)
+		if err != nil || namespace.PreferredPrefix == "" {
+			return
+		}
+
+		if _, found := prefixes[namespace.PreferredPrefix]; found == false {
+			prefixes[namespace.PreferredPrefix] = namespaceUri
+		}
+	}
+
+	for _, triple := range graph.Triples() {
+		add(triple.Predicate)
+
+		if triple.Predicate == RdfType {
+			add(triple.Object)
+		}
+	}
+
+	return prefixes
+}
+
+// Names returns the prefixes in sorted order.
+func (prefixes Prefixes) Names() []string {
+	names := make([]string, 0, len(prefixes))
+	for name := range prefixes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Compact returns the IRI as a prefixed name ("prefix:local") if one of the
+// prefixes applies.
+func (prefixes Prefixes) Compact(iri string) (compacted string, ok bool) {
+	namespaceUri, local := splitIri(iri)
+	if namespaceUri == "" || localNameRe.MatchString(local) == false {
+		return "", false
+	}
+
+	for _, name := range prefixes.Names() {
+		if prefixes[name] == namespaceUri {
+			return name + ":" + local, true
+		}
+	}
+
+	return "", false
+}
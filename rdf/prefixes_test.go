@@ -0,0 +1,77 @@
+package xmprdf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestRegisteredPrefixes(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+
+	graph := getTestExportGraph()
+	graph.Add(NewIri(""), NewIri("http://unregistered/Field"), NewLiteral("value", "", ""))
+
+	expected := Prefixes{
+		"dc":  testDcUri,
+		"rdf": RdfUri,
+		"xmp": testXmpUri,
+	}
+
+	if prefixes := RegisteredPrefixes(graph, xmpregistry.Default()); reflect.DeepEqual(prefixes, expected) != true {
+		t.Fatalf("Prefixes not correct: %v", prefixes)
+	}
+}
+
+func TestRegisteredPrefixes_CustomRegistry(t *testing.T) {
+	xmpregistry.Clear()
+
+	registry := xmpregistry.NewRegistry()
+
+	err := registry.Register(xmpregistry.Namespace{Uri: testDcUri, PreferredPrefix: "dc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graph := getTestExportGraph()
+
+	expected := Prefixes{
+		"dc":  testDcUri,
+		"rdf": RdfUri,
+	}
+
+	if prefixes := RegisteredPrefixes(graph, registry); reflect.DeepEqual(prefixes, expected) != true {
+		t.Fatalf("Prefixes not correct: %v", prefixes)
+	}
+}
+
+func TestPrefixes_Names(t *testing.T) {
+	prefixes := Prefixes{
+		"xmp": testXmpUri,
+		"dc":  testDcUri,
+	}
+
+	if names := prefixes.Names(); reflect.DeepEqual(names, []string{"dc", "xmp"}) != true {
+		t.Fatalf("Names not correct: %v", names)
+	}
+}
+
+func TestPrefixes_Compact(t *testing.T) {
+	prefixes := Prefixes{
+		"dc": testDcUri,
+	}
+
+	if compacted, ok := prefixes.Compact(testDcUri + "title"); ok != true || compacted != "dc:title" {
+		t.Fatalf("Compacted IRI not correct: [%s]", compacted)
+	}
+
+	if _, ok := prefixes.Compact(testXmpUri + "Label"); ok != false {
+		t.Fatalf("Expected unknown namespace to not be compacted.")
+	}
+
+	if _, ok := prefixes.Compact(testDcUri + "has.dot"); ok != false {
+		t.Fatalf("Expected invalid local name to not be compacted.")
+	}
+}
@@ -0,0 +1,327 @@
+package xmprdf
+
+import (
+	"strings"
+
+	"encoding/xml"
+)
+
+// rdfXmlWriter renders a graph as RDF/XML tokens.
+type rdfXmlWriter struct {
+	graph  *Graph
+	tokens []xml.Token
+
+	// properties has the triples of each subject in the order that they were
+	// added.
+	properties map[Term][]Triple
+
+	// references is the number of triples having each term as their object.
+	references map[Term]int
+
+	// written are the nodes that have already been described.
+	written map[Term]bool
+}
+
+// RdfXmlTokens returns the graph as the tokens of an "rdf:RDF" element. Each
+// subject that is not the object of another triple is described by a top-
+// level "rdf:Description" and everything else is nested where it is
+// referenced, with the same element names and, where possible, the same
+// choice of property attributes or property elements that the graph was
+// parsed from. Containers are written as "rdf:Bag", "rdf:Seq", or "rdf:Alt"
+// elements having "rdf:li" members, and blank-nodes that are referenced more
+// than once are identified by "rdf:nodeID". Names are qualified by namespace
+// URI, as the decoder returns them.
+func (graph *Graph) RdfXmlTokens() []xml.Token {
+	writer := &rdfXmlWriter{
+		graph:      graph,
+		tokens:     make([]xml.Token, 0),
+		properties: make(map[Term][]Triple),
+		references: make(map[Term]int),
+		written:    make(map[Term]bool),
+	}
+
+	for _, triple := range graph.triples {
+		writer.properties[triple.Subject] = append(writer.properties[triple.Subject], triple)
+		writer.references[triple.Object]++
+	}
+
+	start := xml.StartElement{Name: rdfTag}
+	writer.tokens = append(writer.tokens, start)
+
+	subjects := graph.Subjects()
+
+	for _, subject := range subjects {
+		if writer.references[subject] == 0 {
+			writer.writeDescription(subject, "", false)
+		}
+	}
+
+	// Nodes that are only referenced from within a cycle have not been
+	// reached yet.
+	for _, subject := range subjects {
+		if writer.written[subject] == false {
+			writer.writeDescription(subject, "", false)
+		}
+	}
+
+	writer.tokens = append(writer.tokens, start.End())
+
+	return writer.tokens
+}
+
+// name returns the name that the given IRI was written with. IRIs that were
+// not parsed are split after their last '#', '/', or ':'.
+func (graph *Graph) name(iri string) xml.Name {
+	if name, found := graph.names[iri]; found == true {
+		return name
+	}
+
+	i := strings.LastIndexAny(iri, "#/:")
+
+	return xml.Name{Space: iri[:i+1], Local: iri[i+1:]}
+}
+
+// nodeAttribute returns the attribute that identifies the node in a node
+// element, if it needs one. A blank-node needs one if it is referenced from
+// anywhere other than where it is described.
+func (writer *rdfXmlWriter) nodeAttribute(node Term, isNested bool) (attribute xml.Attr, found bool) {
+	references := writer.references[node]
+	if isNested == true {
+		references--
+	}
+
+	if node.IsIri() == true {
+		return xml.Attr{Name: rdfAboutName, Value: node.Value}, true
+	} else if references > 0 {
+		return xml.Attr{Name: rdfNodeIdName, Value: node.Value}, true
+	}
+
+	return xml.Attr{}, false
+}
+
+// nodeLanguage returns the language to declare on the element of the node:
+// the one that the node was described with or else the one of the triples
+// that can be written as attributes. Attributes have the language that is in
+// scope, so only those having the language of the first can be.
+func (writer *rdfXmlWriter) nodeLanguage(node Term) string {
+	if language, found := writer.graph.languages[node]; found == true {
+		return language
+	}
+
+	for _, triple := range writer.properties[node] {
+		if writer.isAttribute(triple, triple.Object.Language) == true {
+			return triple.Object.Language
+		}
+	}
+
+	return ""
+}
+
+// isAttribute returns true if the triple was parsed from a property attribute
+// and can be written as one again in the given language.
+func (writer *rdfXmlWriter) isAttribute(triple Triple, language string) bool {
+	if writer.graph.attributeTriples[triple] == false {
+		return false
+	} else if triple.Predicate == RdfType {
+		return triple.Object.IsIri() == true
+	}
+
+	return triple.Object.IsLiteral() == true && triple.Object.Datatype == "" && triple.Object.Language == language
+}
+
+// attributes returns the attributes that describe the triples of the node
+// that are written as attributes and not skipped.
+func (writer *rdfXmlWriter) attributes(node Term, language string, skipped map[Triple]bool) (attributes []xml.Attr) {
+	attributes = make([]xml.Attr, 0)
+
+	for _, triple := range writer.properties[node] {
+		if writer.isAttribute(triple, language) == false || skipped[triple] == true {
+			continue
+		}
+
+		var name xml.Name
+		if triple.Predicate == RdfType {
+			name = rdfTypeName
+		} else {
+			name = writer.graph.name(triple.Predicate.Value)
+		}
+
+		attributes = append(attributes, xml.Attr{Name: name, Value: triple.Object.Value})
+	}
+
+	return attributes
+}
+
+// writeDescription writes the node as an "rdf:Description" element. Nested
+// descriptions are the object of the enclosing property element.
+func (writer *rdfXmlWriter) writeDescription(node Term, scopeLanguage string, isNested bool) {
+	writer.written[node] = true
+
+	start := xml.StartElement{Name: rdfDescriptionTag}
+
+	if attribute, found := writer.nodeAttribute(node, isNested); found == true {
+		start.Attr = append(start.Attr, attribute)
+	}
+
+	language := writer.nodeLanguage(node)
+	if language != scopeLanguage {
+		start.Attr = append(start.Attr, xml.Attr{Name: xmlLangName, Value: language})
+	}
+
+	start.Attr = append(start.Attr, writer.attributes(node, language, nil)...)
+
+	writer.tokens = append(writer.tokens, start)
+	writer.writePropertyElements(node, language, nil)
+	writer.tokens = append(writer.tokens, start.End())
+}
+
+// writePropertyElements writes the triples of the node that are not written
+// as attributes or skipped as property elements.
+func (writer *rdfXmlWriter) writePropertyElements(node Term, language string, skipped map[Triple]bool) {
+	for _, triple := range writer.properties[node] {
+		if writer.isAttribute(triple, language) == true || skipped[triple] == true {
+			continue
+		}
+
+		writer.writeProperty(writer.graph.name(triple.Predicate.Value), triple.Object, language)
+	}
+}
+
+// writeProperty writes a property element having the given object.
+func (writer *rdfXmlWriter) writeProperty(name xml.Name, object Term, scopeLanguage string) {
+	start := xml.StartElement{Name: name}
+
+	if object.IsLiteral() == true {
+		if object.Language != scopeLanguage {
+			start.Attr = append(start.Attr, xml.Attr{Name: xmlLangName, Value: object.Language})
+		}
+
+		if object.Datatype != "" {
+			start.Attr = append(start.Attr, xml.Attr{Name: rdfDatatypeName, Value: object.Datatype})
+		}
+
+		writer.tokens = append(writer.tokens, start)
+
+		if object.Value != "" {
+			writer.tokens = append(writer.tokens, xml.CharData(object.Value))
+		}
+
+		writer.tokens = append(writer.tokens, start.End())
+
+		return
+	}
+
+	if writer.written[object] == true || len(writer.properties[object]) == 0 && object.IsIri() == true {
+		if object.IsIri() == true {
+			start.Attr = append(start.Attr, xml.Attr{Name: rdfResourceName, Value: object.Value})
+		} else {
+			start.Attr = append(start.Attr, xml.Attr{Name: rdfNodeIdName, Value: object.Value})
+		}
+
+		writer.tokens = append(writer.tokens, start, start.End())
+
+		return
+	}
+
+	if containerType, ok := writer.graph.IsContainer(object); ok == true {
+		writer.tokens = append(writer.tokens, start)
+		writer.writeContainer(object, containerType, scopeLanguage)
+		writer.tokens = append(writer.tokens, start.End())
+
+		return
+	}
+
+	if _, found := writer.nodeAttribute(object, true); found == true {
+		writer.tokens = append(writer.tokens, start)
+		writer.writeDescription(object, scopeLanguage, true)
+		writer.tokens = append(writer.tokens, start.End())
+
+		return
+	}
+
+	writer.written[object] = true
+
+	language := writer.nodeLanguage(object)
+	attributes := writer.attributes(object, language, nil)
+
+	if language != scopeLanguage {
+		start.Attr = append(start.Attr, xml.Attr{Name: xmlLangName, Value: language})
+	}
+
+	if len(attributes) == 0 {
+		// Everything is written as property elements.
+
+		start.Attr = append(start.Attr, xml.Attr{Name: rdfParseTypeName, Value: "Resource"})
+
+		writer.tokens = append(writer.tokens, start)
+		writer.writePropertyElements(object, language, nil)
+		writer.tokens = append(writer.tokens, start.End())
+
+		return
+	}
+
+	if len(attributes) == len(writer.properties[object]) {
+		// Everything is written as property attributes.
+
+		start.Attr = append(start.Attr, attributes...)
+		writer.tokens = append(writer.tokens, start, start.End())
+
+		return
+	}
+
+	// Some of the properties are attributes and the rest are elements, so
+	// the node needs its own element.
+
+	description := xml.StartElement{
+		Name: rdfDescriptionTag,
+		Attr: attributes,
+	}
+
+	writer.tokens = append(writer.tokens, start, description)
+	writer.writePropertyElements(object, language, nil)
+	writer.tokens = append(writer.tokens, description.End(), start.End())
+}
+
+// writeContainer writes a container node element having its members as
+// "rdf:li" elements.
+func (writer *rdfXmlWriter) writeContainer(node Term, containerType string, scopeLanguage string) {
+	writer.written[node] = true
+
+	start := xml.StartElement{Name: xml.Name{Space: RdfUri, Local: containerType}}
+
+	if attribute, found := writer.nodeAttribute(node, true); found == true {
+		start.Attr = append(start.Attr, attribute)
+	}
+
+	// The container type is described by the element and the members by
+	// their position.
+	skipped := make(map[Triple]bool)
+
+	typeTriple := Triple{
+		Subject:   node,
+		Predicate: RdfType,
+		Object:    NewIri(RdfUri + containerType),
+	}
+
+	skipped[typeTriple] = true
+
+	members := writer.graph.ContainerMembers(node)
+	for i := range members {
+		predicate := MemberPredicate(i + 1)
+
+		for _, triple := range writer.graph.Match(&node, &predicate, nil) {
+			skipped[triple] = true
+		}
+	}
+
+	start.Attr = append(start.Attr, writer.attributes(node, scopeLanguage, skipped)...)
+
+	writer.tokens = append(writer.tokens, start)
+	writer.writePropertyElements(node, scopeLanguage, skipped)
+
+	for _, member := range members {
+		writer.writeProperty(rdfLiTag, member, scopeLanguage)
+	}
+
+	writer.tokens = append(writer.tokens, start.End())
+}
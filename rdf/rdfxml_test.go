@@ -0,0 +1,143 @@
+package xmprdf
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// rebuildTestGraph writes the graph as RDF/XML and parses it again.
+func rebuildTestGraph(graph *Graph) *Graph {
+	rebuilt := NewGraph()
+	builder := NewBuilder(rebuilt)
+
+	for _, token := range graph.RdfXmlTokens() {
+		err := builder.Process(token)
+		log.PanicIf(err)
+	}
+
+	return rebuilt
+}
+
+func TestGraph_RdfXmlTokens(t *testing.T) {
+	graph := parseTestGraph(`<rdf:Description rdf:about="" xmp:Rating="3"><xmp:Label>some label</xmp:Label></rdf:Description>`)
+
+	description := xml.StartElement{
+		Name: rdfDescriptionTag,
+		Attr: []xml.Attr{
+			{Name: rdfAboutName, Value: ""},
+			{Name: xml.Name{Space: "http://ns.adobe.com/xap/1.0/", Local: "Rating"}, Value: "3"},
+		},
+	}
+
+	label := xml.StartElement{
+		Name: xml.Name{Space: "http://ns.adobe.com/xap/1.0/", Local: "Label"},
+	}
+
+	expected := []xml.Token{
+		xml.StartElement{Name: rdfTag},
+		description,
+		label,
+		xml.CharData("some label"),
+		label.End(),
+		description.End(),
+		xml.EndElement{Name: rdfTag},
+	}
+
+	if tokens := graph.RdfXmlTokens(); reflect.DeepEqual(tokens, expected) != true {
+		t.Fatalf("Tokens not correct:\n%v\n\nExpected:\n%v", tokens, expected)
+	}
+}
+
+func TestGraph_RdfXmlTokens_RoundTrip(t *testing.T) {
+	bodies := []string{
+		`<rdf:Description rdf:about="" dc:format="image/jpeg" xmp:Rating="3"/>`,
+		`
+<rdf:Description rdf:about="">
+  <xmp:Rating rdf:datatype="http://www.w3.org/2001/XMLSchema#integer">3</xmp:Rating>
+  <xmp:BaseURL rdf:resource="http://some/url"/>
+  <dc:title>
+    <rdf:Alt>
+      <rdf:li xml:lang="x-default">default title</rdf:li>
+      <rdf:li xml:lang="de">Titel</rdf:li>
+    </rdf:Alt>
+  </dc:title>
+</rdf:Description>`,
+		`
+<rdf:Description rdf:about="">
+  <xmpMM:DerivedFrom stRef:documentID="xmp.did:1">
+    <stRef:instanceID>xmp.iid:1</stRef:instanceID>
+  </xmpMM:DerivedFrom>
+  <xmpMM:Ingredients>
+    <rdf:Bag>
+      <rdf:li stRef:documentID="xmp.did:2"/>
+      <rdf:li rdf:parseType="Resource">
+        <rdf:value>Some Author</rdf:value>
+        <xmp:Label>qualifier</xmp:Label>
+      </rdf:li>
+    </rdf:Bag>
+  </xmpMM:Ingredients>
+</rdf:Description>`,
+		`
+<rdf:Description rdf:about="" xml:lang="fr">
+  <xmp:Label>étiquette</xmp:Label>
+  <xmp:Thumbnails>
+    <xmp:Thumbnail xmp:Format="JPEG"/>
+  </xmp:Thumbnails>
+</rdf:Description>`,
+	}
+
+	for _, body := range bodies {
+		graph := parseTestGraph(body)
+		rebuilt := rebuildTestGraph(graph)
+
+		actual := getTriplePhrases(rebuilt)
+		expected := getTriplePhrases(graph)
+
+		if reflect.DeepEqual(actual, expected) != true {
+			t.Fatalf("Rebuilt triples not correct:\n%v\n\nExpected:\n%v", actual, expected)
+		}
+	}
+}
+
+func TestGraph_RdfXmlTokens_SharedNode(t *testing.T) {
+	graph := parseTestGraph(`
+<rdf:Description rdf:about="">
+  <xmpMM:DerivedFrom rdf:nodeID="ref"/>
+  <xmpMM:ManagedFrom rdf:nodeID="ref"/>
+</rdf:Description>
+<rdf:Description rdf:nodeID="ref" stRef:documentID="xmp.did:1"/>`)
+
+	nodeIds := 0
+	for _, token := range graph.RdfXmlTokens() {
+		if se, ok := token.(xml.StartElement); ok == true {
+			for _, attribute := range se.Attr {
+				if attribute.Name == rdfNodeIdName {
+					nodeIds++
+				}
+			}
+		}
+	}
+
+	// Once where the node is described and once where it is referenced.
+	if nodeIds != 2 {
+		t.Fatalf("Expected the shared node to be identified twice: (%d)", nodeIds)
+	}
+
+	// The node is described where it is first referenced, so its triples
+	// move ahead of the second reference.
+
+	actual := getTriplePhrases(rebuildTestGraph(graph))
+	expected := getTriplePhrases(graph)
+
+	sort.Strings(actual)
+	sort.Strings(expected)
+
+	if reflect.DeepEqual(actual, expected) != true {
+		t.Fatalf("Rebuilt triples not correct:\n%v\n\nExpected:\n%v", actual, expected)
+	}
+}
@@ -0,0 +1,104 @@
+package xmprdf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// turtleIri returns the IRI as a prefixed name if possible.
+func turtleIri(iri string, prefixes Prefixes) string {
+	if compacted, ok := prefixes.Compact(iri); ok == true {
+		return compacted
+	}
+
+	return NewIri(iri).String()
+}
+
+// turtleTerm returns the term in Turtle form.
+func turtleTerm(term Term, prefixes Prefixes) string {
+	switch term.Kind {
+	case TermIri:
+		return turtleIri(term.Value, prefixes)
+	case TermLiteral:
+		literal := "\"" + escapeString(term.Value) + "\""
+
+		if term.Language != "" {
+			return literal + "@" + term.Language
+		} else if term.Datatype != "" {
+			return literal + "^^" + turtleIri(term.Datatype, prefixes)
+		}
+
+		return literal
+	}
+
+	return term.String()
+}
+
+// WriteTurtle writes the graph as Turtle. The triples of each subject are
+// grouped together and subjects are written in the order that they were first
+// seen. The prefixes are required (see RegisteredPrefixes); they may be empty
+// to write every IRI in full.
+func (graph *Graph) WriteTurtle(w io.Writer, prefixes Prefixes) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if prefixes == nil {
+		log.Panic(ErrPrefixesNotGiven)
+	}
+
+	bw := bufio.NewWriter(w)
+
+	for _, name := range prefixes.Names() {
+		_, err := fmt.Fprintf(bw, "@prefix %s: %s .\n", name, NewIri(prefixes[name]))
+		log.PanicIf(err)
+	}
+
+	for _, subject := range graph.Subjects() {
+		_, err := fmt.Fprintf(bw, "\n%s", turtleTerm(subject, prefixes))
+		log.PanicIf(err)
+
+		// Group the objects of each predicate while keeping the order in which
+		// the predicates were first seen.
+
+		predicates := make([]Term, 0)
+		objects := make(map[Term][]string)
+
+		for _, triple := range graph.Match(&subject, nil, nil) {
+			if _, found := objects[triple.Predicate]; found == false {
+				predicates = append(predicates, triple.Predicate)
+			}
+
+			objects[triple.Predicate] = append(objects[triple.Predicate], turtleTerm(triple.Object, prefixes))
+		}
+
+		for i, predicate := range predicates {
+			separator := " "
+			if i > 0 {
+				separator = " ;\n    "
+			}
+
+			predicatePhrase := turtleTerm(predicate, prefixes)
+			if predicate == RdfType {
+				predicatePhrase = "a"
+			}
+
+			_, err := fmt.Fprintf(bw, "%s%s %s", separator, predicatePhrase, strings.Join(objects[predicate], ", "))
+			log.PanicIf(err)
+		}
+
+		_, err = bw.WriteString(" .\n")
+		log.PanicIf(err)
+	}
+
+	err = bw.Flush()
+	log.PanicIf(err)
+
+	return nil
+}
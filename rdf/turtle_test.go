@@ -0,0 +1,48 @@
+package xmprdf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestGraph_WriteTurtle(t *testing.T) {
+	graph := getTestExportGraph()
+	graph.Add(NewIri(""), NewIri(testDcUri+"subject"), NewLiteral("aa", "", ""))
+	graph.Add(NewIri(""), NewIri(testDcUri+"subject"), NewLiteral("bb", "", ""))
+
+	b := new(bytes.Buffer)
+
+	err := graph.WriteTurtle(b, RegisteredPrefixes(graph, getTestRegistry()))
+	log.PanicIf(err)
+
+	expected := `@prefix dc: <http://purl.org/dc/elements/1.1/> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+@prefix xmp: <http://ns.adobe.com/xap/1.0/> .
+
+<> dc:format "image/jpeg" ;
+    dc:title _:b1 ;
+    xmp:BaseURL <http://some/url> ;
+    xmp:Rating "3"^^<http://www.w3.org/2001/XMLSchema#integer> ;
+    dc:subject "aa", "bb" .
+
+_:b1 a rdf:Alt ;
+    rdf:_1 "some \"title\""@x-default .
+`
+
+	if b.String() != expected {
+		t.Fatalf("Turtle not correct:\n%s", b.String())
+	}
+}
+
+func TestGraph_WriteTurtle_PrefixesNotGiven(t *testing.T) {
+	graph := getTestExportGraph()
+
+	err := graph.WriteTurtle(new(bytes.Buffer), nil)
+	if err == nil {
+		t.Fatalf("Expected error for missing prefixes.")
+	} else if log.Is(err, ErrPrefixesNotGiven) != true {
+		t.Fatalf("Error not correct: %v", err)
+	}
+}
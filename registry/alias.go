@@ -0,0 +1,148 @@
+package xmpregistry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+var (
+	// ErrAliasAlreadyRegistered indicates that an alias was registered for a
+	// property that is already an alias.
+	ErrAliasAlreadyRegistered = errors.New("alias already registered")
+
+	// ErrAliasNotValid indicates that an alias would make a chain of aliases
+	// (its base is an alias or it is the base of another alias) or refers to
+	// itself. The specification only allows aliases of base properties.
+	ErrAliasNotValid = errors.New("alias not valid")
+)
+
+// AliasForm describes how the value of an alias relates to the value of its
+// base property.
+type AliasForm int
+
+const (
+	// AliasFormSimple indicates that the alias has the same value as its base
+	// property.
+	AliasFormSimple AliasForm = iota
+
+	// AliasFormOrderedFirstItem indicates that the alias is a simple value
+	// that is the first item of its base property, an ordered array (e.g.
+	// "photoshop:Author" is the first item of "dc:creator").
+	AliasFormOrderedFirstItem
+
+	// AliasFormLanguageDefault indicates that the alias is a simple value that
+	// is the "x-default" item of its base property, an array of language
+	// alternatives (e.g. "pdf:Title" is the default "dc:title").
+	AliasFormLanguageDefault
+)
+
+// String returns a descriptive name of the form.
+func (form AliasForm) String() string {
+	switch form {
+	case AliasFormSimple:
+		return "simple"
+	case AliasFormOrderedFirstItem:
+		return "first-item"
+	case AliasFormLanguageDefault:
+		return "x-default"
+	}
+
+	return fmt.Sprintf("AliasForm(%d)", int(form))
+}
+
+// Alias describes a property that is another name for a base property. Per
+// Part 1 of the specification, readers are expected to treat the alias as the
+// base property.
+type Alias struct {
+	// Name is the name of the alias.
+	Name xml.Name
+
+	// Base is the name of the base property.
+	Base xml.Name
+
+	// Form is how the value of the alias relates to the value of the base.
+	Form AliasForm
+}
+
+// String returns a string representation of the alias.
+func (alias Alias) String() string {
+	return fmt.Sprintf("Alias<NAME=[%s] BASE=[%s] FORM=[%s]>", XmlName(alias.Name), XmlName(alias.Base), alias.Form)
+}
+
+// RegisterAlias registers an alias. Returns ErrAliasAlreadyRegistered if the
+// name is already an alias and ErrAliasNotValid if it would chain aliases.
+func (registry *Registry) RegisterAlias(alias Alias) (err error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	if _, found := registry.aliases[alias.Name]; found == true {
+		return ErrAliasAlreadyRegistered
+	} else if alias.Name == alias.Base {
+		return ErrAliasNotValid
+	} else if _, found := registry.aliases[alias.Base]; found == true {
+		return ErrAliasNotValid
+	}
+
+	for _, existing := range registry.aliases {
+		if existing.Base == alias.Name {
+			return ErrAliasNotValid
+		}
+	}
+
+	registry.aliases[alias.Name] = alias
+
+	return nil
+}
+
+// ResolveAlias returns the alias registered for the given property name, if
+// it is one.
+func (registry *Registry) ResolveAlias(name xml.Name) (alias Alias, found bool) {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	alias, found = registry.aliases[name]
+
+	return alias, found
+}
+
+// Aliases returns every registered alias sorted by the namespace and then by
+// the local name of the alias.
+func (registry *Registry) Aliases() (aliases []Alias) {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	aliases = make([]Alias, 0, len(registry.aliases))
+	for _, alias := range registry.aliases {
+		aliases = append(aliases, alias)
+	}
+
+	sort.Slice(aliases, func(i, j int) bool {
+		if aliases[i].Name.Space != aliases[j].Name.Space {
+			return aliases[i].Name.Space < aliases[j].Name.Space
+		}
+
+		return aliases[i].Name.Local < aliases[j].Name.Local
+	})
+
+	return aliases
+}
+
+// RegisterAlias registers an alias with the default registry. It panics if
+// the alias can not be registered.
+func RegisterAlias(alias Alias) {
+	err := defaultRegistry.RegisterAlias(alias)
+	if err != nil {
+		log.Panicf("alias could not be registered (%s): %s", err, alias)
+	}
+}
+
+// ResolveAlias returns the alias registered with the default registry for the
+// given property name, if it is one.
+func ResolveAlias(name xml.Name) (alias Alias, found bool) {
+	return defaultRegistry.ResolveAlias(name)
+}
@@ -0,0 +1,109 @@
+package xmpregistry
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+var (
+	testAlias = Alias{
+		Name: xml.Name{Space: "http://some/uri/a", Local: "Author"},
+		Base: xml.Name{Space: "http://some/uri/b", Local: "creator"},
+		Form: AliasFormOrderedFirstItem,
+	}
+)
+
+func TestRegistry_RegisterAlias(t *testing.T) {
+	registry := NewRegistry()
+
+	err := registry.RegisterAlias(testAlias)
+	log.PanicIf(err)
+
+	alias, found := registry.ResolveAlias(testAlias.Name)
+	if found != true || reflect.DeepEqual(alias, testAlias) != true {
+		t.Fatalf("Alias not resolved: [%v] %s", found, alias)
+	}
+
+	if _, found := registry.ResolveAlias(testAlias.Base); found != false {
+		t.Fatalf("Base should not be an alias.")
+	}
+
+	err = registry.RegisterAlias(testAlias)
+	if err != ErrAliasAlreadyRegistered {
+		t.Fatalf("Expected error for duplicate alias: [%v]", err)
+	}
+}
+
+func TestRegistry_RegisterAlias_Chain(t *testing.T) {
+	registry := NewRegistry()
+
+	err := registry.RegisterAlias(testAlias)
+	log.PanicIf(err)
+
+	// An alias of an alias.
+
+	chained := Alias{
+		Name: xml.Name{Space: "http://some/uri/c", Local: "Author"},
+		Base: testAlias.Name,
+	}
+
+	err = registry.RegisterAlias(chained)
+	if err != ErrAliasNotValid {
+		t.Fatalf("Expected error for alias of an alias: [%v]", err)
+	}
+
+	// An alias of a base property.
+
+	chained = Alias{
+		Name: testAlias.Base,
+		Base: xml.Name{Space: "http://some/uri/c", Local: "Author"},
+	}
+
+	err = registry.RegisterAlias(chained)
+	if err != ErrAliasNotValid {
+		t.Fatalf("Expected error for aliasing a base property: [%v]", err)
+	}
+
+	err = registry.RegisterAlias(Alias{Name: testAlias.Base, Base: testAlias.Base})
+	if err != ErrAliasNotValid {
+		t.Fatalf("Expected error for alias of itself: [%v]", err)
+	}
+}
+
+func TestRegistry_Aliases(t *testing.T) {
+	registry := NewRegistry()
+
+	other := Alias{
+		Name: xml.Name{Space: "http://some/uri/a", Local: "Title"},
+		Base: xml.Name{Space: "http://some/uri/b", Local: "title"},
+		Form: AliasFormLanguageDefault,
+	}
+
+	err := registry.RegisterAlias(other)
+	log.PanicIf(err)
+
+	err = registry.RegisterAlias(testAlias)
+	log.PanicIf(err)
+
+	cloned := registry.Clone()
+	registry.Clear()
+
+	if len(registry.Aliases()) != 0 {
+		t.Fatalf("Aliases not cleared.")
+	}
+
+	if reflect.DeepEqual(cloned.Aliases(), []Alias{testAlias, other}) != true {
+		t.Fatalf("Aliases not correct: %v", cloned.Aliases())
+	}
+}
+
+func TestAlias_String(t *testing.T) {
+	s := testAlias.String()
+	if s != "Alias<NAME=[[?]Author] BASE=[[?]creator] FORM=[first-item]>" {
+		t.Fatalf("String not correct: [%s]", s)
+	}
+}
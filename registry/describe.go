@@ -0,0 +1,135 @@
+package xmpregistry
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"encoding/xml"
+)
+
+// ArrayKind is the kind of an array field.
+type ArrayKind string
+
+const (
+	// ArrayKindNone indicates that the field is not an array.
+	ArrayKindNone ArrayKind = ""
+
+	// ArrayKindOrdered is an ordered array ("rdf:Seq").
+	ArrayKindOrdered ArrayKind = "seq"
+
+	// ArrayKindUnordered is an unordered array ("rdf:Bag").
+	ArrayKindUnordered ArrayKind = "bag"
+
+	// ArrayKindAlternative is an array of alternatives ("rdf:Alt").
+	ArrayKindAlternative ArrayKind = "alt"
+
+	// ArrayKindLanguageAlternative is an array of language alternatives.
+	ArrayKindLanguageAlternative ArrayKind = "lang-alt"
+)
+
+// ArrayFieldTypeDescriber is satisfied by the array field-types. It allows
+// them to be described without depending on the type package.
+type ArrayFieldTypeDescriber interface {
+	// ArrayKind returns the kind of the array.
+	ArrayKind() ArrayKind
+
+	// ItemFieldType returns the field-type of the items. It may be nil if the
+	// items are not interpreted.
+	ItemFieldType() interface{}
+}
+
+// FieldDescriptor describes a single registered field.
+type FieldDescriptor struct {
+	// Namespace is the URI of the namespace of the field.
+	Namespace string
+
+	// Parent is the property that the field is scoped to. It is empty for
+	// top-level fields.
+	Parent xml.Name
+
+	// Name is the local name of the field.
+	Name string
+
+	// TypeName is the name of the field-type (e.g. "Text" for
+	// TextFieldType). For arrays, this is the name of the item field-type,
+	// and is empty if the items are not interpreted.
+	TypeName string
+
+	// ArrayKind is the kind of array if the field is an array.
+	ArrayKind ArrayKind
+
+	// Description is the human-readable description of the field, if given.
+	Description string
+
+	// SpecReference is where the field is defined, if known.
+	SpecReference string
+
+	// FieldType is the field-type.
+	FieldType interface{}
+}
+
+// FieldTypeName returns the name of the given field-type with the
+// "FieldType" suffix removed (e.g. "Text" for TextFieldType).
+func FieldTypeName(ft interface{}) string {
+	if ft == nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(reflect.TypeOf(ft).Name(), "FieldType")
+}
+
+// describeField returns the descriptor of a single field.
+func (namespace Namespace) describeField(parent xml.Name, name string, ft interface{}) FieldDescriptor {
+	fd := FieldDescriptor{
+		Namespace:     namespace.Uri,
+		Parent:        parent,
+		Name:          name,
+		Description:   namespace.FieldDescriptions[name],
+		SpecReference: namespace.SpecReference,
+		FieldType:     ft,
+	}
+
+	if aftd, ok := ft.(ArrayFieldTypeDescriber); ok == true {
+		fd.TypeName = FieldTypeName(aftd.ItemFieldType())
+		fd.ArrayKind = aftd.ArrayKind()
+	} else {
+		fd.TypeName = FieldTypeName(ft)
+	}
+
+	return fd
+}
+
+// Describe returns the descriptors of every field of the namespace. Top-level
+// fields come first, sorted by name, followed by the scoped fields, sorted by
+// their parent and then by name.
+func (namespace Namespace) Describe() (descriptors []FieldDescriptor) {
+	descriptors = make([]FieldDescriptor, 0, len(namespace.Fields))
+
+	for name, ft := range namespace.Fields {
+		fd := namespace.describeField(xml.Name{}, name, ft)
+		descriptors = append(descriptors, fd)
+	}
+
+	for parent, fields := range namespace.ScopedFields {
+		for name, ft := range fields {
+			fd := namespace.describeField(parent, name, ft)
+			descriptors = append(descriptors, fd)
+		}
+	}
+
+	sort.Slice(descriptors, func(i, j int) bool {
+		a := descriptors[i]
+		b := descriptors[j]
+
+		if a.Parent.Space != b.Parent.Space {
+			return a.Parent.Space < b.Parent.Space
+		} else if a.Parent.Local != b.Parent.Local {
+			return a.Parent.Local < b.Parent.Local
+		}
+
+		return a.Name < b.Name
+	})
+
+	return descriptors
+}
@@ -0,0 +1,66 @@
+package xmpregistry
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+)
+
+type testTextFieldType struct{}
+
+type testArrayFieldType struct {
+	itemType interface{}
+}
+
+func (taft testArrayFieldType) ArrayKind() ArrayKind {
+	return ArrayKindUnordered
+}
+
+func (taft testArrayFieldType) ItemFieldType() interface{} {
+	return taft.itemType
+}
+
+func TestFieldTypeName(t *testing.T) {
+	if name := FieldTypeName(testTextFieldType{}); name != "testText" {
+		t.Fatalf("Name not correct: [%s]", name)
+	} else if name := FieldTypeName(nil); name != "" {
+		t.Fatalf("Name of nil not correct: [%s]", name)
+	}
+}
+
+func TestNamespace_Describe(t *testing.T) {
+	parent := xml.Name{Space: "http://some/uri", Local: "Location"}
+
+	namespace := Namespace{
+		Uri:             "http://some/uri",
+		PreferredPrefix: "some",
+		SpecReference:   "Some Guide",
+		Fields: map[string]interface{}{
+			"Title":    testTextFieldType{},
+			"Keywords": testArrayFieldType{itemType: testTextFieldType{}},
+			"Location": testTextFieldType{},
+		},
+		ScopedFields: map[xml.Name]map[string]interface{}{
+			parent: {
+				"City": testTextFieldType{},
+			},
+		},
+		FieldDescriptions: map[string]string{
+			"City": "The city",
+		},
+	}
+
+	descriptors := namespace.Describe()
+
+	expected := []FieldDescriptor{
+		{Namespace: "http://some/uri", Name: "Keywords", TypeName: "testText", ArrayKind: ArrayKindUnordered, SpecReference: "Some Guide", FieldType: testArrayFieldType{itemType: testTextFieldType{}}},
+		{Namespace: "http://some/uri", Name: "Location", TypeName: "testText", SpecReference: "Some Guide", FieldType: testTextFieldType{}},
+		{Namespace: "http://some/uri", Name: "Title", TypeName: "testText", SpecReference: "Some Guide", FieldType: testTextFieldType{}},
+		{Namespace: "http://some/uri", Parent: parent, Name: "City", TypeName: "testText", Description: "The city", SpecReference: "Some Guide", FieldType: testTextFieldType{}},
+	}
+
+	if reflect.DeepEqual(descriptors, expected) != true {
+		t.Fatalf("Descriptors not correct:\nACTUAL: %v\nEXPECTED: %v", descriptors, expected)
+	}
+}
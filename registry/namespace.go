@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"encoding/xml"
+
 	"github.com/dsoprea/go-logging"
 )
 
@@ -17,16 +19,6 @@ var (
 	ErrNamespaceNotFound = errors.New("namespace not found")
 )
 
-var (
-	// namespaces contains all of the namespace registrations.
-	namespaces = make(map[string]Namespace)
-
-	// unknownNamespaces indicates which namespaces have been looked up that we
-	// don't have registrations for. This allows us to log warnings once and
-	// only once.
-	unknownNamespaces = make(map[string]struct{})
-)
-
 // Namespace describes the information about a single namespace.
 type Namespace struct {
 	// Uri is the URI of a namespace (it should be regarded as a string only;
@@ -39,6 +31,72 @@ type Namespace struct {
 
 	// Fields is a mapping of field names to types.
 	Fields map[string]interface{}
+
+	// ScopedFields maps the name of an enclosing property to the types of any
+	// fields that have a different type when they are directly within it than
+	// they have elsewhere (e.g. "xmpDM:duration" is a Time as a property but a
+	// FrameCount within an "xmpDM:markers" item). The enclosing property may
+	// be in another namespace. Fields is used for anything not found here.
+	ScopedFields map[xml.Name]map[string]interface{}
+
+	// Description is a human-readable description of the namespace.
+	Description string
+
+	// SpecReference is the document (and section, if any) that defines the
+	// namespace (e.g. "XMP Specification Part 1").
+	SpecReference string
+
+	// FieldDescriptions are human-readable descriptions of fields by name.
+	// They apply both to top-level and scoped fields.
+	FieldDescriptions map[string]string
+}
+
+// FieldType returns the type of the given field when it is directly within
+// the given property (the nearest enclosing node that is not RDF syntax).
+func (namespace Namespace) FieldType(parent xml.Name, fieldName string) (ft interface{}, found bool) {
+	if scoped, found := namespace.ScopedFields[parent]; found == true {
+		if ft, found := scoped[fieldName]; found == true {
+			return ft, true
+		}
+	}
+
+	ft, found = namespace.Fields[fieldName]
+
+	return ft, found
+}
+
+// clone returns a copy of the namespace that shares none of its maps with the
+// original. The field-types themselves are values and are not copied.
+func (namespace Namespace) clone() Namespace {
+	cloned := namespace
+
+	if namespace.Fields != nil {
+		cloned.Fields = make(map[string]interface{}, len(namespace.Fields))
+		for name, ft := range namespace.Fields {
+			cloned.Fields[name] = ft
+		}
+	}
+
+	if namespace.ScopedFields != nil {
+		cloned.ScopedFields = make(map[xml.Name]map[string]interface{}, len(namespace.ScopedFields))
+		for parent, fields := range namespace.ScopedFields {
+			clonedFields := make(map[string]interface{}, len(fields))
+			for name, ft := range fields {
+				clonedFields[name] = ft
+			}
+
+			cloned.ScopedFields[parent] = clonedFields
+		}
+	}
+
+	if namespace.FieldDescriptions != nil {
+		cloned.FieldDescriptions = make(map[string]string, len(namespace.FieldDescriptions))
+		for name, description := range namespace.FieldDescriptions {
+			cloned.FieldDescriptions[name] = description
+		}
+	}
+
+	return cloned
 }
 
 // String returns a string representation of the namespace.
@@ -46,59 +104,45 @@ func (namespace Namespace) String() string {
 	return fmt.Sprintf("Namespace<URI=[%s] PREFIX=[%s]>", namespace.Uri, namespace.PreferredPrefix)
 }
 
-// Register registers a namespace for access during parsing and indexing.
+// Register registers a namespace with the default registry. It panics if the
+// URI is already registered.
 func Register(namespace Namespace) {
-	if _, found := namespaces[namespace.Uri]; found == true {
+	err := defaultRegistry.Register(namespace)
+	if err == ErrNamespaceAlreadyRegistered {
 		log.Panicf("namespace already registered: [%s]", namespace.Uri)
 	}
 
-	namespaces[namespace.Uri] = namespace
+	log.PanicIf(err)
 }
 
-// Clear removes all namespace registrations. Supports testing.
+// Clear removes all namespace and alias registrations from the default
+// registry.
+// Supports testing.
 func Clear() {
 	ClearCachedPrefixes()
 
-	namespaces = make(map[string]Namespace)
-	unknownNamespaces = make(map[string]struct{})
+	defaultRegistry.Clear()
 }
 
-// Get returns the namespace registration for the given URI. Since namespaces
-// URIs are strictly defined, no normalization is required.
+// Get returns the namespace registration for the given URI from the default
+// registry.
 func Get(uri string) (namespace Namespace, err error) {
-	defer func() {
-		if errRaw := recover(); errRaw != nil {
-			err = log.Wrap(errRaw.(error))
-		}
-	}()
-
-	namespace, found := namespaces[uri]
-
-	if found == false {
-		if err == ErrNamespaceNotFound {
-			if _, found := unknownNamespaces[uri]; found == false {
-				namespaceLogger.Warningf(
-					nil,
-					"Namespace [%s] was requested but is not known.",
-					uri)
-
-				unknownNamespaces[uri] = struct{}{}
-			}
-		}
-
-		return Namespace{}, ErrNamespaceNotFound
-	}
-
-	return namespace, nil
+	return defaultRegistry.Get(uri)
 }
 
-// MustGet returns the Namespace struct associated with the given URI. It panics
-// if not known.
+// MustGet returns the Namespace struct associated with the given URI from the
+// default registry. It panics if not known.
 func MustGet(uri string) (namespace Namespace) {
-	namespace, err := Get(uri)
-	if err != nil {
-		panic(err)
-	}
+	return defaultRegistry.MustGet(uri)
+}
+
+// List returns every namespace registered with the default registry.
+func List() (namespaces []Namespace) {
+	return defaultRegistry.List()
+}
 
-	return namespace
+// LookupByPrefix returns the namespace registered with the default registry
+// with the given preferred-prefix.
+func LookupByPrefix(prefix string) (namespace Namespace, err error) {
+	return defaultRegistry.LookupByPrefix(prefix)
 }
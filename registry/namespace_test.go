@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"testing"
 
+	"encoding/xml"
+
 	"github.com/dsoprea/go-logging"
 )
 
@@ -18,12 +20,49 @@ func TestNamespace_String(t *testing.T) {
 	}
 }
 
+func TestNamespace_FieldType(t *testing.T) {
+	parent := xml.Name{Space: "http://some/uri", Local: "SomeStruct"}
+
+	namespace := Namespace{
+		Uri: "http://some/uri",
+		Fields: map[string]interface{}{
+			"field1": "unscoped1",
+			"field2": "unscoped2",
+		},
+		ScopedFields: map[xml.Name]map[string]interface{}{
+			parent: {
+				"field1": "scoped1",
+			},
+		},
+	}
+
+	ft, found := namespace.FieldType(parent, "field1")
+	if found != true || ft != "scoped1" {
+		t.Fatalf("Scoped field-type not correct: [%v]", ft)
+	}
+
+	ft, found = namespace.FieldType(parent, "field2")
+	if found != true || ft != "unscoped2" {
+		t.Fatalf("Field-type not found outside of scope: [%v]", ft)
+	}
+
+	ft, found = namespace.FieldType(xml.Name{}, "field1")
+	if found != true || ft != "unscoped1" {
+		t.Fatalf("Unscoped field-type not correct: [%v]", ft)
+	}
+
+	_, found = namespace.FieldType(parent, "field3")
+	if found != false {
+		t.Fatalf("Expected miss for unknown field.")
+	}
+}
+
 func TestRegister_Hit(t *testing.T) {
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	uri := "http://some/uri/TestRegister"
@@ -35,8 +74,8 @@ func TestRegister_Hit(t *testing.T) {
 
 	Register(namespace)
 
-	if len(namespaces) != 1 {
-		t.Fatalf("Registrations count not correct: (%d)", len(namespaces))
+	if len(defaultRegistry.namespaces) != 1 {
+		t.Fatalf("Registrations count not correct: (%d)", len(defaultRegistry.namespaces))
 	}
 
 	recalled, err := Get(uri)
@@ -48,11 +87,11 @@ func TestRegister_Hit(t *testing.T) {
 }
 
 func TestClear(t *testing.T) {
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	uri := "http://some/uri/TestRegister"
@@ -64,23 +103,23 @@ func TestClear(t *testing.T) {
 
 	Register(namespace)
 
-	if len(namespaces) != 1 {
-		t.Fatalf("Registrations count not correct: (%d)", len(namespaces))
+	if len(defaultRegistry.namespaces) != 1 {
+		t.Fatalf("Registrations count not correct: (%d)", len(defaultRegistry.namespaces))
 	}
 
 	Clear()
 
-	if len(namespaces) != 0 {
+	if len(defaultRegistry.namespaces) != 0 {
 		t.Fatalf("Registrations not cleared.")
 	}
 }
 
 func TestRegister_Get_Hit(t *testing.T) {
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	uri := "http://some/uri/TestRegister"
@@ -92,8 +131,8 @@ func TestRegister_Get_Hit(t *testing.T) {
 
 	Register(namespace)
 
-	if len(namespaces) != 1 {
-		t.Fatalf("Registrations count not correct: (%d)", len(namespaces))
+	if len(defaultRegistry.namespaces) != 1 {
+		t.Fatalf("Registrations count not correct: (%d)", len(defaultRegistry.namespaces))
 	}
 
 	recalled, err := Get(uri)
@@ -105,11 +144,11 @@ func TestRegister_Get_Hit(t *testing.T) {
 }
 
 func TestRegister_Get_Miss(t *testing.T) {
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	_, err := Get("unknown/uri")
@@ -120,11 +159,11 @@ func TestRegister_Get_Miss(t *testing.T) {
 }
 
 func TestRegister_MustGet_Hit(t *testing.T) {
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	uri := "http://some/uri/TestRegister"
@@ -136,8 +175,8 @@ func TestRegister_MustGet_Hit(t *testing.T) {
 
 	Register(namespace)
 
-	if len(namespaces) != 1 {
-		t.Fatalf("Registrations count not correct: (%d)", len(namespaces))
+	if len(defaultRegistry.namespaces) != 1 {
+		t.Fatalf("Registrations count not correct: (%d)", len(defaultRegistry.namespaces))
 	}
 
 	recalled := MustGet(uri)
@@ -160,11 +199,11 @@ func TestRegister_MustGet_Miss(t *testing.T) {
 		}
 	}()
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	MustGet("unknown/uri")
@@ -0,0 +1,19 @@
+package xmpregistry
+
+import (
+	"errors"
+)
+
+var (
+	// ErrPropertyValueNotValid indicates that the value of a property is not
+	// of the type that was expected for it.
+	ErrPropertyValueNotValid = errors.New("property value not valid")
+)
+
+// PropertyGetter is satisfied by indices that can return the value of a
+// top-level property (e.g. *xmp.XmpPropertyIndex). It allows namespace
+// packages to provide typed accessors without depending on the index.
+type PropertyGetter interface {
+	// GetProperty returns the value of the given top-level property.
+	GetProperty(name XmlName) (value interface{}, err error)
+}
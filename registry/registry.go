@@ -0,0 +1,245 @@
+package xmpregistry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"encoding/xml"
+)
+
+var (
+	// ErrNamespaceAlreadyRegistered indicates that a namespace was registered
+	// with a URI that is already registered.
+	ErrNamespaceAlreadyRegistered = errors.New("namespace already registered")
+)
+
+var (
+	// defaultRegistry is the registry that the package-level functions use and
+	// that the standard namespaces are registered in.
+	defaultRegistry = NewRegistry()
+)
+
+// Registry is a set of namespace registrations. It is safe for concurrent
+// use.
+type Registry struct {
+	lock sync.RWMutex
+
+	// namespaces contains all of the namespace registrations.
+	namespaces map[string]Namespace
+
+	// unknownNamespaces indicates which namespaces have been looked up that we
+	// don't have registrations for. This allows us to log warnings once and
+	// only once.
+	unknownNamespaces map[string]struct{}
+
+	// aliases contains all of the alias registrations keyed by the name of
+	// the alias.
+	aliases map[xml.Name]Alias
+}
+
+// NewRegistry returns a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		namespaces:        make(map[string]Namespace),
+		unknownNamespaces: make(map[string]struct{}),
+		aliases:           make(map[xml.Name]Alias),
+	}
+}
+
+// Default returns the global registry. This is what is used when no other
+// registry is given.
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// Register registers a namespace for access during parsing and indexing.
+// Returns ErrNamespaceAlreadyRegistered if the URI is already registered.
+func (registry *Registry) Register(namespace Namespace) (err error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	if _, found := registry.namespaces[namespace.Uri]; found == true {
+		return ErrNamespaceAlreadyRegistered
+	}
+
+	registry.namespaces[namespace.Uri] = namespace
+	delete(registry.unknownNamespaces, namespace.Uri)
+
+	registry.invalidateCachedPrefixes()
+
+	return nil
+}
+
+// RegisterAll registers all of the given namespaces or, if any URI is already
+// registered or repeated, none of them. In that case, the position of the
+// first conflicting namespace is returned along with
+// ErrNamespaceAlreadyRegistered.
+func (registry *Registry) RegisterAll(namespaces []Namespace) (conflictIndex int, err error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	seen := make(map[string]struct{}, len(namespaces))
+	for i, namespace := range namespaces {
+		if _, found := registry.namespaces[namespace.Uri]; found == true {
+			return i, ErrNamespaceAlreadyRegistered
+		} else if _, found := seen[namespace.Uri]; found == true {
+			return i, ErrNamespaceAlreadyRegistered
+		}
+
+		seen[namespace.Uri] = struct{}{}
+	}
+
+	for _, namespace := range namespaces {
+		registry.namespaces[namespace.Uri] = namespace
+		delete(registry.unknownNamespaces, namespace.Uri)
+	}
+
+	registry.invalidateCachedPrefixes()
+
+	return 0, nil
+}
+
+// Clear removes all namespace and alias registrations.
+func (registry *Registry) Clear() {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	registry.namespaces = make(map[string]Namespace)
+	registry.unknownNamespaces = make(map[string]struct{})
+	registry.aliases = make(map[xml.Name]Alias)
+
+	registry.invalidateCachedPrefixes()
+}
+
+// invalidateCachedPrefixes clears the prefix cache used by XmlName if this is
+// the default registry, which is the one that the cache is loaded from.
+func (registry *Registry) invalidateCachedPrefixes() {
+	if registry == defaultRegistry {
+		ClearCachedPrefixes()
+	}
+}
+
+// Clone returns a new registry with the same registrations. Registrations
+// made to either one afterward are not seen by the other, nor are changes to
+// the field maps of the namespaces. This allows custom namespaces to be added
+// to a copy of the standard ones.
+func (registry *Registry) Clone() *Registry {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	cloned := NewRegistry()
+
+	for uri, namespace := range registry.namespaces {
+		cloned.namespaces[uri] = namespace.clone()
+	}
+
+	for name, alias := range registry.aliases {
+		cloned.aliases[name] = alias
+	}
+
+	return cloned
+}
+
+// Get returns the namespace registration for the given URI. Since namespaces
+// URIs are strictly defined, no normalization is required.
+func (registry *Registry) Get(uri string) (namespace Namespace, err error) {
+	registry.lock.RLock()
+	namespace, found := registry.namespaces[uri]
+	registry.lock.RUnlock()
+
+	if found == true {
+		return namespace, nil
+	}
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	if _, found := registry.unknownNamespaces[uri]; found == false {
+		namespaceLogger.Warningf(
+			nil,
+			"Namespace [%s] was requested but is not known.",
+			uri)
+
+		registry.unknownNamespaces[uri] = struct{}{}
+	}
+
+	return Namespace{}, ErrNamespaceNotFound
+}
+
+// Prefix returns the preferred-prefix for the given namespace if registered,
+// else "?".
+func (registry *Registry) Prefix(uri string) string {
+	namespace, err := registry.Get(uri)
+	if err != nil {
+		return "?"
+	}
+
+	return namespace.PreferredPrefix
+}
+
+// NamePhrase returns the name as "[prefix]local" using the preferred-prefix
+// registered with this registry.
+func (registry *Registry) NamePhrase(name XmlName) string {
+	return fmt.Sprintf("[%s]%s", registry.Prefix(name.Space), name.Local)
+}
+
+// PropertyNamePhrase returns the constituent names of the property joined by
+// dots (see NamePhrase).
+func (registry *Registry) PropertyNamePhrase(xpn XmpPropertyName) string {
+	parts := make([]string, len(xpn))
+	for i, name := range xpn {
+		parts[i] = registry.NamePhrase(name)
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// MustGet returns the Namespace struct associated with the given URI. It panics
+// if not known.
+func (registry *Registry) MustGet(uri string) (namespace Namespace) {
+	namespace, err := registry.Get(uri)
+	if err != nil {
+		panic(err)
+	}
+
+	return namespace
+}
+
+// List returns every registered namespace sorted by preferred-prefix and then
+// by URI.
+func (registry *Registry) List() (namespaces []Namespace) {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	namespaces = make([]Namespace, 0, len(registry.namespaces))
+	for _, namespace := range registry.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool {
+		if namespaces[i].PreferredPrefix != namespaces[j].PreferredPrefix {
+			return namespaces[i].PreferredPrefix < namespaces[j].PreferredPrefix
+		}
+
+		return namespaces[i].Uri < namespaces[j].Uri
+	})
+
+	return namespaces
+}
+
+// LookupByPrefix returns the namespace with the given preferred-prefix.
+// Prefixes are not required to be unique, so if more than one namespace has
+// it then the one with the lowest URI is returned. Returns
+// ErrNamespaceNotFound if none do.
+func (registry *Registry) LookupByPrefix(prefix string) (namespace Namespace, err error) {
+	for _, namespace := range registry.List() {
+		if namespace.PreferredPrefix == prefix {
+			return namespace, nil
+		}
+	}
+
+	return Namespace{}, ErrNamespaceNotFound
+}
@@ -0,0 +1,260 @@
+package xmpregistry
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestRegistry_Register(t *testing.T) {
+	registry := NewRegistry()
+
+	namespace := Namespace{
+		Uri:             "http://some/uri/TestRegistry",
+		PreferredPrefix: "TestRegistry",
+	}
+
+	err := registry.Register(namespace)
+	log.PanicIf(err)
+
+	recalled, err := registry.Get(namespace.Uri)
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(recalled, namespace) != true {
+		t.Fatalf("Recalled namespace not correct: %s", recalled)
+	}
+
+	err = registry.Register(namespace)
+	if err != ErrNamespaceAlreadyRegistered {
+		t.Fatalf("Expected error for duplicate registration: [%v]", err)
+	}
+}
+
+func TestRegistry_RegisterAll(t *testing.T) {
+	registry := NewRegistry()
+
+	existing := Namespace{Uri: "http://some/uri/existing", PreferredPrefix: "existing"}
+
+	err := registry.Register(existing)
+	log.PanicIf(err)
+
+	namespaces := []Namespace{
+		{Uri: "http://some/uri/a", PreferredPrefix: "a"},
+		existing,
+	}
+
+	i, err := registry.RegisterAll(namespaces)
+	if err != ErrNamespaceAlreadyRegistered {
+		t.Fatalf("Expected error for duplicate registration: [%v]", err)
+	} else if i != 1 {
+		t.Fatalf("Conflict index not correct: (%d)", i)
+	} else if _, found := registry.namespaces["http://some/uri/a"]; found != false {
+		t.Fatalf("Expected nothing to be registered.")
+	}
+
+	namespaces = []Namespace{
+		{Uri: "http://some/uri/a", PreferredPrefix: "a"},
+		{Uri: "http://some/uri/a", PreferredPrefix: "a2"},
+	}
+
+	i, err = registry.RegisterAll(namespaces)
+	if err != ErrNamespaceAlreadyRegistered {
+		t.Fatalf("Expected error for repeated namespace: [%v]", err)
+	} else if i != 1 {
+		t.Fatalf("Conflict index not correct: (%d)", i)
+	}
+
+	_, err = registry.RegisterAll(namespaces[:1])
+	log.PanicIf(err)
+
+	if _, err := registry.Get("http://some/uri/a"); err != nil {
+		t.Fatalf("Namespace not registered: [%v]", err)
+	}
+}
+
+func TestRegistry_Get_Miss(t *testing.T) {
+	registry := NewRegistry()
+
+	_, err := registry.Get("unknown/uri")
+	if err != ErrNamespaceNotFound {
+		t.Fatalf("Expected namespace miss for unknown namespace-URI: [%v]", err)
+	}
+
+	if _, found := registry.unknownNamespaces["unknown/uri"]; found != true {
+		t.Fatalf("Expected unknown namespace to be recorded.")
+	}
+}
+
+func TestRegistry_Clear(t *testing.T) {
+	registry := NewRegistry()
+
+	err := registry.Register(Namespace{Uri: "http://some/uri/TestRegistry"})
+	log.PanicIf(err)
+
+	registry.Clear()
+
+	if len(registry.namespaces) != 0 {
+		t.Fatalf("Registrations not cleared.")
+	}
+}
+
+func TestRegistry_Clone(t *testing.T) {
+	original := NewRegistry()
+
+	err := original.Register(Namespace{Uri: "http://some/uri/Shared"})
+	log.PanicIf(err)
+
+	cloned := original.Clone()
+
+	err = cloned.Register(Namespace{Uri: "http://some/uri/Cloned"})
+	log.PanicIf(err)
+
+	err = original.Register(Namespace{Uri: "http://some/uri/Original"})
+	log.PanicIf(err)
+
+	if _, err := cloned.Get("http://some/uri/Shared"); err != nil {
+		t.Fatalf("Clone does not have original registration: [%v]", err)
+	} else if _, err := cloned.Get("http://some/uri/Original"); err != ErrNamespaceNotFound {
+		t.Fatalf("Clone sees later registration in original: [%v]", err)
+	} else if _, err := original.Get("http://some/uri/Cloned"); err != ErrNamespaceNotFound {
+		t.Fatalf("Original sees registration in clone: [%v]", err)
+	}
+}
+
+func TestRegistry_Clone_Fields(t *testing.T) {
+	parent := xml.Name{Space: "http://some/uri", Local: "parent"}
+
+	original := NewRegistry()
+
+	err := original.Register(Namespace{
+		Uri:               "http://some/uri",
+		Fields:            map[string]interface{}{"field1": "type1"},
+		ScopedFields:      map[xml.Name]map[string]interface{}{parent: {"field2": "type2"}},
+		FieldDescriptions: map[string]string{"field1": "description1"},
+	})
+
+	log.PanicIf(err)
+
+	cloned := original.Clone()
+
+	namespace := original.MustGet("http://some/uri")
+	namespace.Fields["field3"] = "type3"
+	namespace.ScopedFields[parent]["field4"] = "type4"
+	namespace.FieldDescriptions["field3"] = "description3"
+
+	clonedNamespace := cloned.MustGet("http://some/uri")
+
+	if reflect.DeepEqual(clonedNamespace.Fields, map[string]interface{}{"field1": "type1"}) != true {
+		t.Fatalf("Clone shares fields: %v", clonedNamespace.Fields)
+	} else if reflect.DeepEqual(clonedNamespace.ScopedFields[parent], map[string]interface{}{"field2": "type2"}) != true {
+		t.Fatalf("Clone shares scoped fields: %v", clonedNamespace.ScopedFields)
+	} else if reflect.DeepEqual(clonedNamespace.FieldDescriptions, map[string]string{"field1": "description1"}) != true {
+		t.Fatalf("Clone shares field descriptions: %v", clonedNamespace.FieldDescriptions)
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	registry := NewRegistry()
+
+	wg := new(sync.WaitGroup)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			uri := fmt.Sprintf("http://some/uri/TestRegistry%d", i)
+
+			err := registry.Register(Namespace{Uri: uri})
+			log.PanicIf(err)
+
+			for j := 0; j < 100; j++ {
+				registry.Get(uri)
+				registry.Get("unknown/uri")
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if len(registry.namespaces) != 10 {
+		t.Fatalf("Registrations count not correct: (%d)", len(registry.namespaces))
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if Default() != defaultRegistry {
+		t.Fatalf("Default registry not correct.")
+	}
+}
+
+func TestRegistry_List(t *testing.T) {
+	registry := NewRegistry()
+
+	namespaces := []Namespace{
+		{Uri: "http://some/uri/b", PreferredPrefix: "b"},
+		{Uri: "http://some/uri/a2", PreferredPrefix: "a"},
+		{Uri: "http://some/uri/a1", PreferredPrefix: "a"},
+	}
+
+	for _, namespace := range namespaces {
+		err := registry.Register(namespace)
+		log.PanicIf(err)
+	}
+
+	listed := registry.List()
+
+	uris := make([]string, len(listed))
+	for i, namespace := range listed {
+		uris[i] = namespace.Uri
+	}
+
+	expected := []string{"http://some/uri/a1", "http://some/uri/a2", "http://some/uri/b"}
+	if reflect.DeepEqual(uris, expected) != true {
+		t.Fatalf("Listed namespaces not correct: %v", uris)
+	}
+}
+
+func TestRegistry_LookupByPrefix(t *testing.T) {
+	registry := NewRegistry()
+
+	err := registry.Register(Namespace{Uri: "http://some/uri/a2", PreferredPrefix: "a"})
+	log.PanicIf(err)
+
+	err = registry.Register(Namespace{Uri: "http://some/uri/a1", PreferredPrefix: "a"})
+	log.PanicIf(err)
+
+	namespace, err := registry.LookupByPrefix("a")
+	log.PanicIf(err)
+
+	if namespace.Uri != "http://some/uri/a1" {
+		t.Fatalf("Namespace not correct: %s", namespace)
+	}
+
+	_, err = registry.LookupByPrefix("b")
+	if err != ErrNamespaceNotFound {
+		t.Fatalf("Expected miss for unknown prefix: [%v]", err)
+	}
+}
+
+func TestRegistry_PropertyNamePhrase(t *testing.T) {
+	registry := NewRegistry()
+
+	err := registry.Register(Namespace{Uri: "http://some/uri/a", PreferredPrefix: "a"})
+	log.PanicIf(err)
+
+	xpn := XmpPropertyName{
+		{Space: "http://some/uri/a", Local: "Outer"},
+		{Space: "http://some/uri/b", Local: "Inner"},
+	}
+
+	if phrase := registry.PropertyNamePhrase(xpn); phrase != "[a]Outer.[?]Inner" {
+		t.Fatalf("Phrase not correct: [%s]", phrase)
+	}
+}
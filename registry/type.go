@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"encoding/xml"
 
@@ -16,22 +17,36 @@ var (
 
 var (
 	// TODO(dustin): This has questionable savings. It just swaps one lookup with another with maybe only a constant savings.
-	cachedPrefixes = make(map[string]string)
+	cachedPrefixes     = make(map[string]string)
+	cachedPrefixesLock sync.RWMutex
+
+	// cachedPrefixesGeneration is incremented whenever the cache is cleared so
+	// that a lookup that raced with a registration isn't cached.
+	cachedPrefixesGeneration int
 )
 
 // ClearCachedPrefixes clears the namespace-prefix cache that is loaded from
-// Get().
+// Get(). This is done whenever namespaces are registered with or cleared from
+// the default registry.
 func ClearCachedPrefixes() {
+	cachedPrefixesLock.Lock()
+	defer cachedPrefixesLock.Unlock()
+
 	cachedPrefixes = make(map[string]string)
+	cachedPrefixesGeneration++
 }
 
 // XmlName is a localized version of xml.Name with a String() method attached.
 type XmlName xml.Name
 
-// Prefix returns the preferred-prefix for the given namespace if registered,
-// else "?".
+// Prefix returns the preferred-prefix for the given namespace if registered
+// with the default registry, else "?".
 func (xn XmlName) Prefix() string {
+	cachedPrefixesLock.RLock()
 	prefix, found := cachedPrefixes[xn.Space]
+	generation := cachedPrefixesGeneration
+	cachedPrefixesLock.RUnlock()
+
 	if found == true {
 		return prefix
 	}
@@ -48,7 +63,11 @@ func (xn XmlName) Prefix() string {
 		prefix = ns.PreferredPrefix
 	}
 
-	cachedPrefixes[xn.Space] = prefix
+	cachedPrefixesLock.Lock()
+	if cachedPrefixesGeneration == generation {
+		cachedPrefixes[xn.Space] = prefix
+	}
+	cachedPrefixesLock.Unlock()
 
 	return prefix
 }
@@ -27,11 +27,11 @@ func TestClearCachedPrefixes(t *testing.T) {
 func TestXmlName_Prefix_Known(t *testing.T) {
 	// Stage.
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	ClearCachedPrefixes()
@@ -70,11 +70,11 @@ func TestXmlName_Prefix_Known(t *testing.T) {
 func TestXmlName_String_Known(t *testing.T) {
 	// Stage.
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	ClearCachedPrefixes()
@@ -113,11 +113,11 @@ func TestXmlName_String_Known(t *testing.T) {
 func TestXmlName_Prefix_NotKnown(t *testing.T) {
 	// Stage.
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	ClearCachedPrefixes()
@@ -146,14 +146,57 @@ func TestXmlName_Prefix_NotKnown(t *testing.T) {
 	}
 }
 
+func TestXmlName_Prefix_RegisteredLater(t *testing.T) {
+	// Stage.
+
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
+
+	defer func() {
+		defaultRegistry = originalRegistry
+	}()
+
+	ClearCachedPrefixes()
+
+	namespaceUri := "http://ns.adobe.com/pdf/1.3/"
+
+	name := XmlName{
+		Space: namespaceUri,
+		Local: "bb",
+	}
+
+	if name.Prefix() != "?" {
+		t.Fatalf("Prefix not correct before registration: [%s]", name.Prefix())
+	}
+
+	// Test.
+
+	namespace := Namespace{
+		Uri:             namespaceUri,
+		PreferredPrefix: "pdf",
+	}
+
+	Register(namespace)
+
+	if name.Prefix() != "pdf" {
+		t.Fatalf("Prefix not correct after registration: [%s]", name.Prefix())
+	}
+
+	Clear()
+
+	if name.Prefix() != "?" {
+		t.Fatalf("Prefix not correct after clearing: [%s]", name.Prefix())
+	}
+}
+
 func TestXmlName_String_NotKnown(t *testing.T) {
 	// Stage.
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	ClearCachedPrefixes()
@@ -173,11 +216,11 @@ func TestXmlName_String_NotKnown(t *testing.T) {
 func TestXmpPropertyName_Parts(t *testing.T) {
 	// Stage.
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	ClearCachedPrefixes()
@@ -222,11 +265,11 @@ func TestXmpPropertyName_Parts(t *testing.T) {
 func TestXmpPropertyName_String(t *testing.T) {
 	// Stage.
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	ClearCachedPrefixes()
@@ -266,11 +309,11 @@ func TestXmpPropertyName_String(t *testing.T) {
 func TestInlineAttributes(t *testing.T) {
 	// Stage.
 
-	originalNamespaces := namespaces
-	namespaces = make(map[string]Namespace)
+	originalRegistry := defaultRegistry
+	defaultRegistry = NewRegistry()
 
 	defer func() {
-		namespaces = originalNamespaces
+		defaultRegistry = originalRegistry
 	}()
 
 	ClearCachedPrefixes()
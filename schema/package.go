@@ -0,0 +1,3 @@
+// Package xmpschema loads namespace descriptions from declarative JSON or YAML
+// documents so that custom namespaces can be registered without code.
+package xmpschema
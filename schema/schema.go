@@ -0,0 +1,416 @@
+package xmpschema
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+	"gopkg.in/yaml.v2"
+
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+var (
+	// ErrSchemaFormatNotSupported indicates that the format of a schema file
+	// could not be determined from its extension.
+	ErrSchemaFormatNotSupported = errors.New("schema format not supported")
+)
+
+const (
+	// TypeStruct is the type of a field whose value is a struct of the
+	// nested fields.
+	TypeStruct = "Struct"
+
+	// TypeChoice is the type of a field whose value is one of the given
+	// choices.
+	TypeChoice = "Choice"
+)
+
+const (
+	// ArraySeq is an ordered array ("rdf:Seq").
+	ArraySeq = "seq"
+
+	// ArrayBag is an unordered array ("rdf:Bag").
+	ArrayBag = "bag"
+
+	// ArrayAlt is an array of alternatives ("rdf:Alt").
+	ArrayAlt = "alt"
+
+	// ArrayLangAlt is an array of language alternatives. The type must be
+	// Text.
+	ArrayLangAlt = "lang-alt"
+)
+
+// Choice is a single defined choice of a Choice field.
+type Choice struct {
+	// Code is the value as stored in the document.
+	Code string `json:"code" yaml:"code"`
+
+	// Label is the human-readable description of the code.
+	Label string `json:"label" yaml:"label"`
+}
+
+// Field describes a single field of a namespace or of a struct.
+type Field struct {
+	// Name is the local name of the field.
+	Name string `json:"name" yaml:"name"`
+
+	// Type is the name of the type of the value (e.g. "Text" or "Date"),
+	// "Choice", or "Struct".
+	Type string `json:"type" yaml:"type"`
+
+	// Array is the kind of array ("seq", "bag", "alt", or "lang-alt") if the
+	// field is an array of values of the type. Empty if the field is not an
+	// array.
+	Array string `json:"array,omitempty" yaml:"array,omitempty"`
+
+	// Closed indicates that the value of a Choice field must be one of the
+	// choices.
+	Closed bool `json:"closed,omitempty" yaml:"closed,omitempty"`
+
+	// Choices are the choices of a Choice field.
+	Choices []Choice `json:"choices,omitempty" yaml:"choices,omitempty"`
+
+	// Fields are the fields of a Struct field. They are in the same
+	// namespace.
+	Fields []Field `json:"fields,omitempty" yaml:"fields,omitempty"`
+
+	// Description is a human-readable description of the field.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// Namespace describes a single namespace.
+type Namespace struct {
+	// Uri is the URI of the namespace.
+	Uri string `json:"uri" yaml:"uri"`
+
+	// Prefix is the preferred prefix of the namespace.
+	Prefix string `json:"prefix" yaml:"prefix"`
+
+	// Fields are the top-level fields of the namespace.
+	Fields []Field `json:"fields" yaml:"fields"`
+
+	// Description is a human-readable description of the namespace.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// SpecReference is the document (and section, if any) that defines the
+	// namespace.
+	SpecReference string `json:"specReference,omitempty" yaml:"specReference,omitempty"`
+}
+
+// Schema is a set of namespace descriptions.
+type Schema struct {
+	// Namespaces are the described namespaces.
+	Namespaces []Namespace `json:"namespaces" yaml:"namespaces"`
+}
+
+// ParseJson decodes a schema from JSON. Unknown keys are not valid.
+func ParseJson(r io.Reader) (schema *Schema, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+
+	schema = new(Schema)
+
+	err = d.Decode(schema)
+	log.PanicIf(err)
+
+	return schema, nil
+}
+
+// ParseYaml decodes a schema from YAML. Unknown keys are not valid.
+func ParseYaml(r io.Reader) (schema *Schema, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	data, err := ioutil.ReadAll(r)
+	log.PanicIf(err)
+
+	schema = new(Schema)
+
+	err = yaml.UnmarshalStrict(data, schema)
+	log.PanicIf(err)
+
+	return schema, nil
+}
+
+// entryPanicf panics with an error that identifies the schema entry that is
+// not valid.
+func entryPanicf(entry string, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Panicf("schema entry not valid: [%s]: %s", entry, message)
+}
+
+// namespaceBuilder builds the field-types of a single namespace.
+type namespaceBuilder struct {
+	namespace xmpregistry.Namespace
+}
+
+// valueType returns the field-type of a single value of the field.
+func (nb *namespaceBuilder) valueType(entry string, field Field) interface{} {
+	if field.Type != TypeChoice && (field.Closed == true || len(field.Choices) > 0) {
+		entryPanicf(entry, "choices given for non-choice type: [%s]", field.Type)
+	} else if field.Type != TypeStruct && len(field.Fields) > 0 {
+		entryPanicf(entry, "fields given for non-struct type: [%s]", field.Type)
+	}
+
+	switch field.Type {
+	case "":
+		entryPanicf(entry, "type not given")
+	case TypeChoice:
+		if len(field.Choices) == 0 {
+			entryPanicf(entry, "no choices given")
+		}
+
+		choices := make([]xmptype.ChoiceDefinition, len(field.Choices))
+		for i, choice := range field.Choices {
+			if choice.Code == "" {
+				entryPanicf(fmt.Sprintf("%s.choices[%d]", entry, i), "code not given")
+			}
+
+			choices[i] = xmptype.ChoiceDefinition{
+				Code:  choice.Code,
+				Label: choice.Label,
+			}
+		}
+
+		ft := xmptype.DefinedChoiceFieldType{
+			Choices:  choices,
+			IsClosed: field.Closed,
+		}
+
+		return ft
+	case TypeStruct:
+		if len(field.Fields) == 0 {
+			entryPanicf(entry, "no fields given for struct")
+		}
+
+		// The fields of the struct (or of the items of an array of structs)
+		// are directly within this field.
+
+		parent := xml.Name{
+			Space: nb.namespace.Uri,
+			Local: field.Name,
+		}
+
+		nb.addFields(entry, parent, field.Fields)
+
+		return xmptype.GenericStructFieldType{}
+	}
+
+	ft, found := xmptype.LookupFieldTypeByName(field.Type)
+	if found == false {
+		entryPanicf(entry, "type not known: [%s]", field.Type)
+	}
+
+	return ft
+}
+
+// fieldType returns the field-type of the field.
+func (nb *namespaceBuilder) fieldType(entry string, field Field) interface{} {
+	ft := nb.valueType(entry, field)
+
+	switch field.Array {
+	case "":
+		return ft
+	case ArraySeq:
+		return xmptype.OrderedArrayFieldType{ItemType: ft}
+	case ArrayBag:
+		return xmptype.UnorderedArrayFieldType{ItemType: ft}
+	case ArrayAlt:
+		return xmptype.AlternativeArrayFieldType{ItemType: ft}
+	case ArrayLangAlt:
+		if field.Type != "Text" {
+			entryPanicf(entry, "language alternatives must be text: [%s]", field.Type)
+		}
+
+		return xmptype.LanguageAlternativeArrayFieldType{}
+	}
+
+	entryPanicf(entry, "array kind not valid: [%s]", field.Array)
+
+	// Not reachable.
+	return nil
+}
+
+// addFields adds the given fields. Top-level fields have an empty parent.
+// Struct fields are scoped to the struct.
+func (nb *namespaceBuilder) addFields(parentEntry string, parent xml.Name, fields []Field) {
+	if parent.Local != "" {
+		if _, found := nb.namespace.ScopedFields[parent]; found == true {
+			entryPanicf(parentEntry, "struct defined more than once: [%s]", parent.Local)
+		}
+
+		nb.namespace.ScopedFields[parent] = make(map[string]interface{})
+	}
+
+	for i, field := range fields {
+		entry := fmt.Sprintf("%s.fields[%d]", parentEntry, i)
+
+		if field.Name == "" {
+			entryPanicf(entry, "name not given")
+		}
+
+		entry = fmt.Sprintf("%s(%s)", entry, field.Name)
+
+		siblings := nb.namespace.Fields
+		if parent.Local != "" {
+			siblings = nb.namespace.ScopedFields[parent]
+		}
+
+		if _, found := siblings[field.Name]; found == true {
+			entryPanicf(entry, "field defined more than once")
+		}
+
+		siblings[field.Name] = nb.fieldType(entry, field)
+
+		// Descriptions are shared by every field with the same name, so the
+		// first one given is kept.
+
+		if _, found := nb.namespace.FieldDescriptions[field.Name]; found == false && field.Description != "" {
+			nb.namespace.FieldDescriptions[field.Name] = field.Description
+		}
+	}
+}
+
+// Build returns the registry namespaces described by the schema. Any entry
+// that is not valid fails the whole schema.
+func (schema *Schema) Build() (namespaces []xmpregistry.Namespace, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	namespaces = make([]xmpregistry.Namespace, len(schema.Namespaces))
+	seen := make(map[string]struct{})
+
+	for i, namespace := range schema.Namespaces {
+		entry := fmt.Sprintf("namespaces[%d]", i)
+
+		if namespace.Uri == "" {
+			entryPanicf(entry, "URI not given")
+		} else if namespace.Prefix == "" {
+			entryPanicf(entry, "prefix not given")
+		}
+
+		entry = fmt.Sprintf("%s(%s)", entry, namespace.Prefix)
+
+		if _, found := seen[namespace.Uri]; found == true {
+			entryPanicf(entry, "namespace defined more than once: [%s]", namespace.Uri)
+		}
+
+		seen[namespace.Uri] = struct{}{}
+
+		nb := &namespaceBuilder{
+			namespace: xmpregistry.Namespace{
+				Uri:               namespace.Uri,
+				PreferredPrefix:   namespace.Prefix,
+				Fields:            make(map[string]interface{}),
+				ScopedFields:      make(map[xml.Name]map[string]interface{}),
+				Description:       namespace.Description,
+				SpecReference:     namespace.SpecReference,
+				FieldDescriptions: make(map[string]string),
+			},
+		}
+
+		nb.addFields(entry, xml.Name{}, namespace.Fields)
+
+		namespaces[i] = nb.namespace
+	}
+
+	return namespaces, nil
+}
+
+// Register registers the namespaces described by the schema with the given
+// registry. Nothing is registered if any entry is not valid or if any of the
+// namespaces is already registered.
+func (schema *Schema) Register(registry *xmpregistry.Registry) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	namespaces, err := schema.Build()
+	log.PanicIf(err)
+
+	i, err := registry.RegisterAll(namespaces)
+	if err == xmpregistry.ErrNamespaceAlreadyRegistered {
+		namespace := namespaces[i]
+
+		entry := fmt.Sprintf("namespaces[%d](%s)", i, namespace.PreferredPrefix)
+		entryPanicf(entry, "namespace already registered: [%s]", namespace.Uri)
+	}
+
+	log.PanicIf(err)
+
+	return nil
+}
+
+// ParseFile parses the given JSON (".json") or YAML (".yaml" or ".yml")
+// schema file.
+func ParseFile(filename string) (schema *Schema, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	f, err := os.Open(filename)
+	log.PanicIf(err)
+
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		schema, err = ParseJson(f)
+	case ".yaml", ".yml":
+		schema, err = ParseYaml(f)
+	default:
+		log.Panic(ErrSchemaFormatNotSupported)
+	}
+
+	if err != nil {
+		log.Panicf("schema file not valid: [%s]: %s", filename, err)
+	}
+
+	return schema, nil
+}
+
+// LoadFile parses the given JSON (".json") or YAML (".yaml" or ".yml") schema
+// file and registers its namespaces with the given registry.
+func LoadFile(registry *xmpregistry.Registry, filename string) (err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	schema, err := ParseFile(filename)
+	log.PanicIf(err)
+
+	err = schema.Register(registry)
+	if err != nil {
+		log.Panicf("schema file not valid: [%s]: %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package xmpschema
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp"
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+const (
+	testPartnerUri = "http://ns.partner.example.com/1.0/"
+)
+
+var (
+	testSchemaYaml = `
+namespaces:
+  - uri: http://ns.partner.example.com/1.0/
+    prefix: partner
+    description: Partner metadata
+    specReference: Partner Guide
+    fields:
+      - name: Title
+        type: Text
+        array: lang-alt
+        description: The title
+      - name: Rating
+        type: Choice
+        closed: true
+        choices:
+          - code: "1"
+            label: Poor
+          - code: "2"
+            label: Good
+      - name: Keywords
+        type: Text
+        array: bag
+      - name: Location
+        type: Struct
+        fields:
+          - name: City
+            type: Text
+          - name: Latitude
+            type: Real
+`
+
+	testSchemaJson = `{
+  "namespaces": [
+    {
+      "uri": "http://ns.partner.example.com/1.0/",
+      "prefix": "partner",
+      "description": "Partner metadata",
+      "specReference": "Partner Guide",
+      "fields": [
+        {"name": "Title", "type": "Text", "array": "lang-alt", "description": "The title"},
+        {"name": "Rating", "type": "Choice", "closed": true, "choices": [{"code": "1", "label": "Poor"}, {"code": "2", "label": "Good"}]},
+        {"name": "Keywords", "type": "Text", "array": "bag"},
+        {"name": "Location", "type": "Struct", "fields": [{"name": "City", "type": "Text"}, {"name": "Latitude", "type": "Real"}]}
+      ]
+    }
+  ]
+}`
+
+	testExpectedNamespace = xmpregistry.Namespace{
+		Uri:             testPartnerUri,
+		PreferredPrefix: "partner",
+		Fields: map[string]interface{}{
+			"Title": xmptype.LanguageAlternativeArrayFieldType{},
+			"Rating": xmptype.DefinedChoiceFieldType{
+				Choices: []xmptype.ChoiceDefinition{
+					{Code: "1", Label: "Poor"},
+					{Code: "2", Label: "Good"},
+				},
+				IsClosed: true,
+			},
+			"Keywords": xmptype.UnorderedArrayFieldType{ItemType: xmptype.TextFieldType{}},
+			"Location": xmptype.GenericStructFieldType{},
+		},
+		ScopedFields: map[xml.Name]map[string]interface{}{
+			{Space: testPartnerUri, Local: "Location"}: {
+				"City":     xmptype.TextFieldType{},
+				"Latitude": xmptype.RealFieldType{},
+			},
+		},
+		Description:   "Partner metadata",
+		SpecReference: "Partner Guide",
+		FieldDescriptions: map[string]string{
+			"Title": "The title",
+		},
+	}
+)
+
+func TestParseYaml(t *testing.T) {
+	schema, err := ParseYaml(bytes.NewBufferString(testSchemaYaml))
+	log.PanicIf(err)
+
+	namespaces, err := schema.Build()
+	log.PanicIf(err)
+
+	if len(namespaces) != 1 {
+		t.Fatalf("Expected exactly one namespace: %v", namespaces)
+	} else if reflect.DeepEqual(namespaces[0], testExpectedNamespace) != true {
+		t.Fatalf("Namespace not correct: %v", namespaces[0])
+	}
+}
+
+func TestParseJson(t *testing.T) {
+	schema, err := ParseJson(bytes.NewBufferString(testSchemaJson))
+	log.PanicIf(err)
+
+	namespaces, err := schema.Build()
+	log.PanicIf(err)
+
+	if len(namespaces) != 1 {
+		t.Fatalf("Expected exactly one namespace: %v", namespaces)
+	} else if reflect.DeepEqual(namespaces[0], testExpectedNamespace) != true {
+		t.Fatalf("Namespace not correct: %v", namespaces[0])
+	}
+}
+
+func TestParseJson_UnknownKey(t *testing.T) {
+	_, err := ParseJson(bytes.NewBufferString(`{"namespaces": [{"uri": "a", "prefix": "b", "feilds": []}]}`))
+	if err == nil {
+		t.Fatalf("Expected error for unknown key.")
+	}
+}
+
+func TestSchema_Build_NotValid(t *testing.T) {
+	cases := []struct {
+		schema   string
+		expected string
+	}{
+		{
+			schema:   `{"namespaces": [{"prefix": "a"}]}`,
+			expected: "[namespaces[0]]: URI not given",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a"}, {"uri": "http://a/", "prefix": "b"}]}`,
+			expected: "[namespaces[1](b)]: namespace defined more than once",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a", "fields": [{"name": "f1", "type": "Text"}, {"name": "f2", "type": "Texts"}]}]}`,
+			expected: "[namespaces[0](a).fields[1](f2)]: type not known: [Texts]",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a", "fields": [{"name": "f1", "type": "Text"}, {"name": "f1", "type": "Text"}]}]}`,
+			expected: "[namespaces[0](a).fields[1](f1)]: field defined more than once",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a", "fields": [{"name": "f1", "type": "Text", "array": "list"}]}]}`,
+			expected: "[namespaces[0](a).fields[0](f1)]: array kind not valid: [list]",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a", "fields": [{"name": "f1", "type": "Integer", "array": "lang-alt"}]}]}`,
+			expected: "language alternatives must be text",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a", "fields": [{"name": "f1", "type": "Choice"}]}]}`,
+			expected: "[namespaces[0](a).fields[0](f1)]: no choices given",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a", "fields": [{"name": "f1", "type": "Text", "choices": [{"code": "x"}]}]}]}`,
+			expected: "choices given for non-choice type: [Text]",
+		},
+		{
+			schema:   `{"namespaces": [{"uri": "http://a/", "prefix": "a", "fields": [{"name": "s1", "type": "Struct", "fields": [{"name": "f1", "type": "Bogus"}]}]}]}`,
+			expected: "[namespaces[0](a).fields[0](s1).fields[0](f1)]: type not known: [Bogus]",
+		},
+	}
+
+	for i, c := range cases {
+		schema, err := ParseJson(bytes.NewBufferString(c.schema))
+		log.PanicIf(err)
+
+		_, err = schema.Build()
+		if err == nil {
+			t.Fatalf("Expected error for case (%d).", i)
+		} else if strings.Contains(err.Error(), c.expected) != true {
+			t.Fatalf("Error for case (%d) not correct: [%s]", i, err)
+		}
+	}
+}
+
+func TestSchema_Register(t *testing.T) {
+	schema, err := ParseYaml(bytes.NewBufferString(testSchemaYaml))
+	log.PanicIf(err)
+
+	registry := xmpregistry.NewRegistry()
+
+	err = schema.Register(registry)
+	log.PanicIf(err)
+
+	namespace, err := registry.Get(testPartnerUri)
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(namespace, testExpectedNamespace) != true {
+		t.Fatalf("Registered namespace not correct: %v", namespace)
+	}
+
+	err = schema.Register(registry)
+	if err == nil {
+		t.Fatalf("Expected error for namespace already registered.")
+	} else if strings.Contains(err.Error(), "[namespaces[0](partner)]: namespace already registered") != true {
+		t.Fatalf("Error not correct: [%s]", err)
+	}
+}
+
+func TestSchema_Register_Parse(t *testing.T) {
+	schema, err := ParseYaml(bytes.NewBufferString(testSchemaYaml))
+	log.PanicIf(err)
+
+	registry := xmpregistry.Default().Clone()
+
+	err = schema.Register(registry)
+	log.PanicIf(err)
+
+	document := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:partner="http://ns.partner.example.com/1.0/" partner:Rating="2">
+      <partner:Location partner:City="Lyon">
+        <partner:Latitude>45.76</partner:Latitude>
+      </partner:Location>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	xp := xmp.NewParserWithRegistry(bytes.NewBufferString(document), registry)
+
+	xpi, err := xp.Parse()
+	log.PanicIf(err)
+
+	rootName := xmpregistry.XmlName{Space: xmpnamespace.XUri, Local: "xmpmeta"}
+	locationName := xmpregistry.XmlName{Space: testPartnerUri, Local: "Location"}
+
+	parsed, err := xpi.GetStruct(xmpregistry.XmpPropertyName{rootName, locationName})
+	log.PanicIf(err)
+
+	expected := xmptype.StructValue{
+		{Space: testPartnerUri, Local: "City"}:     "Lyon",
+		{Space: testPartnerUri, Local: "Latitude"}: 45.76,
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Struct not correct: %v", parsed)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	tempPath, err := ioutil.TempDir("", "")
+	log.PanicIf(err)
+
+	defer os.RemoveAll(tempPath)
+
+	for _, filename := range []string{"partner.yaml", "partner.json"} {
+		filepath := path.Join(tempPath, filename)
+
+		content := testSchemaYaml
+		if path.Ext(filename) == ".json" {
+			content = testSchemaJson
+		}
+
+		err := ioutil.WriteFile(filepath, []byte(content), 0644)
+		log.PanicIf(err)
+
+		registry := xmpregistry.NewRegistry()
+
+		err = LoadFile(registry, filepath)
+		log.PanicIf(err)
+
+		if _, err := registry.Get(testPartnerUri); err != nil {
+			t.Fatalf("Namespace not registered from [%s]: [%v]", filename, err)
+		}
+	}
+}
+
+func TestLoadFile_FormatNotSupported(t *testing.T) {
+	tempPath, err := ioutil.TempDir("", "")
+	log.PanicIf(err)
+
+	defer os.RemoveAll(tempPath)
+
+	filepath := path.Join(tempPath, "partner.xml")
+
+	err = ioutil.WriteFile(filepath, []byte(testSchemaYaml), 0644)
+	log.PanicIf(err)
+
+	err = LoadFile(xmpregistry.NewRegistry(), filepath)
+	if log.Is(err, ErrSchemaFormatNotSupported) != true {
+		t.Fatalf("Expected format error: [%v]", err)
+	}
+}
@@ -0,0 +1,377 @@
+package xmptype
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+var (
+	// ErrArrayItemTypeNotDeclared indicates that the field-type of an array
+	// does not declare the type of its items and so the items can not be
+	// parsed.
+	ErrArrayItemTypeNotDeclared = errors.New("array item-type not declared")
+)
+
+// ArrayItemParser is satisfied by item-types that parse a complete array item
+// rather than just its char-data (e.g. structs whose fields are expressed as
+// attributes).
+type ArrayItemParser interface {
+	// ParseItem parses the given item.
+	ParseItem(ai ArrayItem) (parsed interface{}, err error)
+}
+
+// ParsedArrayItem is an array item along with its value as parsed by the
+// item-type of the array.
+type ParsedArrayItem struct {
+	ArrayItem
+
+	// Value is the parsed value. Nil if the item could not be parsed.
+	Value interface{}
+
+	// Err is the reason that the item could not be parsed, if it couldn't.
+	Err error
+}
+
+// ArrayParsedItemLister is satisfied by all arrays that can parse their items
+// using the item-type of their field-type.
+type ArrayParsedItemLister interface {
+	// ParsedItems returns every item along with its parsed value. An item
+	// that can not be parsed does not fail the others.
+	ParsedItems() (items []ParsedArrayItem, err error)
+}
+
+// ArrayItemError describes a single array item that could not be parsed.
+type ArrayItemError struct {
+	// Index is the position of the item in the array.
+	Index int
+
+	// Item is the item.
+	Item ArrayItem
+
+	// Err is the reason that the item could not be parsed.
+	Err error
+}
+
+// Error returns the error message.
+func (aie ArrayItemError) Error() string {
+	return fmt.Sprintf("item (%d) not valid: [%s]: %s", aie.Index, aie.Item.CharData, aie.Err)
+}
+
+// ArrayItemsError describes all of the items of an array that could not be
+// parsed.
+type ArrayItemsError struct {
+	// FullName is the name of the array.
+	FullName xmpregistry.XmpPropertyName
+
+	// Items are the items that could not be parsed.
+	Items []ArrayItemError
+}
+
+// Error returns the error message.
+func (aie ArrayItemsError) Error() string {
+	messages := make([]string, len(aie.Items))
+	for i, item := range aie.Items {
+		messages[i] = item.Error()
+	}
+
+	return fmt.Sprintf("array items not valid: [%s]: %s", aie.FullName, strings.Join(messages, "; "))
+}
+
+// ParsedItemsError returns an ArrayItemsError that describes every item that
+// could not be parsed or nil if all of them were.
+func ParsedItemsError(fullName xmpregistry.XmpPropertyName, items []ParsedArrayItem) error {
+	failed := make([]ArrayItemError, 0)
+
+	for i, pai := range items {
+		if pai.Err == nil {
+			continue
+		}
+
+		aie := ArrayItemError{
+			Index: i,
+			Item:  pai.ArrayItem,
+			Err:   pai.Err,
+		}
+
+		failed = append(failed, aie)
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return ArrayItemsError{
+		FullName: fullName,
+		Items:    failed,
+	}
+}
+
+// parseArrayItem parses a single item using the given item-type.
+func parseArrayItem(itemType interface{}, ai ArrayItem) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	if aip, ok := itemType.(ArrayItemParser); ok == true {
+		return aip.ParseItem(ai)
+	} else if sft, ok := itemType.(ScalarFieldType); ok == true {
+		return sft.GetValueParser(ai.CharData).Parse()
+	}
+
+	log.Panicf("array item-type not valid: [%v]", reflect.TypeOf(itemType))
+	panic(nil)
+}
+
+// ItemType returns the declared type of the items or nil if not declared.
+func (bav baseArrayValue) ItemType() interface{} {
+	return bav.itemType
+}
+
+// parseItems parses the given items using the item-type of the array.
+func (bav baseArrayValue) parseItems(items []ArrayItem) (parsedItems []ParsedArrayItem, err error) {
+	if bav.itemType == nil {
+		return nil, ErrArrayItemTypeNotDeclared
+	}
+
+	parsedItems = make([]ParsedArrayItem, len(items))
+
+	for i, ai := range items {
+		parsed, err := parseArrayItem(bav.itemType, ai)
+
+		parsedItems[i] = ParsedArrayItem{
+			ArrayItem: ai,
+			Value:     parsed,
+			Err:       err,
+		}
+	}
+
+	return parsedItems, nil
+}
+
+// ParsedItems returns every item along with its value as parsed by the item-
+// type. Returns ErrArrayItemTypeNotDeclared if there is no item-type.
+func (oav OrderedArrayValue) ParsedItems() (items []ParsedArrayItem, err error) {
+	rawItems, err := oav.Items()
+	if err != nil {
+		return nil, err
+	}
+
+	return oav.parseItems(rawItems)
+}
+
+// ParsedItems returns every item along with its value as parsed by the item-
+// type. Returns ErrArrayItemTypeNotDeclared if there is no item-type.
+func (uav UnorderedArrayValue) ParsedItems() (items []ParsedArrayItem, err error) {
+	rawItems, err := uav.Items()
+	if err != nil {
+		return nil, err
+	}
+
+	return uav.parseItems(rawItems)
+}
+
+// ParsedItems returns every item along with its value as parsed by the item-
+// type. Returns ErrArrayItemTypeNotDeclared if there is no item-type.
+func (aav AlternativeArrayValue) ParsedItems() (items []ParsedArrayItem, err error) {
+	rawItems, err := aav.Items()
+	if err != nil {
+		return nil, err
+	}
+
+	return aav.parseItems(rawItems)
+}
+
+// parsedValues returns the parsed values of all items or an ArrayItemsError
+// if any of them could not be parsed.
+func parsedValues(av ArrayValue) (values []interface{}, err error) {
+	apil, ok := av.(ArrayParsedItemLister)
+	if ok == false {
+		return nil, ErrArrayItemTypeNotDeclared
+	}
+
+	items, err := apil.ParsedItems()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ParsedItemsError(av.FullName(), items)
+	if err != nil {
+		return nil, err
+	}
+
+	values = make([]interface{}, len(items))
+	for i, pai := range items {
+		values[i] = pai.Value
+	}
+
+	return values, nil
+}
+
+// TextItems returns the items of an array whose item-type produces strings.
+func TextItems(av ArrayValue) (items []string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(av)
+	if err != nil {
+		return nil, err
+	}
+
+	items = make([]string, len(values))
+	for i, value := range values {
+		var ok bool
+		if items[i], ok = value.(string); ok == false {
+			log.Panicf("array item (%d) is not text: [%s] [%v]", i, av.FullName(), reflect.TypeOf(value))
+		}
+	}
+
+	return items, nil
+}
+
+// IntegerItems returns the items of an array whose item-type produces
+// integers.
+func IntegerItems(av ArrayValue) (items []int64, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(av)
+	if err != nil {
+		return nil, err
+	}
+
+	items = make([]int64, len(values))
+	for i, value := range values {
+		var ok bool
+		if items[i], ok = value.(int64); ok == false {
+			log.Panicf("array item (%d) is not an integer: [%s] [%v]", i, av.FullName(), reflect.TypeOf(value))
+		}
+	}
+
+	return items, nil
+}
+
+// RealItems returns the items of an array whose item-type produces reals.
+func RealItems(av ArrayValue) (items []float64, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(av)
+	if err != nil {
+		return nil, err
+	}
+
+	items = make([]float64, len(values))
+	for i, value := range values {
+		var ok bool
+		if items[i], ok = value.(float64); ok == false {
+			log.Panicf("array item (%d) is not a real: [%s] [%v]", i, av.FullName(), reflect.TypeOf(value))
+		}
+	}
+
+	return items, nil
+}
+
+// LanguageItems returns the items of a language-alternative array keyed by
+// their language (e.g. "x-default").
+func LanguageItems(av ArrayValue) (items map[string]string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	apil, ok := av.(ArrayParsedItemLister)
+	if ok == false {
+		return nil, ErrArrayItemTypeNotDeclared
+	}
+
+	parsedItems, err := apil.ParsedItems()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ParsedItemsError(av.FullName(), parsedItems)
+	if err != nil {
+		return nil, err
+	}
+
+	items = make(map[string]string, len(parsedItems))
+	for i, pai := range parsedItems {
+		text, ok := pai.Value.(string)
+		if ok == false {
+			log.Panicf("array item (%d) is not text: [%s] [%v]", i, av.FullName(), reflect.TypeOf(pai.Value))
+		}
+
+		items[pai.Language()] = text
+	}
+
+	return items, nil
+}
+
+// RationalItems returns the items of an array whose item-type produces
+// rationals.
+func RationalItems(av ArrayValue) (items []Rational, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(av)
+	if err != nil {
+		return nil, err
+	}
+
+	items = make([]Rational, len(values))
+	for i, value := range values {
+		var ok bool
+		if items[i], ok = value.(Rational); ok == false {
+			log.Panicf("array item (%d) is not a rational: [%s] [%v]", i, av.FullName(), reflect.TypeOf(value))
+		}
+	}
+
+	return items, nil
+}
+
+// DateItems returns the items of an array whose item-type produces dates. Like
+// every date value, the items are XmpDates that retain their precision and
+// zone.
+func DateItems(av ArrayValue) (items []XmpDate, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(av)
+	if err != nil {
+		return nil, err
+	}
+
+	items = make([]XmpDate, len(values))
+	for i, value := range values {
+		var ok bool
+		if items[i], ok = value.(XmpDate); ok == false {
+			log.Panicf("array item (%d) is not a date: [%s] [%v]", i, av.FullName(), reflect.TypeOf(value))
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,215 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+// getTestCollected returns the raw elements of an array having the given
+// container and simple items.
+func getTestCollected(containerLocal string, values ...string) []interface{} {
+	containerName := xml.Name{Space: RdfUri, Local: containerLocal}
+
+	itemElements := make([][]interface{}, len(values))
+	for i, value := range values {
+		itemElements[i] = []interface{}{
+			xml.StartElement{Name: rdfLiTag},
+			value,
+			xml.EndElement{Name: rdfLiTag},
+		}
+	}
+
+	return NewCollected(containerName, itemElements)
+}
+
+type testArrayItemParser struct {
+}
+
+func (taip testArrayItemParser) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return len(ai.CharData), nil
+}
+
+func TestRealItems(t *testing.T) {
+	oaft := OrderedArrayFieldType{ItemType: RealFieldType{}}
+	av := oaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "1.5", "-2", "3e2"))
+
+	items, err := RealItems(av)
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []float64{1.5, -2, 300}) != true {
+		t.Fatalf("Items not correct: %v", items)
+	}
+}
+
+func TestIntegerItems(t *testing.T) {
+	uaft := UnorderedArrayFieldType{ItemType: IntegerFieldType{}}
+	av := uaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Bag", "11", "22"))
+
+	items, err := IntegerItems(av)
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []int64{11, 22}) != true {
+		t.Fatalf("Items not correct: %v", items)
+	}
+}
+
+func TestLanguageItems(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+	registerTestXmlNamespace()
+
+	av := getTestLanguageAlternatives("x-default", "some title", "de", "ein Titel")
+
+	items, err := LanguageItems(av)
+	log.PanicIf(err)
+
+	expected := map[string]string{
+		"x-default": "some title",
+		"de":        "ein Titel",
+	}
+
+	if reflect.DeepEqual(items, expected) != true {
+		t.Fatalf("Items not correct: %v", items)
+	}
+}
+
+func TestRationalItems(t *testing.T) {
+	oaft := OrderedArrayFieldType{ItemType: RationalFieldType{}}
+	av := oaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "24/1", "70/10"))
+
+	items, err := RationalItems(av)
+	log.PanicIf(err)
+
+	expected := []Rational{
+		{Numerator: 24, Denominator: 1},
+		{Numerator: 70, Denominator: 10},
+	}
+
+	if reflect.DeepEqual(items, expected) != true {
+		t.Fatalf("Items not correct: %v", items)
+	}
+}
+
+func TestDateItems(t *testing.T) {
+	oaft := OrderedArrayFieldType{ItemType: DateFieldType{}}
+	av := oaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "2020-01-02T03:04:05+01:00", "2021"))
+
+	items, err := DateItems(av)
+	log.PanicIf(err)
+
+	expected := []XmpDate{
+		NewXmpDate(time.Date(2020, 1, 2, 3, 4, 5, 0, time.FixedZone("", 3600)), DatePrecisionSecond, true),
+		NewXmpDate(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), DatePrecisionYear, false),
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("Expected two items: %v", items)
+	} else if items[0].Equal(expected[0]) != true || items[1].Equal(expected[1]) != true {
+		t.Fatalf("Items not correct: %v", items)
+	}
+}
+
+func TestTextItems(t *testing.T) {
+	uaft := UnorderedTextArrayFieldType{}
+	av := uaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Bag", "aa", "bb"))
+
+	items, err := TextItems(av)
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"aa", "bb"}) != true {
+		t.Fatalf("Items not correct: %v", items)
+	}
+
+	// The item-type produces integers.
+
+	uaft2 := UnorderedArrayFieldType{ItemType: IntegerFieldType{}}
+	av = uaft2.New(xmpregistry.Default(), testPropertyName, getTestCollected("Bag", "11"))
+
+	_, err = TextItems(av)
+	if err == nil {
+		t.Fatalf("Expected error for non-text items.")
+	}
+}
+
+func TestParsedItems_ItemNotValid(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+
+	oaft := OrderedArrayFieldType{ItemType: RealFieldType{}}
+	av := oaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "1.5", "abc", "2.5", "def"))
+
+	items, err := av.(ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	if len(items) != 4 {
+		t.Fatalf("Expected four items: %v", items)
+	} else if items[0].Value != 1.5 || items[0].Err != nil {
+		t.Fatalf("First item not correct: %v", items[0])
+	} else if items[1].Value != nil || items[1].Err != ErrValueNotValid {
+		t.Fatalf("Second item not correct: %v", items[1])
+	} else if items[2].Value != 2.5 || items[2].Err != nil {
+		t.Fatalf("Third item not correct: %v", items[2])
+	}
+
+	_, err = RealItems(av)
+	if err == nil {
+		t.Fatalf("Expected error for invalid items.")
+	}
+
+	aie, ok := err.(ArrayItemsError)
+	if ok != true {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	} else if len(aie.Items) != 2 || aie.Items[0].Index != 1 || aie.Items[1].Index != 3 {
+		t.Fatalf("Failed items not correct: %v", aie.Items)
+	} else if err.Error() != "array items not valid: [[rdf]aa.[xmp]bb]: item (1) not valid: [abc]: value not valid/allowed; item (3) not valid: [def]: value not valid/allowed" {
+		t.Fatalf("Error message not correct: [%s]", err.Error())
+	}
+}
+
+func TestParsedItems_NotDeclared(t *testing.T) {
+	oaft := OrderedArrayFieldType{}
+	av := oaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "aa"))
+
+	_, err := av.(ArrayParsedItemLister).ParsedItems()
+	if err != ErrArrayItemTypeNotDeclared {
+		t.Fatalf("Expected not-declared error: %v", err)
+	}
+
+	_, err = TextItems(av)
+	if err != ErrArrayItemTypeNotDeclared {
+		t.Fatalf("Expected not-declared error: %v", err)
+	}
+}
+
+func TestParsedItems_ArrayItemParser(t *testing.T) {
+	aaft := AlternativeArrayFieldType{ItemType: testArrayItemParser{}}
+	av := aaft.New(xmpregistry.Default(), testPropertyName, getTestCollected("Alt", "a", "bbb"))
+
+	items, err := av.(ArrayParsedItemLister).ParsedItems()
+	log.PanicIf(err)
+
+	if items[0].Value != 1 || items[1].Value != 3 {
+		t.Fatalf("Items not correct: %v", items)
+	}
+}
+
+func TestBaseArrayValue_ItemType(t *testing.T) {
+	av := OrderedTextArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq"))
+
+	if reflect.DeepEqual(av.(OrderedTextArrayValue).ItemType(), TextFieldType{}) != true {
+		t.Fatalf("Item-type not correct: %v", av.(OrderedTextArrayValue).ItemType())
+	}
+
+	av = OrderedUriArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq"))
+
+	if reflect.DeepEqual(av.(OrderedArrayValue).ItemType(), UriFieldType{}) != true {
+		t.Fatalf("Item-type not correct: %v", av.(OrderedArrayValue).ItemType())
+	}
+}
@@ -58,12 +58,26 @@ type ArrayItem struct {
 	// Attributes are the attributes, if any, of the array item.
 	Attributes map[xml.Name]interface{}
 
-	// CharData is the trimmed char-data found in the array item.
+	// CharData is the trimmed char-data found in the array item. If the item
+	// is qualified, this is the char-data of its "rdf:value".
 	CharData string
+
+	// Qualifiers are the qualifiers of the item if it is expressed in the
+	// general qualifier form. Nil otherwise.
+	Qualifiers Qualifiers
 }
 
 // String returns a string representation of the item.
 func (ai ArrayItem) String() string {
+	if len(ai.Qualifiers) > 0 {
+		return fmt.Sprintf(
+			"ArrayItem<NAME={%s} ATTR={%s} CHAR-DATA=[%s] QUALIFIERS={%s}>",
+			xmpregistry.XmlName(ai.Name),
+			ai.InlineAttributes(),
+			ai.CharData,
+			ai.Qualifiers)
+	}
+
 	return fmt.Sprintf(
 		"ArrayItem<NAME={%s} ATTR={%s} CHAR-DATA=[%s]>",
 		xmpregistry.XmlName(ai.Name),
@@ -71,6 +85,16 @@ func (ai ArrayItem) String() string {
 		ai.CharData)
 }
 
+// Language returns the "xml:lang" of the item, whether given as an attribute
+// or a qualifier, or an empty string if there isn't one.
+func (ai ArrayItem) Language() string {
+	if language, ok := ai.Attributes[xmlLangAttribute].(string); ok == true {
+		return language
+	}
+
+	return ai.Qualifiers.Language()
+}
+
 // InlineAttributes returns an inline string representation of all attributes.
 func (ai ArrayItem) InlineAttributes() string {
 	return xmpregistry.InlineAttributes(ai.Attributes)
@@ -83,6 +107,12 @@ type ArrayValue interface {
 
 	// Count returns the number of items.
 	Count() int
+
+	// ContainerName returns the name of the container tag.
+	ContainerName() xml.Name
+
+	// ItemElements returns the raw elements of each item.
+	ItemElements() (itemElements [][]interface{}, err error)
 }
 
 // ArrayItemLister supports enumerating the items of the array. This is the
@@ -103,8 +133,10 @@ type ArrayStringValueLister interface {
 
 // ArrayFieldType is satisfied by all array field-types..
 type ArrayFieldType interface {
-	// New returns a new value struct encapsulating the given arguments.
-	New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue
+	// New returns a new value struct encapsulating the given arguments. The
+	// registry is used to interpret the items and is required (usually the
+	// registry of the index). Panics if it is nil.
+	New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue
 }
 
 // elementTagName returns the xml.Name for the ith element. isTag indicates
@@ -190,12 +222,21 @@ func validateAnchorElements(elements []interface{}, name xml.Name) (err error) {
 }
 
 type baseArrayValue struct {
+	registry  *xmpregistry.Registry
 	fullName  xmpregistry.XmpPropertyName
 	collected []interface{}
+
+	// itemType is the declared type of the items or nil if not declared.
+	itemType interface{}
 }
 
-func newBaseArrayValue(fullName xmpregistry.XmpPropertyName, collected []interface{}) baseArrayValue {
+func newBaseArrayValue(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) baseArrayValue {
+	if registry == nil {
+		log.Panicf("registry not given: [%s]", fullName)
+	}
+
 	return baseArrayValue{
+		registry:  registry,
 		fullName:  fullName,
 		collected: collected,
 	}
@@ -212,29 +253,108 @@ func (bav baseArrayValue) Count() int {
 	return len(bav.collected)
 }
 
-func (bav baseArrayValue) constructArrayItem(subslice []interface{}) (ai ArrayItem, err error) {
+// ContainerName returns the name of the container tag (e.g. "rdf:Seq") or an
+// empty name if the array is empty.
+func (bav baseArrayValue) ContainerName() xml.Name {
+	if len(bav.collected) == 0 {
+		return xml.Name{}
+	}
+
+	name, isTag, isOpenTag := elementTagName(bav.collected, 0)
+	if isTag == false || isOpenTag == false {
+		return xml.Name{}
+	}
+
+	return name
+}
+
+// ItemElements returns the raw elements of each item, from the item's open-
+// tag through its close-tag. This is what allows arrays to be recombined
+// without reparsing the document.
+func (bav baseArrayValue) ItemElements() (itemElements [][]interface{}, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
 			err = log.Wrap(errRaw.(error))
 		}
 	}()
 
-	subsliceLen := len(subslice)
-	if subsliceLen != 3 {
-		log.Panicf("sublice length is not valid: (%d)", subsliceLen)
+	itemElements = make([][]interface{}, 0)
+
+	elementCount := len(bav.collected)
+	if elementCount < 2 {
+		return itemElements, nil
+	}
+
+	depth := 0
+	start := -1
+
+	for i := 1; i < elementCount-1; i++ {
+		_, isTag, isOpenTag := elementTagName(bav.collected, i)
+
+		if isTag == true && isOpenTag == true {
+			if depth == 0 {
+				start = i
+			}
+
+			depth++
+		} else if isTag == true {
+			depth--
+
+			if depth < 0 {
+				log.Panicf("array elements are not balanced: [%s]", bav.FullName())
+			} else if depth == 0 {
+				itemElements = append(itemElements, bav.collected[start:i+1])
+			}
+		} else if depth == 0 {
+			log.Panicf("array has element outside of any item: [%s]", bav.FullName())
+		}
 	}
 
+	if depth != 0 {
+		log.Panicf("array item was not closed: [%s]", bav.FullName())
+	}
+
+	return itemElements, nil
+}
+
+// NewCollected reassembles the raw elements of an array from the container
+// name and the raw elements of each item (as returned by ItemElements).
+func NewCollected(containerName xml.Name, itemElements [][]interface{}) (collected []interface{}) {
+	collected = make([]interface{}, 0)
+
+	collected = append(collected, xml.StartElement{Name: containerName})
+
+	for _, elements := range itemElements {
+		collected = append(collected, elements...)
+	}
+
+	collected = append(collected, xml.EndElement{Name: containerName})
+
+	return collected
+}
+
+func (bav baseArrayValue) constructArrayItem(subslice []interface{}) (ai ArrayItem, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
 	err = validateAnchorElements(subslice, rdfLiTag)
 	log.PanicIf(err)
 
 	se := subslice[0].(xml.StartElement)
+	parent := bav.itemParent()
 
-	attributes, err := ParseAttributes(se)
+	attributes, err := ParseAttributes(bav.registry, se, parent)
 	log.PanicIf(err)
 
 	var charData string
+	var qualifiers Qualifiers
 
-	if len(subslice) == 3 {
+	subsliceLen := len(subslice)
+
+	if subsliceLen == 3 {
 		// There is character-data between the tags. Extract it.
 
 		charDataRaw := subslice[1]
@@ -247,29 +367,181 @@ func (bav baseArrayValue) constructArrayItem(subslice []interface{}) (ai ArrayIt
 				"expected element between 'li' tags in unordered-array to be char-data: [%s] [%s]",
 				bav.FullName(), reflect.TypeOf(charData))
 		}
+	} else if subsliceLen > 3 {
+		// The item has child nodes. Either this is the general qualifier form,
+		// with the value in an "rdf:value" node, or the item is a struct
+		// expressed with "rdf:parseType='Resource'", whose fields are
+		// equivalent to attributes.
+
+		value, hasValue, children, err := bav.constructItemChildren(subslice[1:subsliceLen-1], parent)
+		log.PanicIf(err)
+
+		if hasValue == true {
+			charData = value
+
+			if len(children) > 0 {
+				qualifiers = Qualifiers(children)
+			}
+		} else {
+			for name, value := range children {
+				attributes[name] = value
+			}
+		}
 	}
 
 	ai = ArrayItem{
 		Name:       se.Name,
 		Attributes: attributes,
 		CharData:   charData,
+		Qualifiers: qualifiers,
 	}
 
 	return ai, nil
 }
 
-// innerItems will extract the attributes and char-data from all elements except
-// the first and last. It expects these to all be "li" tags with an optional
-// char-data value between them.
-func (bav baseArrayValue) innerItems(hasSandwichedCharData bool) (items []ArrayItem, err error) {
+// itemParent returns the name of the property that the fields of the items
+// are directly within (the array property).
+func (bav baseArrayValue) itemParent() xml.Name {
+	fullName := bav.FullName()
+
+	for i := len(fullName) - 1; i >= 0; i-- {
+		if fullName[i].Space != RdfUri {
+			return xml.Name(fullName[i])
+		}
+	}
+
+	return xml.Name{}
+}
+
+// arrayFieldType returns the field-type of the given node, when directly
+// within the given property, if it is a registered array-type or nil
+// otherwise.
+func arrayFieldType(registry *xmpregistry.Registry, parent xml.Name, name xml.Name) ArrayFieldType {
+	namespace, err := registry.Get(name.Space)
+	if err != nil {
+		if err == xmpregistry.ErrNamespaceNotFound {
+			return nil
+		}
+
+		log.Panic(err)
+	}
+
+	ft, _ := namespace.FieldType(parent, name.Local)
+	aft, _ := ft.(ArrayFieldType)
+
+	return aft
+}
+
+// constructItemChildren extracts the values of the child nodes of an item.
+// The value of an "rdf:value" node is returned separately from the others.
+// Each child is an open-tag, an optional value, and a close-tag. A child that
+// is a registered array-type has its ArrayValue as its value. Any other child
+// that has attributes or child nodes of its own is a nested struct and its
+// value is a StructValue. The parent is the property that the children are
+// directly within.
+func (bav baseArrayValue) constructItemChildren(elements []interface{}, parent xml.Name) (charData string, hasValue bool, children map[xml.Name]interface{}, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
 			err = log.Wrap(errRaw.(error))
 		}
 	}()
 
-	elementCount := bav.Count()
-	items = make([]ArrayItem, 0, elementCount-2)
+	children = make(map[xml.Name]interface{})
+
+	for i := 0; i < len(elements); {
+		se, ok := elements[i].(xml.StartElement)
+		if ok == false {
+			log.Panicf("expected qualifier open-tag in array item: [%s] [%v]", bav.FullName(), reflect.TypeOf(elements[i]))
+		}
+
+		// Find the matching close-tag.
+
+		depth := 1
+		j := i + 1
+
+		for ; j < len(elements); j++ {
+			_, isTag, isOpenTag := elementTagName(elements, j)
+			if isTag == false {
+				continue
+			} else if isOpenTag == true {
+				depth++
+			} else {
+				depth--
+
+				if depth == 0 {
+					break
+				}
+			}
+		}
+
+		if j >= len(elements) {
+			log.Panicf("qualifier was not closed: [%s] [%s]", bav.FullName(), xmpregistry.XmlName(se.Name))
+		} else if ee := elements[j].(xml.EndElement); ee.Name != se.Name {
+			log.Panicf("qualifier was not closed: [%s] [%s]", bav.FullName(), xmpregistry.XmlName(se.Name))
+		}
+
+		content := elements[i+1 : j]
+		i = j + 1
+
+		attributes, err := ParseAttributes(bav.registry, se, se.Name)
+		log.PanicIf(err)
+
+		var value interface{} = ""
+
+		if aft := arrayFieldType(bav.registry, parent, se.Name); aft != nil {
+			// This is an array nested in the item (e.g. the "xmpDM:markers"
+			// of an "xmpDM:Tracks" item).
+
+			fullName := make(xmpregistry.XmpPropertyName, len(bav.FullName()), len(bav.FullName())+1)
+			copy(fullName, bav.FullName())
+			fullName = append(fullName, xmpregistry.XmlName(se.Name))
+
+			value = aft.New(bav.registry, fullName, content)
+		} else if len(content) == 1 {
+			value = content[0]
+		} else if len(content) > 1 {
+			// This is a nested struct expressed with
+			// "rdf:parseType='Resource'".
+
+			_, _, fields, err := bav.constructItemChildren(content, se.Name)
+			log.PanicIf(err)
+
+			for name, fieldValue := range fields {
+				attributes[name] = fieldValue
+			}
+
+			value = StructValue(attributes)
+		} else if len(attributes) > 0 {
+			// This is a nested struct expressed with attributes.
+
+			value = StructValue(attributes)
+		}
+
+		if se.Name == rdfValueTag {
+			charData, ok = value.(string)
+			if ok == false {
+				log.Panicf("expected value of array item to be char-data: [%s] [%s]", bav.FullName(), reflect.TypeOf(value))
+			}
+
+			hasValue = true
+		} else {
+			children[se.Name] = value
+		}
+	}
+
+	return charData, hasValue, children, nil
+}
+
+// innerItems will extract the attributes, char-data, and qualifiers from all
+// elements except the first and last. It expects these to all be "li" tags
+// with either an optional char-data value or qualified-value nodes between
+// them.
+func (bav baseArrayValue) innerItems() (items []ArrayItem, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
 
 	firstTag := bav.collected[0].(xml.StartElement)
 	firstTagName := firstTag.Name
@@ -282,31 +554,16 @@ func (bav baseArrayValue) innerItems(hasSandwichedCharData bool) (items []ArrayI
 			firstTagName.Space, firstTagName.Local, lastTagName.Space, lastTagName.Local)
 	}
 
-	for i := 1; i < elementCount-1; {
-		var subsliceLen int
-
-		if hasSandwichedCharData == true {
-			// Two tags with char-data in the middle (three elements total).
-			subsliceLen = 3
-		} else {
-			// Two tags with no char-data in the middle (two elements total).
-			subsliceLen = 2
-		}
-
-		subslice := bav.collected[i : i+subsliceLen]
-		if len(subslice) != subsliceLen {
-			log.Panicf("number of elements does not make sense (with char-data)")
-		}
+	itemElements, err := bav.ItemElements()
+	log.PanicIf(err)
 
-		// constructArrayItem will assume that the second item is char-data if
-		// there are three items.
+	items = make([]ArrayItem, 0, len(itemElements))
 
-		ai, err := bav.constructArrayItem(subslice)
+	for _, elements := range itemElements {
+		ai, err := bav.constructArrayItem(elements)
 		log.PanicIf(err)
 
 		items = append(items, ai)
-
-		i += len(subslice)
 	}
 
 	return items, nil
@@ -314,7 +571,7 @@ func (bav baseArrayValue) innerItems(hasSandwichedCharData bool) (items []ArrayI
 
 // Ordered array semantics
 
-// TODO(dustin): Ordered array yet-to-implement: CuePointParam, Marker, ResourceEvent, Version, Colorant, Marker, Layer, "point" (?)
+// TODO(dustin): Ordered array yet-to-implement: Colorant, Layer, "point" (?)
 
 // OrderedArrayValue represents the items of an ordered-array.
 type OrderedArrayValue struct {
@@ -343,7 +600,7 @@ func (oav OrderedArrayValue) Items() (items []ArrayItem, err error) {
 	err = validateAnchorElements(oav.baseArrayValue.collected, rdfSeqTag)
 	log.PanicIf(err)
 
-	items, err = oav.innerItems(true)
+	items, err = oav.innerItems()
 	log.PanicIf(err)
 
 	return items, nil
@@ -352,15 +609,29 @@ func (oav OrderedArrayValue) Items() (items []ArrayItem, err error) {
 // OrderedArrayFieldType is a field-type that acts as a factory for the ordered-
 // array value type.
 type OrderedArrayFieldType struct {
+	// ItemType is the type of the items: a ScalarFieldType or an
+	// ArrayItemParser. It may be nil if the items are not interpreted.
+	ItemType interface{}
 }
 
 // New returns a value-type for the given arguments.
-func (oat OrderedArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (oat OrderedArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = oat.ItemType
 
 	return newOrderedArrayValue(bav)
 }
 
+// ArrayKind returns the kind of the array.
+func (oat OrderedArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindOrdered
+}
+
+// ItemFieldType returns the field-type of the items. It may be nil.
+func (oat OrderedArrayFieldType) ItemFieldType() interface{} {
+	return oat.ItemType
+}
+
 // OrderedTextArrayValue identifies the array as having resource-event
 // items.
 type OrderedTextArrayValue struct {
@@ -395,8 +666,9 @@ type OrderedTextArrayFieldType struct {
 }
 
 // New returns a value-type for the given arguments.
-func (oat OrderedTextArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (oat OrderedTextArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = TextFieldType{}
 	oav := newOrderedArrayValue(bav)
 
 	return OrderedTextArrayValue{
@@ -404,11 +676,29 @@ func (oat OrderedTextArrayFieldType) New(fullName xmpregistry.XmpPropertyName, c
 	}
 }
 
+// ItemFieldType returns the field-type of the items.
+func (oat OrderedTextArrayFieldType) ItemFieldType() interface{} {
+	return TextFieldType{}
+}
+
 // OrderedUriArrayFieldType identifies the array as having URI items.
 type OrderedUriArrayFieldType struct {
 	OrderedArrayFieldType
 }
 
+// New returns a value-type for the given arguments.
+func (ouat OrderedUriArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = UriFieldType{}
+
+	return newOrderedArrayValue(bav)
+}
+
+// ItemFieldType returns the field-type of the items.
+func (ouat OrderedUriArrayFieldType) ItemFieldType() interface{} {
+	return UriFieldType{}
+}
+
 // OrderedResourceEventArrayValue identifies the array as having resource-event
 // items.
 type OrderedResourceEventArrayValue struct {
@@ -437,14 +727,37 @@ func (oreav OrderedResourceEventArrayValue) StringItems() (items []string, err e
 	return items, nil
 }
 
+// Events returns the items as resource events in order. Fails if any item is
+// not a resource event.
+func (oreav OrderedResourceEventArrayValue) Events() (events []ResourceEvent, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(oreav)
+	if err != nil {
+		return nil, err
+	}
+
+	events = make([]ResourceEvent, len(values))
+	for i, value := range values {
+		events[i] = value.(ResourceEvent)
+	}
+
+	return events, nil
+}
+
 // OrderedResourceEventArrayFieldType identifies the array as having resource-
 // event items.
 type OrderedResourceEventArrayFieldType struct {
 }
 
 // New returns a value-type for the given arguments.
-func (oreat OrderedResourceEventArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (oreat OrderedResourceEventArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = ResourceEventFieldType{}
 	oav := newOrderedArrayValue(bav)
 
 	return OrderedResourceEventArrayValue{
@@ -452,9 +765,243 @@ func (oreat OrderedResourceEventArrayFieldType) New(fullName xmpregistry.XmpProp
 	}
 }
 
+// ArrayKind returns the kind of the array.
+func (oreat OrderedResourceEventArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindOrdered
+}
+
+// ItemFieldType returns the field-type of the items.
+func (oreat OrderedResourceEventArrayFieldType) ItemFieldType() interface{} {
+	return ResourceEventFieldType{}
+}
+
+// OrderedVersionArrayValue identifies the array as having version items.
+type OrderedVersionArrayValue struct {
+	OrderedArrayValue
+}
+
+// StringItems this is a wrapper that returns a simple list of strings from
+// inner underlying array-items, thereby satisfying the ArrayStringValueLister
+// interface. In the case of these, we return a stringification of the
+// attributes.
+func (ovav OrderedVersionArrayValue) StringItems() (items []string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	innerItems, err := ovav.OrderedArrayValue.Items()
+	log.PanicIf(err)
+
+	items = make([]string, len(innerItems))
+	for i, ai := range innerItems {
+		items[i] = ai.InlineAttributes()
+	}
+
+	return items, nil
+}
+
+// Versions returns the items as versions in order. Fails if any item is not a
+// version.
+func (ovav OrderedVersionArrayValue) Versions() (versions []Version, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(ovav)
+	if err != nil {
+		return nil, err
+	}
+
+	versions = make([]Version, len(values))
+	for i, value := range values {
+		versions[i] = value.(Version)
+	}
+
+	return versions, nil
+}
+
+// OrderedVersionArrayFieldType identifies the array as having version items.
+type OrderedVersionArrayFieldType struct {
+}
+
+// New returns a value-type for the given arguments.
+func (ovat OrderedVersionArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = VersionFieldType{}
+	oav := newOrderedArrayValue(bav)
+
+	return OrderedVersionArrayValue{
+		OrderedArrayValue: oav,
+	}
+}
+
+// ArrayKind returns the kind of the array.
+func (ovat OrderedVersionArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindOrdered
+}
+
+// ItemFieldType returns the field-type of the items.
+func (ovat OrderedVersionArrayFieldType) ItemFieldType() interface{} {
+	return VersionFieldType{}
+}
+
+// OrderedCuePointParamArrayValue identifies the array as having cue-point
+// parameter items.
+type OrderedCuePointParamArrayValue struct {
+	OrderedArrayValue
+}
+
+// StringItems this is a wrapper that returns a simple list of strings from
+// inner underlying array-items, thereby satisfying the ArrayStringValueLister
+// interface. In the case of these, we return a stringification of the
+// attributes.
+func (ocppav OrderedCuePointParamArrayValue) StringItems() (items []string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	innerItems, err := ocppav.OrderedArrayValue.Items()
+	log.PanicIf(err)
+
+	items = make([]string, len(innerItems))
+	for i, ai := range innerItems {
+		items[i] = ai.InlineAttributes()
+	}
+
+	return items, nil
+}
+
+// CuePointParams returns the items as cue-point parameters in order. Fails if
+// any item is not a cue-point parameter.
+func (ocppav OrderedCuePointParamArrayValue) CuePointParams() (params []CuePointParam, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(ocppav)
+	if err != nil {
+		return nil, err
+	}
+
+	params = make([]CuePointParam, len(values))
+	for i, value := range values {
+		params[i] = value.(CuePointParam)
+	}
+
+	return params, nil
+}
+
+// OrderedCuePointParamArrayFieldType identifies the array as having cue-point
+// parameter items.
+type OrderedCuePointParamArrayFieldType struct {
+}
+
+// New returns a value-type for the given arguments.
+func (ocppat OrderedCuePointParamArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = CuePointParamFieldType{}
+	oav := newOrderedArrayValue(bav)
+
+	return OrderedCuePointParamArrayValue{
+		OrderedArrayValue: oav,
+	}
+}
+
+// ArrayKind returns the kind of the array.
+func (ocppat OrderedCuePointParamArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindOrdered
+}
+
+// ItemFieldType returns the field-type of the items.
+func (ocppat OrderedCuePointParamArrayFieldType) ItemFieldType() interface{} {
+	return CuePointParamFieldType{}
+}
+
+// OrderedMarkerArrayValue identifies the array as having marker items.
+type OrderedMarkerArrayValue struct {
+	OrderedArrayValue
+}
+
+// StringItems this is a wrapper that returns a simple list of strings from
+// inner underlying array-items, thereby satisfying the ArrayStringValueLister
+// interface. In the case of these, we return a stringification of the
+// attributes.
+func (omav OrderedMarkerArrayValue) StringItems() (items []string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	innerItems, err := omav.OrderedArrayValue.Items()
+	log.PanicIf(err)
+
+	items = make([]string, len(innerItems))
+	for i, ai := range innerItems {
+		items[i] = ai.InlineAttributes()
+	}
+
+	return items, nil
+}
+
+// Markers returns the items as markers in order. Fails if any item is not a
+// marker.
+func (omav OrderedMarkerArrayValue) Markers() (markers []Marker, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(omav)
+	if err != nil {
+		return nil, err
+	}
+
+	markers = make([]Marker, len(values))
+	for i, value := range values {
+		markers[i] = value.(Marker)
+	}
+
+	return markers, nil
+}
+
+// OrderedMarkerArrayFieldType identifies the array as having marker items.
+type OrderedMarkerArrayFieldType struct {
+}
+
+// New returns a value-type for the given arguments.
+func (omat OrderedMarkerArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = MarkerFieldType{}
+	oav := newOrderedArrayValue(bav)
+
+	return OrderedMarkerArrayValue{
+		OrderedArrayValue: oav,
+	}
+}
+
+// ArrayKind returns the kind of the array.
+func (omat OrderedMarkerArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindOrdered
+}
+
+// ItemFieldType returns the field-type of the items.
+func (omat OrderedMarkerArrayFieldType) ItemFieldType() interface{} {
+	return MarkerFieldType{}
+}
+
 // Unordered array semantics
 
-// TODO(dustin): Unordered array yet-to-implement: XPath, ResourceRef, "struct" (?), Job, Font, Media, Track
+// TODO(dustin): Unordered array yet-to-implement: XPath, "struct" (?), Job, Font, Media
 
 // UnorderedArrayValue represents the items of an unordered-array.
 type UnorderedArrayValue struct {
@@ -483,7 +1030,7 @@ func (uav UnorderedArrayValue) Items() (items []ArrayItem, err error) {
 	err = validateAnchorElements(uav.baseArrayValue.collected, rdfBagTag)
 	log.PanicIf(err)
 
-	items, err = uav.innerItems(true)
+	items, err = uav.innerItems()
 	log.PanicIf(err)
 
 	return items, nil
@@ -492,15 +1039,29 @@ func (uav UnorderedArrayValue) Items() (items []ArrayItem, err error) {
 // UnorderedArrayFieldType is a field-type that acts as a factory for the
 // unordered-array value type.
 type UnorderedArrayFieldType struct {
+	// ItemType is the type of the items: a ScalarFieldType or an
+	// ArrayItemParser. It may be nil if the items are not interpreted.
+	ItemType interface{}
 }
 
 // New returns a value-type for the given arguments.
-func (uat UnorderedArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (uat UnorderedArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = uat.ItemType
 
 	return newUnorderedArrayValue(bav)
 }
 
+// ArrayKind returns the kind of the array.
+func (uat UnorderedArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindUnordered
+}
+
+// ItemFieldType returns the field-type of the items. It may be nil.
+func (uat UnorderedArrayFieldType) ItemFieldType() interface{} {
+	return uat.ItemType
+}
+
 // UnorderedTextArrayValue represents the items of an unordered-array with
 // Ancestor items.
 type UnorderedTextArrayValue struct {
@@ -534,8 +1095,9 @@ type UnorderedTextArrayFieldType struct {
 }
 
 // New returns a value-type for the given arguments.
-func (uat UnorderedTextArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (uat UnorderedTextArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = TextFieldType{}
 	uav := newUnorderedArrayValue(bav)
 
 	return UnorderedTextArrayValue{
@@ -543,6 +1105,11 @@ func (uat UnorderedTextArrayFieldType) New(fullName xmpregistry.XmpPropertyName,
 	}
 }
 
+// ItemFieldType returns the field-type of the items.
+func (uat UnorderedTextArrayFieldType) ItemFieldType() interface{} {
+	return TextFieldType{}
+}
+
 // UnorderedAncestorArrayValue represents the items of an unordered-array with
 // Ancestor items.
 type UnorderedAncestorArrayValue struct {
@@ -577,14 +1144,169 @@ type UnorderedAncestorArrayFieldType struct {
 }
 
 // New returns a value-type for the given arguments.
-func (uaat UnorderedAncestorArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (uaat UnorderedAncestorArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = TextFieldType{}
 
 	return UnorderedAncestorArrayValue{
 		UnorderedArrayValue: newUnorderedArrayValue(bav),
 	}
 }
 
+// ItemFieldType returns the field-type of the items.
+func (uaat UnorderedAncestorArrayFieldType) ItemFieldType() interface{} {
+	return TextFieldType{}
+}
+
+// UnorderedResourceRefArrayValue identifies the array as having resource-
+// reference items.
+type UnorderedResourceRefArrayValue struct {
+	UnorderedArrayValue
+}
+
+// StringItems this is a wrapper that returns a simple list of strings from
+// inner underlying array-items, thereby satisfying the ArrayStringValueLister
+// interface. In the case of these, we return a stringification of the
+// attributes.
+func (urrav UnorderedResourceRefArrayValue) StringItems() (items []string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	innerItems, err := urrav.UnorderedArrayValue.Items()
+	log.PanicIf(err)
+
+	items = make([]string, len(innerItems))
+	for i, ai := range innerItems {
+		items[i] = ai.InlineAttributes()
+	}
+
+	return items, nil
+}
+
+// Refs returns the items as resource references. Fails if any item is not a
+// resource reference.
+func (urrav UnorderedResourceRefArrayValue) Refs() (refs []ResourceRef, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(urrav)
+	if err != nil {
+		return nil, err
+	}
+
+	refs = make([]ResourceRef, len(values))
+	for i, value := range values {
+		refs[i] = value.(ResourceRef)
+	}
+
+	return refs, nil
+}
+
+// UnorderedResourceRefArrayFieldType identifies the array as having resource-
+// reference items.
+type UnorderedResourceRefArrayFieldType struct {
+}
+
+// New returns a value-type for the given arguments.
+func (urrat UnorderedResourceRefArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = ResourceRefFieldType{}
+	uav := newUnorderedArrayValue(bav)
+
+	return UnorderedResourceRefArrayValue{
+		UnorderedArrayValue: uav,
+	}
+}
+
+// ArrayKind returns the kind of the array.
+func (urrat UnorderedResourceRefArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindUnordered
+}
+
+// ItemFieldType returns the field-type of the items.
+func (urrat UnorderedResourceRefArrayFieldType) ItemFieldType() interface{} {
+	return ResourceRefFieldType{}
+}
+
+// UnorderedTrackArrayValue identifies the array as having track items.
+type UnorderedTrackArrayValue struct {
+	UnorderedArrayValue
+}
+
+// StringItems this is a wrapper that returns a simple list of strings from
+// inner underlying array-items, thereby satisfying the ArrayStringValueLister
+// interface. In the case of these, we return a stringification of the
+// attributes.
+func (utav UnorderedTrackArrayValue) StringItems() (items []string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	innerItems, err := utav.UnorderedArrayValue.Items()
+	log.PanicIf(err)
+
+	items = make([]string, len(innerItems))
+	for i, ai := range innerItems {
+		items[i] = ai.InlineAttributes()
+	}
+
+	return items, nil
+}
+
+// Tracks returns the items as tracks. Fails if any item is not a track.
+func (utav UnorderedTrackArrayValue) Tracks() (tracks []Track, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	values, err := parsedValues(utav)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks = make([]Track, len(values))
+	for i, value := range values {
+		tracks[i] = value.(Track)
+	}
+
+	return tracks, nil
+}
+
+// UnorderedTrackArrayFieldType identifies the array as having track items.
+type UnorderedTrackArrayFieldType struct {
+}
+
+// New returns a value-type for the given arguments.
+func (utat UnorderedTrackArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = TrackFieldType{}
+	uav := newUnorderedArrayValue(bav)
+
+	return UnorderedTrackArrayValue{
+		UnorderedArrayValue: uav,
+	}
+}
+
+// ArrayKind returns the kind of the array.
+func (utat UnorderedTrackArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindUnordered
+}
+
+// ItemFieldType returns the field-type of the items.
+func (utat UnorderedTrackArrayFieldType) ItemFieldType() interface{} {
+	return TrackFieldType{}
+}
+
 // Alternatives array semantics
 
 // AlternativeArrayValue represents the items of an alternatives-array
@@ -614,7 +1336,7 @@ func (aav AlternativeArrayValue) Items() (items []ArrayItem, err error) {
 	err = validateAnchorElements(aav.baseArrayValue.collected, rdfAltTag)
 	log.PanicIf(err)
 
-	items, err = aav.innerItems(true)
+	items, err = aav.innerItems()
 	log.PanicIf(err)
 
 	return items, nil
@@ -623,17 +1345,31 @@ func (aav AlternativeArrayValue) Items() (items []ArrayItem, err error) {
 // AlternativeArrayFieldType is a field-type that acts as a factory for the
 // actual value type.
 type AlternativeArrayFieldType struct {
+	// ItemType is the type of the items: a ScalarFieldType or an
+	// ArrayItemParser. It may be nil if the items are not interpreted.
+	ItemType interface{}
 }
 
 // New returns a value-type for the given arguments.
-func (aat AlternativeArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (aat AlternativeArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = aat.ItemType
 
 	return AlternativeArrayValue{
 		baseArrayValue: bav,
 	}
 }
 
+// ArrayKind returns the kind of the array.
+func (aat AlternativeArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindAlternative
+}
+
+// ItemFieldType returns the field-type of the items. It may be nil.
+func (aat AlternativeArrayFieldType) ItemFieldType() interface{} {
+	return aat.ItemType
+}
+
 // LanguageAlternativeArrayValue represents the items of an alternatives-array.
 type LanguageAlternativeArrayValue struct {
 	AlternativeArrayValue
@@ -666,11 +1402,22 @@ type LanguageAlternativeArrayFieldType struct {
 }
 
 // New returns a value-type for the given arguments.
-func (laat LanguageAlternativeArrayFieldType) New(fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
-	bav := newBaseArrayValue(fullName, collected)
+func (laat LanguageAlternativeArrayFieldType) New(registry *xmpregistry.Registry, fullName xmpregistry.XmpPropertyName, collected []interface{}) ArrayValue {
+	bav := newBaseArrayValue(registry, fullName, collected)
+	bav.itemType = TextFieldType{}
 	aav := newAlternativeArrayValue(bav)
 
 	return LanguageAlternativeArrayValue{
 		AlternativeArrayValue: aav,
 	}
 }
+
+// ArrayKind returns the kind of the array.
+func (laat LanguageAlternativeArrayFieldType) ArrayKind() xmpregistry.ArrayKind {
+	return xmpregistry.ArrayKindLanguageAlternative
+}
+
+// ItemFieldType returns the field-type of the items.
+func (laat LanguageAlternativeArrayFieldType) ItemFieldType() interface{} {
+	return TextFieldType{}
+}
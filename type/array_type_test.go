@@ -3,6 +3,7 @@ package xmptype
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"encoding/xml"
@@ -168,7 +169,7 @@ func TestNewBaseArrayValue(t *testing.T) {
 		"value2",
 	}
 
-	bav := newBaseArrayValue(testPropertyName, items)
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, items)
 
 	if reflect.DeepEqual(bav.fullName, testPropertyName) != true {
 		t.Fatalf("Full-name not correct.")
@@ -177,13 +178,29 @@ func TestNewBaseArrayValue(t *testing.T) {
 	}
 }
 
+func TestNewBaseArrayValue_RegistryNotGiven(t *testing.T) {
+	defer func() {
+		errRaw := recover()
+		if errRaw == nil {
+			t.Fatalf("Expected panic for missing registry.")
+		}
+
+		err := errRaw.(error)
+		if strings.HasPrefix(err.Error(), "registry not given: ") != true {
+			t.Fatalf("Error not correct: [%s]", err.Error())
+		}
+	}()
+
+	OrderedTextArrayFieldType{}.New(nil, testPropertyName, nil)
+}
+
 func TestBaseArrayValue_FullName(t *testing.T) {
 	items := []interface{}{
 		"value1",
 		"value2",
 	}
 
-	bav := newBaseArrayValue(testPropertyName, items)
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, items)
 
 	if reflect.DeepEqual(bav.FullName(), testPropertyName) != true {
 		t.Fatalf("FullName() not correct.")
@@ -196,7 +213,7 @@ func TestBaseArrayValue_Count(t *testing.T) {
 		"value2",
 	}
 
-	bav := newBaseArrayValue(testPropertyName, items)
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, items)
 
 	if bav.Count() != 2 {
 		t.Fatalf("Count() not correct.")
@@ -233,13 +250,113 @@ func TestBaseArrayValue_constructArrayItem_WithChardata(t *testing.T) {
 	}
 }
 
+func TestBaseArrayValue_constructArrayItem_Qualified(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+
+	itemName := xml.Name{Space: RdfUri, Local: "li"}
+	qualifierName := xml.Name{Space: xmpUri, Local: "Scheme"}
+
+	elements := []interface{}{
+		xml.StartElement{Name: itemName},
+		xml.StartElement{Name: qualifierName},
+		"ISBN",
+		xml.EndElement{Name: qualifierName},
+		xml.StartElement{Name: rdfValueTag},
+		"978-3-16-148410-0",
+		xml.EndElement{Name: rdfValueTag},
+		xml.EndElement{Name: itemName},
+	}
+
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, nil)
+
+	actual, err := bav.constructArrayItem(elements)
+	log.PanicIf(err)
+
+	expected := ArrayItem{
+		Name:       itemName,
+		Attributes: map[xml.Name]interface{}{},
+		CharData:   "978-3-16-148410-0",
+		Qualifiers: Qualifiers{
+			qualifierName: "ISBN",
+		},
+	}
+
+	if reflect.DeepEqual(actual, expected) != true {
+		t.Fatalf("ArrayItem not correct: %s", actual)
+	} else if actual.String() != "ArrayItem<NAME={[rdf]li} ATTR={} CHAR-DATA=[978-3-16-148410-0] QUALIFIERS={[xmp]Scheme=[ISBN]}>" {
+		t.Fatalf("String not correct: [%s]", actual.String())
+	}
+}
+
+func TestBaseArrayValue_constructArrayItem_Struct(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+
+	itemName := xml.Name{Space: RdfUri, Local: "li"}
+	fieldName := xml.Name{Space: xmpUri, Local: "action"}
+
+	elements := []interface{}{
+		xml.StartElement{
+			Name: itemName,
+			Attr: []xml.Attr{
+				{Name: xml.Name{Space: RdfUri, Local: "item1"}, Value: "test_value_1"},
+			},
+		},
+		xml.StartElement{Name: fieldName},
+		"saved",
+		xml.EndElement{Name: fieldName},
+		xml.EndElement{Name: itemName},
+	}
+
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, nil)
+
+	actual, err := bav.constructArrayItem(elements)
+	log.PanicIf(err)
+
+	// Without a value, the child nodes are struct fields and are folded into
+	// the attributes.
+
+	expected := ArrayItem{
+		Name: itemName,
+		Attributes: map[xml.Name]interface{}{
+			{Space: RdfUri, Local: "item1"}: "test_value_1",
+			fieldName:                       "saved",
+		},
+	}
+
+	if reflect.DeepEqual(actual, expected) != true {
+		t.Fatalf("ArrayItem not correct: %s", actual)
+	}
+}
+
+func TestBaseArrayValue_constructArrayItem_ChildNotClosed(t *testing.T) {
+	itemName := xml.Name{Space: RdfUri, Local: "li"}
+	fieldName := xml.Name{Space: xmpUri, Local: "action"}
+
+	elements := []interface{}{
+		xml.StartElement{Name: itemName},
+		xml.StartElement{Name: fieldName},
+		"saved",
+		"unexpected",
+		xml.EndElement{Name: itemName},
+	}
+
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, nil)
+
+	_, err := bav.constructArrayItem(elements)
+	if err == nil {
+		t.Fatalf("Expected error for child node that is not closed.")
+	}
+}
+
 func TestBaseArrayValue_innerItems_WithChardata(t *testing.T) {
 	defer xmpregistry.Clear()
 	registerTestNamespaces()
 
 	bav := getTestSequenceBaseArrayValueWithChardata()
 
-	actualItems, err := bav.innerItems(true)
+	actualItems, err := bav.innerItems()
 	log.PanicIf(err)
 
 	itemName := xml.Name{Space: RdfUri, Local: "li"}
@@ -275,6 +392,68 @@ func TestBaseArrayValue_innerItems_WithChardata(t *testing.T) {
 	}
 }
 
+func TestBaseArrayValue_ContainerName(t *testing.T) {
+	bav := getTestSequenceBaseArrayValueWithChardata()
+
+	if bav.ContainerName() != (xml.Name{Space: RdfUri, Local: "Seq"}) {
+		t.Fatalf("Container name not correct: %v", bav.ContainerName())
+	}
+
+	bav = newBaseArrayValue(xmpregistry.Default(), testPropertyName, nil)
+
+	if bav.ContainerName() != (xml.Name{}) {
+		t.Fatalf("Expected empty container name for empty array: %v", bav.ContainerName())
+	}
+}
+
+func TestBaseArrayValue_ItemElements(t *testing.T) {
+	bav := getTestSequenceBaseArrayValueWithChardata()
+
+	itemElements, err := bav.ItemElements()
+	log.PanicIf(err)
+
+	expected := [][]interface{}{
+		bav.collected[1:4],
+		bav.collected[4:7],
+	}
+
+	if reflect.DeepEqual(itemElements, expected) != true {
+		t.Fatalf("Item elements not correct: %v", itemElements)
+	}
+}
+
+func TestBaseArrayValue_ItemElements_Unbalanced(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+
+	items := getTestSequenceItemsWithChardata()
+
+	// Drop the close-tag of the last item.
+	items = append(items[:len(items)-2], items[len(items)-1])
+
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, items)
+
+	_, err := bav.ItemElements()
+	if err == nil {
+		t.Fatalf("Expected error for unbalanced array.")
+	} else if err.Error() != "array item was not closed: [[rdf]aa.[xmp]bb]" {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	}
+}
+
+func TestNewCollected(t *testing.T) {
+	bav := getTestSequenceBaseArrayValueWithChardata()
+
+	itemElements, err := bav.ItemElements()
+	log.PanicIf(err)
+
+	collected := NewCollected(bav.ContainerName(), itemElements)
+
+	if reflect.DeepEqual(collected, bav.collected) != true {
+		t.Fatalf("Collected elements not correct: %v", collected)
+	}
+}
+
 // Ordered array
 
 func TestNewOrderedArrayValue(t *testing.T) {
@@ -283,7 +462,7 @@ func TestNewOrderedArrayValue(t *testing.T) {
 		xmpregistry.XmlName{Space: RdfUri, Local: "some_node"},
 	}
 
-	bav := newBaseArrayValue(xpn, nil)
+	bav := newBaseArrayValue(xmpregistry.Default(), xpn, nil)
 	newOrderedArrayValue(bav)
 }
 
@@ -292,7 +471,7 @@ func TestOrderedArrayValue_String(t *testing.T) {
 		xmpregistry.XmlName{Space: RdfUri, Local: "some_node"},
 	}
 
-	bav := newBaseArrayValue(xpn, []interface{}{"aa", "bb", "cc"})
+	bav := newBaseArrayValue(xmpregistry.Default(), xpn, []interface{}{"aa", "bb", "cc"})
 	oav := newOrderedArrayValue(bav)
 
 	if oav.String() != "OrderedArray<COUNT=(3)>" {
@@ -366,7 +545,7 @@ func TestOrderedArrayFieldType_New(t *testing.T) {
 	oaft := OrderedArrayFieldType{}
 
 	items := getTestSequenceItemsWithChardata()
-	av := oaft.New(testPropertyName, items)
+	av := oaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	actualItems, err := av.(OrderedArrayValue).Items()
 	log.PanicIf(err)
@@ -522,7 +701,7 @@ func TestOrderedResourceEventArrayFieldType_New(t *testing.T) {
 	items := getTestSequenceItemsWithChardata()
 
 	oreaft := OrderedResourceEventArrayFieldType{}
-	av := oreaft.New(testPropertyName, items)
+	av := oreaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	oreav := av.(OrderedResourceEventArrayValue)
 
@@ -622,7 +801,7 @@ func TestNewUnorderedArrayValue(t *testing.T) {
 		xmpregistry.XmlName{Space: RdfUri, Local: "some_node"},
 	}
 
-	bav := newBaseArrayValue(xpn, nil)
+	bav := newBaseArrayValue(xmpregistry.Default(), xpn, nil)
 	newUnorderedArrayValue(bav)
 }
 
@@ -631,7 +810,7 @@ func TestUnorderedArrayValue_String(t *testing.T) {
 		xmpregistry.XmlName{Space: RdfUri, Local: "some_node"},
 	}
 
-	bav := newBaseArrayValue(xpn, []interface{}{"aa", "bb", "cc"})
+	bav := newBaseArrayValue(xmpregistry.Default(), xpn, []interface{}{"aa", "bb", "cc"})
 	uav := newUnorderedArrayValue(bav)
 
 	if uav.String() != "UnorderedArray<COUNT=(3)>" {
@@ -689,7 +868,7 @@ func TestUnorderedArrayFieldType_New(t *testing.T) {
 	uaft := UnorderedArrayFieldType{}
 
 	items := getTestBagItemsWithChardata()
-	av := uaft.New(testPropertyName, items)
+	av := uaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	actualItems, err := av.(UnorderedArrayValue).Items()
 	log.PanicIf(err)
@@ -736,7 +915,7 @@ func TestUnorderedAncestorArrayFieldType_New(t *testing.T) {
 	uaaft := UnorderedAncestorArrayFieldType{}
 
 	items := getTestBagItemsWithChardata()
-	av := uaaft.New(testPropertyName, items)
+	av := uaaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	uaav := av.(UnorderedAncestorArrayValue)
 
@@ -793,7 +972,7 @@ func TestUnorderedAncestorArrayValue_Items(t *testing.T) {
 	uaaft := UnorderedAncestorArrayFieldType{}
 
 	items := getTestBagItemsWithChardata()
-	av := uaaft.New(testPropertyName, items)
+	av := uaaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	uaav := av.(UnorderedAncestorArrayValue)
 
@@ -850,7 +1029,7 @@ func TestUnorderedAncestorArrayValue_StringItems(t *testing.T) {
 	uaaft := UnorderedAncestorArrayFieldType{}
 
 	items := getTestBagItemsWithChardata()
-	av := uaaft.New(testPropertyName, items)
+	av := uaaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	uaav := av.(UnorderedAncestorArrayValue)
 
@@ -882,7 +1061,7 @@ func TestUnorderedTextArrayValue_StringItems(t *testing.T) {
 	utaft := UnorderedTextArrayFieldType{}
 
 	items := getTestBagItemsWithChardata()
-	av := utaft.New(testPropertyName, items)
+	av := utaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	utav := av.(UnorderedTextArrayValue)
 
@@ -915,7 +1094,7 @@ func TestNewAlternativeArrayValue(t *testing.T) {
 		xmpregistry.XmlName{Space: RdfUri, Local: "some_node"},
 	}
 
-	bav := newBaseArrayValue(xpn, nil)
+	bav := newBaseArrayValue(xmpregistry.Default(), xpn, nil)
 	newAlternativeArrayValue(bav)
 }
 
@@ -924,7 +1103,7 @@ func TestAlternativeArrayValue_String(t *testing.T) {
 		xmpregistry.XmlName{Space: RdfUri, Local: "some_node"},
 	}
 
-	bav := newBaseArrayValue(xpn, []interface{}{"aa", "bb", "cc"})
+	bav := newBaseArrayValue(xmpregistry.Default(), xpn, []interface{}{"aa", "bb", "cc"})
 	aav := newAlternativeArrayValue(bav)
 
 	if aav.String() != "AlternativeArray<COUNT=(3)>" {
@@ -982,7 +1161,7 @@ func TestAlternativeArrayFieldType_New(t *testing.T) {
 	oaft := AlternativeArrayFieldType{}
 
 	items := getTestAltItemsWithChardata()
-	av := oaft.New(testPropertyName, items)
+	av := oaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	aav := av.(AlternativeArrayValue)
 
@@ -1141,7 +1320,7 @@ func TestLanguageAlternativeArrayFieldType_New(t *testing.T) {
 	laaft := LanguageAlternativeArrayFieldType{}
 
 	items := getTestAltItemsWithChardata()
-	av := laaft.New(testPropertyName, items)
+	av := laaft.New(xmpregistry.Default(), testPropertyName, items)
 
 	laav := av.(LanguageAlternativeArrayValue)
 
@@ -1190,3 +1369,29 @@ func TestLanguageAlternativeArrayFieldType_New(t *testing.T) {
 		t.Fatalf("Items not correct.")
 	}
 }
+
+func TestArrayFieldTypeDescriber(t *testing.T) {
+	cases := []struct {
+		ft       interface{}
+		kind     xmpregistry.ArrayKind
+		itemType interface{}
+	}{
+		{OrderedArrayFieldType{ItemType: IntegerFieldType{}}, xmpregistry.ArrayKindOrdered, IntegerFieldType{}},
+		{OrderedTextArrayFieldType{}, xmpregistry.ArrayKindOrdered, TextFieldType{}},
+		{UnorderedResourceRefArrayFieldType{}, xmpregistry.ArrayKindUnordered, ResourceRefFieldType{}},
+		{UnorderedArrayFieldType{}, xmpregistry.ArrayKindUnordered, nil},
+		{AlternativeArrayFieldType{ItemType: TextFieldType{}}, xmpregistry.ArrayKindAlternative, TextFieldType{}},
+		{LanguageAlternativeArrayFieldType{}, xmpregistry.ArrayKindLanguageAlternative, TextFieldType{}},
+	}
+
+	for _, c := range cases {
+		aftd, ok := c.ft.(xmpregistry.ArrayFieldTypeDescriber)
+		if ok != true {
+			t.Fatalf("Field-type does not describe itself: [%v]", reflect.TypeOf(c.ft))
+		} else if aftd.ArrayKind() != c.kind {
+			t.Fatalf("Kind not correct: [%v] [%s]", reflect.TypeOf(c.ft), aftd.ArrayKind())
+		} else if reflect.DeepEqual(aftd.ItemFieldType(), c.itemType) != true {
+			t.Fatalf("Item-type not correct: [%v] [%v]", reflect.TypeOf(c.ft), aftd.ItemFieldType())
+		}
+	}
+}
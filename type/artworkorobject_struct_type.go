@@ -0,0 +1,120 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// ArtworkOrObject describes an artwork or object in an image (the
+// "Iptc4xmpExt:ArtworkOrObjectDetails" struct).
+type ArtworkOrObject struct {
+	// Title is the title, keyed by language.
+	Title map[string]string
+
+	// Creators are the names of the creators.
+	Creators []string
+
+	// CreatorIds are the identifiers of the creators, in the same order.
+	CreatorIds []string
+
+	// DateCreated is the date that the artwork or object was created.
+	DateCreated XmpDate
+
+	// CircaDateCreated is an approximate date of creation (e.g. "ca. 1900").
+	CircaDateCreated string
+
+	// ContentDescription describes the content, keyed by language.
+	ContentDescription map[string]string
+
+	// ContributionDescription describes the contributions of the creators,
+	// keyed by language.
+	ContributionDescription map[string]string
+
+	// PhysicalDescription describes the physical characteristics, keyed by
+	// language.
+	PhysicalDescription map[string]string
+
+	// StylePeriods are the styles, periods, or movements.
+	StylePeriods []string
+
+	// Source is the organisation or body holding and registering the artwork
+	// or object for inventory purposes.
+	Source string
+
+	// SourceInventoryNumber is the inventory number issued by the source.
+	SourceInventoryNumber string
+
+	// SourceInventoryUrl is the URL of the inventory record.
+	SourceInventoryUrl string
+
+	// CopyrightNotice is the copyright notice.
+	CopyrightNotice string
+
+	// CurrentCopyrightOwnerName is the name of the current copyright owner.
+	CurrentCopyrightOwnerName string
+
+	// CurrentCopyrightOwnerId is the identifier of the current copyright
+	// owner.
+	CurrentCopyrightOwnerId string
+
+	// CurrentLicensorName is the name of the current licensor.
+	CurrentLicensorName string
+
+	// CurrentLicensorId is the identifier of the current licensor.
+	CurrentLicensorId string
+}
+
+// String returns a string representation of the artwork or object.
+func (aoo ArtworkOrObject) String() string {
+	return fmt.Sprintf("ArtworkOrObject<TITLE=%v CREATORS=%v SOURCE=[%s]>", aoo.Title, aoo.Creators, aoo.Source)
+}
+
+// ArtworkOrObjectFieldType is the field-type of artworks or objects
+// ("Iptc4xmpExt:ArtworkOrObject").
+type ArtworkOrObjectFieldType struct {
+}
+
+// ParseItem parses the item to an ArtworkOrObject.
+func (aooft ArtworkOrObjectFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return aooft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to an ArtworkOrObject.
+func (aooft ArtworkOrObjectFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	aoo := ArtworkOrObject{
+		Title:                     sf.languageItems("AOTitle"),
+		Creators:                  sf.textItems("AOCreator"),
+		CreatorIds:                sf.textItems("AOCreatorId"),
+		DateCreated:               sf.date("AODateCreated"),
+		CircaDateCreated:          sf.text("AOCircaDateCreated"),
+		ContentDescription:        sf.languageItems("AOContentDescription"),
+		ContributionDescription:   sf.languageItems("AOContributionDescription"),
+		PhysicalDescription:       sf.languageItems("AOPhysicalDescription"),
+		StylePeriods:              sf.textItems("AOStylePeriod"),
+		Source:                    sf.text("AOSource"),
+		SourceInventoryNumber:     sf.text("AOSourceInvNo"),
+		SourceInventoryUrl:        sf.text("AOSourceInvURL"),
+		CopyrightNotice:           sf.text("AOCopyrightNotice"),
+		CurrentCopyrightOwnerName: sf.text("AOCurrentCopyrightOwnerName"),
+		CurrentCopyrightOwnerId:   sf.text("AOCurrentCopyrightOwnerId"),
+		CurrentLicensorName:       sf.text("AOCurrentLicensorName"),
+		CurrentLicensorId:         sf.text("AOCurrentLicensorId"),
+	}
+
+	return aoo, nil
+}
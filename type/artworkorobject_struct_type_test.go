@@ -0,0 +1,58 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestArtworkOrObjectFieldType_ParseItem(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+	registerTestXmlNamespace()
+
+	dateCreated := NewXmpDate(time.Date(1889, 1, 1, 0, 0, 0, 0, time.UTC), DatePrecisionYear, false)
+	creators := OrderedArrayFieldType{ItemType: ProperNameFieldType{}}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "Vincent van Gogh"))
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "AOTitle"}:       getTestLanguageAlternatives("x-default", "The Starry Night"),
+			{Space: Iptc4xmpExtUri, Local: "AOCreator"}:     creators,
+			{Space: Iptc4xmpExtUri, Local: "AODateCreated"}: dateCreated,
+			{Space: Iptc4xmpExtUri, Local: "AOSource"}:      "Museum of Modern Art",
+			{Space: Iptc4xmpExtUri, Local: "AOSourceInvNo"}: "472.1941",
+		},
+	}
+
+	parsed, err := ArtworkOrObjectFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := ArtworkOrObject{
+		Title:                 map[string]string{"x-default": "The Starry Night"},
+		Creators:              []string{"Vincent van Gogh"},
+		DateCreated:           dateCreated,
+		Source:                "Museum of Modern Art",
+		SourceInventoryNumber: "472.1941",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Artwork not correct: %s", parsed)
+	}
+
+	if parsed.(ArtworkOrObject).String() != "ArtworkOrObject<TITLE=map[x-default:The Starry Night] CREATORS=[Vincent van Gogh] SOURCE=[Museum of Modern Art]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(ArtworkOrObject).String())
+	}
+}
+
+func TestArtworkOrObjectFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := ArtworkOrObjectFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -5,11 +5,13 @@ type BooleanFieldValue struct {
 	raw string
 }
 
-// Parse parses the raw string value.
+// Parse parses the raw string value. The specification requires "True" or
+// "False", but Camera Raw writes some values (e.g. "crs:CorrectionActive") in
+// lowercase.
 func (bfv BooleanFieldValue) Parse() (parsed interface{}, err error) {
-	if bfv.raw == "True" {
+	if bfv.raw == "True" || bfv.raw == "true" {
 		return true, nil
-	} else if bfv.raw == "False" {
+	} else if bfv.raw == "False" || bfv.raw == "false" {
 		return false, nil
 	}
 
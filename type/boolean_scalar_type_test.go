@@ -33,3 +33,30 @@ func TestBooleanFieldType_GetValueParser_False(t *testing.T) {
 		t.Fatalf("Parse is not correct: [%s]", parsed)
 	}
 }
+
+func TestBooleanFieldType_GetValueParser_Lowercase(t *testing.T) {
+	bft := BooleanFieldType{}
+
+	parsed, err := bft.GetValueParser("true").Parse()
+	log.PanicIf(err)
+
+	if parsed != true {
+		t.Fatalf("Parse is not correct: [%s]", parsed)
+	}
+
+	parsed, err = bft.GetValueParser("false").Parse()
+	log.PanicIf(err)
+
+	if parsed != false {
+		t.Fatalf("Parse is not correct: [%s]", parsed)
+	}
+}
+
+func TestBooleanFieldType_GetValueParser_Invalid(t *testing.T) {
+	bft := BooleanFieldType{}
+
+	_, err := bft.GetValueParser("TRUE").Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected invalid value: [%v]", err)
+	}
+}
@@ -0,0 +1,57 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// CfaPattern is the color filter array geometry of the image sensor (the
+// "exif:CFAPattern" struct). Values holds the color of each cell of the
+// pattern in row-major order.
+type CfaPattern struct {
+	// Columns is the number of columns in the pattern.
+	Columns int64
+
+	// Rows is the number of rows in the pattern.
+	Rows int64
+
+	// Values are the colors of the cells (0=red, 1=green, 2=blue, 3=cyan,
+	// 4=magenta, 5=yellow, 6=white).
+	Values []int64
+}
+
+// String returns a string representation of the pattern.
+func (cp CfaPattern) String() string {
+	return fmt.Sprintf("CfaPattern<COLUMNS=(%d) ROWS=(%d) VALUES=%v>", cp.Columns, cp.Rows, cp.Values)
+}
+
+// CfaPatternFieldType is the field-type of color filter array patterns
+// ("exif:CFAPattern").
+type CfaPatternFieldType struct {
+}
+
+// ParseStruct parses the fields to a CfaPattern.
+func (cpft CfaPatternFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(ExifUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	cp := CfaPattern{
+		Columns: sf.integer("Columns"),
+		Rows:    sf.integer("Rows"),
+		Values:  sf.integerItems("Values"),
+	}
+
+	return cp, nil
+}
@@ -0,0 +1,46 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestCfaPatternFieldType_ParseStruct(t *testing.T) {
+	values := OrderedArrayFieldType{ItemType: IntegerFieldType{}}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "0", "1", "1", "2"))
+
+	fields := map[xml.Name]interface{}{
+		{Space: ExifUri, Local: "Columns"}: int64(2),
+		{Space: ExifUri, Local: "Rows"}:    "2",
+		{Space: ExifUri, Local: "Values"}:  values,
+	}
+
+	parsed, err := CfaPatternFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := CfaPattern{
+		Columns: 2,
+		Rows:    2,
+		Values:  []int64{0, 1, 1, 2},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("CFA pattern not correct: %s", parsed)
+	}
+
+	if parsed.(CfaPattern).String() != "CfaPattern<COLUMNS=(2) ROWS=(2) VALUES=[0 1 1 2]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(CfaPattern).String())
+	}
+}
+
+func TestCfaPatternFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := CfaPatternFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
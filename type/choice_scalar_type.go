@@ -2,6 +2,7 @@ package xmptype
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
@@ -94,3 +95,103 @@ func (ccft ClosedChoiceFieldType) GetValueParser(raw string) ScalarValueParser {
 	panic(ErrChoicesNotOverridden)
 
 }
+
+// ChoiceDefinition is a single defined choice of a field.
+type ChoiceDefinition struct {
+	// Code is the value as stored in the document.
+	Code string
+
+	// Label is the human-readable description of the code.
+	Label string
+}
+
+// Choice is a parsed choice value.
+type Choice struct {
+	// Code is the value as stored in the document.
+	Code string
+
+	// Label is the human-readable description of the code. It is empty if the
+	// code is not one of the defined choices (only possible for open
+	// choices).
+	Label string
+}
+
+// IsDefined returns true if the code is one of the defined choices.
+func (c Choice) IsDefined() bool {
+	return c.Label != ""
+}
+
+// String returns a string representation of the choice.
+func (c Choice) String() string {
+	if c.Label == "" {
+		return c.Code
+	}
+
+	return fmt.Sprintf("%s (%s)", c.Label, c.Code)
+}
+
+// ChoiceFieldValue knows how to parse a value that is taken from a set of
+// defined choices. It returns a Choice having both the code and its label.
+type ChoiceFieldValue struct {
+	raw      string
+	choices  []ChoiceDefinition
+	isClosed bool
+}
+
+// NewChoiceFieldValue returns a new struct. If isClosed is true, a value that
+// does not appear among the choices is not valid.
+func NewChoiceFieldValue(raw string, choices []ChoiceDefinition, isClosed bool) ChoiceFieldValue {
+	return ChoiceFieldValue{
+		raw:      raw,
+		choices:  choices,
+		isClosed: isClosed,
+	}
+}
+
+// Raw returns the original text to be parsed.
+func (cfv ChoiceFieldValue) Raw() string {
+	return cfv.raw
+}
+
+// Parse returns a Choice for the value. Returns ErrValueNotValid if the
+// choices are closed and the value does not appear among them.
+func (cfv ChoiceFieldValue) Parse() (parsed interface{}, err error) {
+	for _, cd := range cfv.choices {
+		if cd.Code == cfv.raw {
+			c := Choice{
+				Code:  cd.Code,
+				Label: cd.Label,
+			}
+
+			return c, nil
+		}
+	}
+
+	if cfv.isClosed == true {
+		return nil, ErrValueNotValid
+	}
+
+	c := Choice{
+		Code: cfv.raw,
+	}
+
+	return c, nil
+}
+
+// DefinedChoiceFieldType describes a choice value whose choices are given
+// with the field-type rather than by a dedicated type (e.g. when loaded from a
+// schema).
+type DefinedChoiceFieldType struct {
+	// Choices are the defined choices.
+	Choices []ChoiceDefinition
+
+	// IsClosed indicates that a value that does not appear among the choices
+	// is not valid.
+	IsClosed bool
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (dcft DefinedChoiceFieldType) GetValueParser(raw string) ScalarValueParser {
+	return NewChoiceFieldValue(raw, dcft.Choices, dcft.IsClosed)
+}
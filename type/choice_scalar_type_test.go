@@ -228,3 +228,92 @@ func TestClosedChoiceFieldType_GetValueParser_Miss(t *testing.T) {
 	_, err := svp.Parse()
 	log.PanicIf(err)
 }
+
+// Defined-choices tests
+
+func TestChoiceFieldValue_Parse_Closed(t *testing.T) {
+	choices := []ChoiceDefinition{
+		{Code: "1", Label: "One"},
+	}
+
+	cfv := NewChoiceFieldValue("1", choices, true)
+
+	if cfv.Raw() != "1" {
+		t.Fatalf("Raw value not correct: [%s]", cfv.Raw())
+	}
+
+	parsed, err := cfv.Parse()
+	log.PanicIf(err)
+
+	if parsed != (Choice{Code: "1", Label: "One"}) {
+		t.Fatalf("Parse not correct: [%v]", parsed)
+	}
+
+	_, err = NewChoiceFieldValue("2", choices, true).Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestChoiceFieldValue_Parse_Open(t *testing.T) {
+	choices := []ChoiceDefinition{
+		{Code: "1", Label: "One"},
+	}
+
+	parsed, err := NewChoiceFieldValue("2", choices, false).Parse()
+	log.PanicIf(err)
+
+	c := parsed.(Choice)
+
+	if c != (Choice{Code: "2"}) {
+		t.Fatalf("Parse not correct: [%v]", c)
+	} else if c.IsDefined() != false {
+		t.Fatalf("Expected choice to not be defined.")
+	}
+}
+
+func TestChoice_String(t *testing.T) {
+	c := Choice{Code: "3", Label: "RGB color"}
+
+	if c.String() != "RGB color (3)" {
+		t.Fatalf("String not correct: [%s]", c.String())
+	} else if c.IsDefined() != true {
+		t.Fatalf("Expected choice to be defined.")
+	}
+
+	c = Choice{Code: "other"}
+
+	if c.String() != "other" {
+		t.Fatalf("String not correct: [%s]", c.String())
+	}
+}
+
+func TestDefinedChoiceFieldType_GetValueParser(t *testing.T) {
+	ft := DefinedChoiceFieldType{
+		Choices: []ChoiceDefinition{
+			{Code: "1", Label: "One"},
+		},
+		IsClosed: true,
+	}
+
+	parsed, err := ft.GetValueParser("1").Parse()
+	log.PanicIf(err)
+
+	if parsed != (Choice{Code: "1", Label: "One"}) {
+		t.Fatalf("Parse not correct: [%v]", parsed)
+	}
+
+	_, err = ft.GetValueParser("2").Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error for undefined choice: [%v]", err)
+	}
+
+	ft.IsClosed = false
+
+	parsed, err = ft.GetValueParser("2").Parse()
+	log.PanicIf(err)
+
+	if parsed != (Choice{Code: "2"}) {
+		t.Fatalf("Parse not correct for open choice: [%v]", parsed)
+	}
+}
@@ -0,0 +1,27 @@
+package xmptype
+
+var (
+	colorantModeChoices = []ChoiceDefinition{
+		{Code: "CMYK", Label: "CMYK"},
+		{Code: "RGB", Label: "RGB"},
+		{Code: "LAB", Label: "LAB"},
+	}
+)
+
+// ColorantModeFieldValue knows how to parse a colorant color-space value.
+type ColorantModeFieldValue struct {
+	ChoiceFieldValue
+}
+
+// ColorantModeFieldType describes a colorant color-space value. The value must
+// be one of the defined modes.
+type ColorantModeFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (cmft ColorantModeFieldType) GetValueParser(raw string) ScalarValueParser {
+	return ColorantModeFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, colorantModeChoices, true),
+	}
+}
@@ -0,0 +1,36 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestColorantModeFieldType_GetValueParser(t *testing.T) {
+	ft := ColorantModeFieldType{}
+	scp := ft.GetValueParser("LAB")
+
+	fv := scp.(ColorantModeFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "LAB",
+		Label: "LAB",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestColorantModeFieldType_GetValueParser_NotValid(t *testing.T) {
+	ft := ColorantModeFieldType{}
+	scp := ft.GetValueParser("HSV")
+
+	_, err := scp.Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
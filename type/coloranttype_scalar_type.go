@@ -0,0 +1,26 @@
+package xmptype
+
+var (
+	colorantTypeChoices = []ChoiceDefinition{
+		{Code: "PROCESS", Label: "Process"},
+		{Code: "SPOT", Label: "Spot"},
+	}
+)
+
+// ColorantTypeFieldValue knows how to parse a colorant-type value.
+type ColorantTypeFieldValue struct {
+	ChoiceFieldValue
+}
+
+// ColorantTypeFieldType describes a colorant-type value. The value must be one
+// of the defined types.
+type ColorantTypeFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (ctft ColorantTypeFieldType) GetValueParser(raw string) ScalarValueParser {
+	return ColorantTypeFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, colorantTypeChoices, true),
+	}
+}
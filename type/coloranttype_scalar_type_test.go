@@ -0,0 +1,36 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestColorantTypeFieldType_GetValueParser(t *testing.T) {
+	ft := ColorantTypeFieldType{}
+	scp := ft.GetValueParser("SPOT")
+
+	fv := scp.(ColorantTypeFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "SPOT",
+		Label: "Spot",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestColorantTypeFieldType_GetValueParser_NotValid(t *testing.T) {
+	ft := ColorantTypeFieldType{}
+	scp := ft.GetValueParser("MIXED")
+
+	_, err := scp.Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package xmptype
+
+var (
+	colorModeChoices = []ChoiceDefinition{
+		{Code: "0", Label: "Bitmap"},
+		{Code: "1", Label: "Gray scale"},
+		{Code: "2", Label: "Indexed color"},
+		{Code: "3", Label: "RGB color"},
+		{Code: "4", Label: "CMYK color"},
+		{Code: "7", Label: "Multi-channel"},
+		{Code: "8", Label: "Duotone"},
+		{Code: "9", Label: "LAB color"},
+	}
+)
+
+// ColorModeFieldValue knows how to parse a Photoshop color-mode value.
+type ColorModeFieldValue struct {
+	ChoiceFieldValue
+}
+
+// ColorModeFieldType describes a Photoshop color-mode value. The value must be
+// one of the defined modes.
+type ColorModeFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (cmft ColorModeFieldType) GetValueParser(raw string) ScalarValueParser {
+	return ColorModeFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, colorModeChoices, true),
+	}
+}
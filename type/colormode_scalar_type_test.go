@@ -0,0 +1,36 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestColorModeFieldType_GetValueParser(t *testing.T) {
+	ft := ColorModeFieldType{}
+	scp := ft.GetValueParser("3")
+
+	fv := scp.(ColorModeFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "3",
+		Label: "RGB color",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestColorModeFieldType_GetValueParser_NotValid(t *testing.T) {
+	ft := ColorModeFieldType{}
+	scp := ft.GetValueParser("5")
+
+	_, err := scp.Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -39,6 +39,47 @@ func registerTestNamespaces() {
 	xmpregistry.Register(namespace)
 }
 
+// registerTestXmlNamespace registers the "xml" namespace so that languages
+// are parsed.
+func registerTestXmlNamespace() {
+	namespace := xmpregistry.Namespace{
+		Uri:             XmlUri,
+		PreferredPrefix: "xml",
+		Fields: map[string]interface{}{
+			"lang": TextFieldType{},
+		},
+	}
+
+	xmpregistry.Register(namespace)
+}
+
+// getTestLanguageAlternatives returns a language-alternative array having the
+// given pairs of languages and values. The "xml" namespace must be
+// registered.
+func getTestLanguageAlternatives(languagesAndValues ...string) ArrayValue {
+	containerName := xml.Name{Space: RdfUri, Local: "Alt"}
+
+	itemElements := make([][]interface{}, 0, len(languagesAndValues)/2)
+	for i := 0; i < len(languagesAndValues); i += 2 {
+		language := xml.Attr{
+			Name:  xmlLangAttribute,
+			Value: languagesAndValues[i],
+		}
+
+		elements := []interface{}{
+			xml.StartElement{Name: rdfLiTag, Attr: []xml.Attr{language}},
+			languagesAndValues[i+1],
+			xml.EndElement{Name: rdfLiTag},
+		}
+
+		itemElements = append(itemElements, elements)
+	}
+
+	collected := NewCollected(containerName, itemElements)
+
+	return LanguageAlternativeArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, collected)
+}
+
 func getTestSequenceItemsWithChardata() []interface{} {
 	arrayName := xml.Name{Space: RdfUri, Local: "Seq"}
 	itemName := xml.Name{Space: RdfUri, Local: "li"}
@@ -75,7 +116,7 @@ func getTestSequenceItemsWithChardata() []interface{} {
 func getTestSequenceBaseArrayValueWithChardata() baseArrayValue {
 	items := getTestSequenceItemsWithChardata()
 
-	bav := newBaseArrayValue(testPropertyName, items)
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, items)
 	return bav
 }
 
@@ -115,7 +156,7 @@ func getTestBagItemsWithChardata() []interface{} {
 func getTestBagBaseArrayValueWithChardata() baseArrayValue {
 	items := getTestBagItemsWithChardata()
 
-	bav := newBaseArrayValue(testPropertyName, items)
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, items)
 	return bav
 }
 
@@ -155,6 +196,6 @@ func getTestAltItemsWithChardata() []interface{} {
 func getTestAltBaseArrayValueWithChardata() baseArrayValue {
 	items := getTestAltItemsWithChardata()
 
-	bav := newBaseArrayValue(testPropertyName, items)
+	bav := newBaseArrayValue(xmpregistry.Default(), testPropertyName, items)
 	return bav
 }
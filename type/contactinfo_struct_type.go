@@ -0,0 +1,82 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// Iptc4xmpCoreUri is the URI for the "Iptc4xmpCore" namespace. We can't
+	// use the same value from xmpnamespace because xmptype can't import from
+	// it.
+	Iptc4xmpCoreUri = "http://iptc.org/std/Iptc4xmpCore/1.0/xmlns/"
+)
+
+// ContactInfo is the contact information of the creator of an image (the
+// "Iptc4xmpCore:ContactInfo" struct).
+type ContactInfo struct {
+	// Address is the street address. It may have several lines.
+	Address string
+
+	// City is the city.
+	City string
+
+	// Region is the state or province.
+	Region string
+
+	// PostalCode is the postal code.
+	PostalCode string
+
+	// Country is the country.
+	Country string
+
+	// Phone is one or more work phone numbers, separated by commas.
+	Phone string
+
+	// Email is one or more work email addresses, separated by commas.
+	Email string
+
+	// Url is one or more work web addresses, separated by commas.
+	Url string
+}
+
+// String returns a string representation of the contact information.
+func (ci ContactInfo) String() string {
+	return fmt.Sprintf("ContactInfo<CITY=[%s] COUNTRY=[%s] EMAIL=[%s]>", ci.City, ci.Country, ci.Email)
+}
+
+// ContactInfoFieldType is the field-type of contact information (e.g.
+// "Iptc4xmpCore:CreatorContactInfo").
+type ContactInfoFieldType struct {
+}
+
+// ParseStruct parses the fields to a ContactInfo.
+func (cift ContactInfoFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpCoreUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	ci := ContactInfo{
+		Address:    sf.text("CiAdrExtadr"),
+		City:       sf.text("CiAdrCity"),
+		Region:     sf.text("CiAdrRegion"),
+		PostalCode: sf.text("CiAdrPcode"),
+		Country:    sf.text("CiAdrCtry"),
+		Phone:      sf.text("CiTelWork"),
+		Email:      sf.text("CiEmailWork"),
+		Url:        sf.text("CiUrlWork"),
+	}
+
+	return ci, nil
+}
@@ -0,0 +1,52 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestContactInfoFieldType_ParseStruct(t *testing.T) {
+	fields := map[xml.Name]interface{}{
+		{Space: Iptc4xmpCoreUri, Local: "CiAdrExtadr"}: "1 Main Street",
+		{Space: Iptc4xmpCoreUri, Local: "CiAdrCity"}:   "Springfield",
+		{Space: Iptc4xmpCoreUri, Local: "CiAdrRegion"}: "Oregon",
+		{Space: Iptc4xmpCoreUri, Local: "CiAdrPcode"}:  "97477",
+		{Space: Iptc4xmpCoreUri, Local: "CiAdrCtry"}:   "USA",
+		{Space: Iptc4xmpCoreUri, Local: "CiTelWork"}:   "+1 555 0100",
+		{Space: Iptc4xmpCoreUri, Local: "CiEmailWork"}: "photo@example.com",
+		{Space: Iptc4xmpCoreUri, Local: "CiUrlWork"}:   "http://example.com",
+	}
+
+	parsed, err := ContactInfoFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := ContactInfo{
+		Address:    "1 Main Street",
+		City:       "Springfield",
+		Region:     "Oregon",
+		PostalCode: "97477",
+		Country:    "USA",
+		Phone:      "+1 555 0100",
+		Email:      "photo@example.com",
+		Url:        "http://example.com",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Contact info not correct: %s", parsed)
+	}
+
+	if parsed.(ContactInfo).String() != "ContactInfo<CITY=[Springfield] COUNTRY=[USA] EMAIL=[photo@example.com]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(ContactInfo).String())
+	}
+}
+
+func TestContactInfoFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := ContactInfoFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
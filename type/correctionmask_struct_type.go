@@ -0,0 +1,172 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// CorrectionMask describes where a Camera Raw local correction or retouch
+// area applies (the items of "crs:CorrectionMasks" and "crs:Masks"). Which
+// fields are meaningful depends on the kind of mask: brush strokes
+// ("Mask/Paint"), linear gradients ("Mask/Gradient"), radial gradients
+// ("Mask/CircularGradient"), and so on. Newer versions group masks
+// ("Mask/Image") and nest the grouped masks.
+type CorrectionMask struct {
+	// What is the kind of mask (e.g. "Mask/Paint").
+	What string
+
+	// Value is the strength of the mask (0-1).
+	Value float64
+
+	// Radius is the radius of a brush stroke.
+	Radius float64
+
+	// Flow is the flow of a brush stroke.
+	Flow float64
+
+	// CenterWeight is the hardness of a brush stroke.
+	CenterWeight float64
+
+	// Dabs are the points of a brush stroke (e.g. "d 0.512 0.301").
+	Dabs []string
+
+	// ZeroX is the horizontal position where a linear gradient has no
+	// effect.
+	ZeroX float64
+
+	// ZeroY is the vertical position where a linear gradient has no effect.
+	ZeroY float64
+
+	// FullX is the horizontal position where a linear gradient has its full
+	// effect.
+	FullX float64
+
+	// FullY is the vertical position where a linear gradient has its full
+	// effect.
+	FullY float64
+
+	// Top is the top of the bounds of a radial gradient.
+	Top float64
+
+	// Left is the left of the bounds of a radial gradient.
+	Left float64
+
+	// Bottom is the bottom of the bounds of a radial gradient.
+	Bottom float64
+
+	// Right is the right of the bounds of a radial gradient.
+	Right float64
+
+	// Angle is the rotation of a radial gradient.
+	Angle float64
+
+	// Midpoint is the midpoint of a radial gradient.
+	Midpoint float64
+
+	// Roundness is the roundness of a radial gradient.
+	Roundness float64
+
+	// Feather is the feathering of a radial gradient.
+	Feather float64
+
+	// Flipped indicates that a radial gradient applies outside of its bounds.
+	Flipped bool
+
+	// Version is the version of the mask.
+	Version int64
+
+	// SizeX is the horizontal size of the mask.
+	SizeX float64
+
+	// SizeY is the vertical size of the mask.
+	SizeY float64
+
+	// Active indicates that the mask is enabled.
+	Active bool
+
+	// Name is the name of the mask.
+	Name string
+
+	// BlendMode is how the mask is combined with the other masks of the
+	// group.
+	BlendMode int64
+
+	// Inverted indicates that the mask is inverted.
+	Inverted bool
+
+	// SubType identifies the kind of AI-selected mask (e.g. subject or sky).
+	SubType int64
+
+	// SyncId identifies the mask when synchronizing.
+	SyncId string
+
+	// Masks are the masks that are grouped by this mask.
+	Masks []CorrectionMask
+}
+
+// String returns a string representation of the mask.
+func (cm CorrectionMask) String() string {
+	return fmt.Sprintf("CorrectionMask<WHAT=[%s] VALUE=(%g) DABS=(%d) MASKS=(%d)>", cm.What, cm.Value, len(cm.Dabs), len(cm.Masks))
+}
+
+// CorrectionMaskFieldType is the item-type of Camera Raw masks
+// ("crs:CorrectionMasks" and "crs:Masks").
+type CorrectionMaskFieldType struct {
+}
+
+// ParseItem parses the item to a CorrectionMask.
+func (cmft CorrectionMaskFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return cmft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a CorrectionMask.
+func (cmft CorrectionMaskFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(CrsUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	cm := CorrectionMask{
+		What:         sf.text("What"),
+		Value:        sf.real("MaskValue"),
+		Radius:       sf.real("Radius"),
+		Flow:         sf.real("Flow"),
+		CenterWeight: sf.real("CenterWeight"),
+		Dabs:         sf.textItems("Dabs"),
+		ZeroX:        sf.real("ZeroX"),
+		ZeroY:        sf.real("ZeroY"),
+		FullX:        sf.real("FullX"),
+		FullY:        sf.real("FullY"),
+		Top:          sf.real("Top"),
+		Left:         sf.real("Left"),
+		Bottom:       sf.real("Bottom"),
+		Right:        sf.real("Right"),
+		Angle:        sf.real("Angle"),
+		Midpoint:     sf.real("Midpoint"),
+		Roundness:    sf.real("Roundness"),
+		Feather:      sf.real("Feather"),
+		Flipped:      sf.boolean("Flipped"),
+		Version:      sf.integer("Version"),
+		SizeX:        sf.real("SizeX"),
+		SizeY:        sf.real("SizeY"),
+		Active:       sf.boolean("MaskActive"),
+		Name:         sf.text("MaskName"),
+		BlendMode:    sf.integer("MaskBlendMode"),
+		Inverted:     sf.boolean("MaskInverted"),
+		SubType:      sf.integer("MaskSubType"),
+		SyncId:       sf.text("MaskSyncID"),
+		Masks:        sf.correctionMasks("Masks"),
+	}
+
+	return cm, nil
+}
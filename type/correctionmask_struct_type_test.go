@@ -0,0 +1,56 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestCorrectionMaskFieldType_ParseItem(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: CrsUri, Local: "What"}:       "Mask/Gradient",
+			{Space: CrsUri, Local: "MaskValue"}:  1.0,
+			{Space: CrsUri, Local: "ZeroX"}:      0.5,
+			{Space: CrsUri, Local: "ZeroY"}:      0.1,
+			{Space: CrsUri, Local: "FullX"}:      0.5,
+			{Space: CrsUri, Local: "FullY"}:      0.4,
+			{Space: CrsUri, Local: "Version"}:    int64(2),
+			{Space: CrsUri, Local: "MaskActive"}: true,
+			{Space: CrsUri, Local: "MaskName"}:   "Sky",
+		},
+	}
+
+	parsed, err := CorrectionMaskFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := CorrectionMask{
+		What:    "Mask/Gradient",
+		Value:   1,
+		ZeroX:   0.5,
+		ZeroY:   0.1,
+		FullX:   0.5,
+		FullY:   0.4,
+		Version: 2,
+		Active:  true,
+		Name:    "Sky",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Mask not correct: %s", parsed)
+	}
+
+	if parsed.(CorrectionMask).String() != "CorrectionMask<WHAT=[Mask/Gradient] VALUE=(1) DABS=(0) MASKS=(0)>" {
+		t.Fatalf("String not correct: [%s]", parsed.(CorrectionMask).String())
+	}
+}
+
+func TestCorrectionMaskFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := CorrectionMaskFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
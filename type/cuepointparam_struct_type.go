@@ -0,0 +1,56 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// CuePointParam is a single key/value parameter of a marker's cue-point (the
+// "xmpDM:CuePointParam" struct).
+type CuePointParam struct {
+	// Key is the name of the parameter.
+	Key string
+
+	// Value is the value of the parameter.
+	Value string
+}
+
+// String returns a string representation of the parameter.
+func (cpp CuePointParam) String() string {
+	return fmt.Sprintf("CuePointParam<KEY=[%s] VALUE=[%s]>", cpp.Key, cpp.Value)
+}
+
+// CuePointParamFieldType is the item-type of arrays of cue-point parameters
+// (e.g. "xmpDM:cuePointParams").
+type CuePointParamFieldType struct {
+}
+
+// ParseItem parses the item to a CuePointParam.
+func (cppft CuePointParamFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return cppft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a CuePointParam.
+func (cppft CuePointParamFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(XmpDmUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	cpp := CuePointParam{
+		Key:   sf.text("key"),
+		Value: sf.text("value"),
+	}
+
+	return cpp, nil
+}
@@ -0,0 +1,78 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestCuePointParamFieldType_ParseItem(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: XmpDmUri, Local: "key"}:   "scene",
+			{Space: XmpDmUri, Local: "value"}: "2",
+		},
+	}
+
+	parsed, err := CuePointParamFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := CuePointParam{
+		Key:   "scene",
+		Value: "2",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Cue-point parameter not correct: %s", parsed)
+	} else if parsed.(CuePointParam).String() != "CuePointParam<KEY=[scene] VALUE=[2]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(CuePointParam).String())
+	}
+}
+
+func TestCuePointParamFieldType_ParseItem_NotCuePointParam(t *testing.T) {
+	ai := ArrayItem{
+		CharData: "not a parameter",
+	}
+
+	_, err := CuePointParamFieldType{}.ParseItem(ai)
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestOrderedCuePointParamArrayValue_CuePointParams(t *testing.T) {
+	keyName := xml.Name{Space: XmpDmUri, Local: "key"}
+	valueName := xml.Name{Space: XmpDmUri, Local: "value"}
+
+	itemElements := [][]interface{}{
+		{
+			xml.StartElement{Name: rdfLiTag},
+			xml.StartElement{Name: keyName},
+			"scene",
+			xml.EndElement{Name: keyName},
+			xml.StartElement{Name: valueName},
+			"2",
+			xml.EndElement{Name: valueName},
+			xml.EndElement{Name: rdfLiTag},
+		},
+	}
+
+	collected := NewCollected(rdfSeqTag, itemElements)
+	av := OrderedCuePointParamArrayFieldType{}.New(xmpregistry.Default(), nil, collected)
+
+	params, err := av.(OrderedCuePointParamArrayValue).CuePointParams()
+	log.PanicIf(err)
+
+	expected := []CuePointParam{
+		{Key: "scene", Value: "2"},
+	}
+
+	if reflect.DeepEqual(params, expected) != true {
+		t.Fatalf("Cue-point parameters not correct: %v", params)
+	}
+}
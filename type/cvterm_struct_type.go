@@ -0,0 +1,71 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// Iptc4xmpExtUri is the URI for the "Iptc4xmpExt" namespace. We can't use
+	// the same value from xmpnamespace because xmptype can't import from it.
+	Iptc4xmpExtUri = "http://iptc.org/std/Iptc4xmpExt/2008-02-29/"
+)
+
+// CvTerm is a term taken from a controlled vocabulary (the "Iptc4xmpExt:
+// CVTerm" struct).
+type CvTerm struct {
+	// VocabularyId is the URI of the controlled vocabulary.
+	VocabularyId string
+
+	// Id is the URI of the term.
+	Id string
+
+	// Name is the name of the term, keyed by language.
+	Name map[string]string
+
+	// RefinedAbout is the URI of a term that refines what the content is
+	// about.
+	RefinedAbout string
+}
+
+// String returns a string representation of the term.
+func (ct CvTerm) String() string {
+	return fmt.Sprintf("CvTerm<ID=[%s] NAME=%v>", ct.Id, ct.Name)
+}
+
+// CvTermFieldType is the field-type of controlled-vocabulary terms (e.g.
+// "Iptc4xmpExt:AboutCvTerm").
+type CvTermFieldType struct {
+}
+
+// ParseItem parses the item to a CvTerm.
+func (ctft CvTermFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return ctft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a CvTerm.
+func (ctft CvTermFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	ct := CvTerm{
+		VocabularyId: sf.text("CvId"),
+		Id:           sf.text("CvTermId"),
+		Name:         sf.languageItems("CvTermName"),
+		RefinedAbout: sf.text("CvTermRefinedAbout"),
+	}
+
+	return ct, nil
+}
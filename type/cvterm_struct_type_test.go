@@ -0,0 +1,50 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestCvTermFieldType_ParseItem(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+	registerTestXmlNamespace()
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "CvId"}:       "http://cv.iptc.org/newscodes/scene/",
+			{Space: Iptc4xmpExtUri, Local: "CvTermId"}:   "http://cv.iptc.org/newscodes/scene/011900",
+			{Space: Iptc4xmpExtUri, Local: "CvTermName"}: getTestLanguageAlternatives("x-default", "aerial view"),
+		},
+	}
+
+	parsed, err := CvTermFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := CvTerm{
+		VocabularyId: "http://cv.iptc.org/newscodes/scene/",
+		Id:           "http://cv.iptc.org/newscodes/scene/011900",
+		Name:         map[string]string{"x-default": "aerial view"},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Term not correct: %s", parsed)
+	}
+
+	if parsed.(CvTerm).String() != "CvTerm<ID=[http://cv.iptc.org/newscodes/scene/011900] NAME=map[x-default:aerial view]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(CvTerm).String())
+	}
+}
+
+func TestCvTermFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := CvTermFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -1,47 +1,311 @@
 package xmptype
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
-// DateFieldValue knows how to parse dates/timestamps.
-type DateFieldValue struct {
-	raw string
+// DatePrecision is the most specific component that is present in a date.
+type DatePrecision int
+
+const (
+	// DatePrecisionYear is a date having only a year ("YYYY").
+	DatePrecisionYear DatePrecision = iota
+
+	// DatePrecisionMonth is a date having a year and month ("YYYY-MM").
+	DatePrecisionMonth
+
+	// DatePrecisionDay is a date without a time ("YYYY-MM-DD").
+	DatePrecisionDay
+
+	// DatePrecisionMinute is a timestamp without seconds
+	// ("YYYY-MM-DDThh:mm").
+	DatePrecisionMinute
+
+	// DatePrecisionSecond is a timestamp with whole seconds
+	// ("YYYY-MM-DDThh:mm:ss").
+	DatePrecisionSecond
+
+	// DatePrecisionNanosecond is a timestamp with fractional seconds
+	// ("YYYY-MM-DDThh:mm:ss.s").
+	DatePrecisionNanosecond
+)
+
+var (
+	datePrecisionNames = map[DatePrecision]string{
+		DatePrecisionYear:       "year",
+		DatePrecisionMonth:      "month",
+		DatePrecisionDay:        "day",
+		DatePrecisionMinute:     "minute",
+		DatePrecisionSecond:     "second",
+		DatePrecisionNanosecond: "nanosecond",
+	}
+)
+
+// String returns a string representation of the precision.
+func (dp DatePrecision) String() string {
+	if name, found := datePrecisionNames[dp]; found == true {
+		return name
+	}
+
+	return fmt.Sprintf("DatePrecision<%d>", int(dp))
 }
 
 var (
-	timeLayouts = []string{
-		"2006-01-02T15:04:05.999999999Z-07:00",
+	// dateRe matches the forms allowed by the standard. A zone is only
+	// allowed with a time. "Z" directly followed by an offset is nonstandard
+	// but found in practice.
+	dateRe = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2})(?:T(\d{2}):(\d{2})(?::(\d{2})(?:\.(\d{1,9}))?)?(Z|Z?[+-]\d{2}:\d{2})?)?)?)?$`)
+)
+
+// XmpDate is a date as found in XMP. Unlike a bare time.Time, it retains how
+// much of the date was given and whether it had a timezone, so it can be
+// formatted exactly as it was parsed.
+type XmpDate struct {
+	// Time is the date. Components that were not given are at their minimum
+	// (e.g. "1962" is 1962-01-01T00:00:00). If there was no timezone, this is
+	// the local (wall-clock) time in UTC and does not describe an instant.
+	Time time.Time
 
-		// Nonstandard but found in practice.
-		"2006-01-02T15:04:05.999999999-07:00",
+	// Precision is the most specific component that was given.
+	Precision DatePrecision
 
-		"2006-01-02T15:04:05Z-07:00",
+	// HasZone is true if a timezone was given.
+	HasZone bool
 
-		// Nonstandard but found in practice.
-		"2006-01-02T15:04:05-07:00",
+	// fractionDigits is the number of fractional-second digits that were
+	// given. If zero, trailing zeros are trimmed when formatting.
+	fractionDigits int
 
-		"2006-01-02T15:04Z-07:00",
+	// isUtcDesignator is true if the zone was given as "Z" rather than as an
+	// offset.
+	isUtcDesignator bool
 
-		// Nonstandard but found in practice.
-		"2006-01-02T15:04-07:00",
+	// hasUtcDesignatorPrefix is true if the offset was given after a "Z"
+	// (e.g. "Z+02:00").
+	hasUtcDesignatorPrefix bool
+}
 
-		"2006-01-02",
-		"2006-01",
-		"2006",
+// NewXmpDate returns a date with the given precision. Any components of the
+// time more specific than the precision are dropped. If hasZone is false the
+// wall-clock time of the given time is used without its zone.
+func NewXmpDate(t time.Time, precision DatePrecision, hasZone bool) XmpDate {
+	if hasZone == false {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
 	}
-)
 
-// Parse parses the raw string value.
-func (dfv DateFieldValue) Parse() (parsed interface{}, err error) {
-	for _, layout := range timeLayouts {
-		t, err := time.Parse(layout, dfv.raw)
-		if err == nil {
-			return t, nil
+	year, month, day := t.Date()
+
+	switch precision {
+	case DatePrecisionYear:
+		t = time.Date(year, 1, 1, 0, 0, 0, 0, t.Location())
+	case DatePrecisionMonth:
+		t = time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case DatePrecisionDay:
+		t = time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case DatePrecisionMinute:
+		t = time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, t.Location())
+	case DatePrecisionSecond:
+		t = time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	}
+
+	// The standard only allows a zone with a time.
+	if precision < DatePrecisionMinute {
+		hasZone = false
+	}
+
+	return XmpDate{
+		Time:      t,
+		Precision: precision,
+		HasZone:   hasZone,
+	}
+}
+
+// ParseXmpDate parses any of the date forms allowed by the standard.
+func ParseXmpDate(raw string) (xd XmpDate, err error) {
+	matches := dateRe.FindStringSubmatch(raw)
+	if matches == nil {
+		return xd, ErrValueNotValid
+	}
+
+	components := make([]int, 6)
+	components[1] = 1
+	components[2] = 1
+
+	precision := DatePrecisionYear
+
+	for i, precisionOfComponent := range []DatePrecision{DatePrecisionYear, DatePrecisionMonth, DatePrecisionDay, DatePrecisionMinute, DatePrecisionMinute, DatePrecisionSecond} {
+		if matches[i+1] == "" {
+			break
 		}
+
+		components[i], _ = strconv.Atoi(matches[i+1])
+		precision = precisionOfComponent
+	}
+
+	nanoseconds := 0
+	fraction := matches[7]
+
+	if fraction != "" {
+		precision = DatePrecisionNanosecond
+		nanoseconds, _ = strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+	}
+
+	location := time.UTC
+	zone := matches[8]
+
+	if zone != "" && zone != "Z" {
+		offset := zone[len(zone)-6:]
+
+		hours, _ := strconv.Atoi(offset[1:3])
+		minutes, _ := strconv.Atoi(offset[4:6])
+
+		seconds := hours*60*60 + minutes*60
+		if offset[0] == '-' {
+			seconds = -seconds
+		}
+
+		location = time.FixedZone("", seconds)
+	}
+
+	t := time.Date(components[0], time.Month(components[1]), components[2], components[3], components[4], components[5], nanoseconds, location)
+
+	// Reject components that are out of range (e.g. a thirteenth month)
+	// rather than letting them roll over.
+	if t.Month() != time.Month(components[1]) || t.Day() != components[2] || t.Hour() != components[3] || t.Minute() != components[4] || t.Second() != components[5] {
+		return xd, ErrValueNotValid
+	}
+
+	xd = XmpDate{
+		Time:                   t,
+		Precision:              precision,
+		HasZone:                zone != "",
+		fractionDigits:         len(fraction),
+		isUtcDesignator:        zone == "Z",
+		hasUtcDesignatorPrefix: len(zone) == 7,
+	}
+
+	return xd, nil
+}
+
+// IsZero returns true if this is the zero date.
+func (xd XmpDate) IsZero() bool {
+	return xd.Time.IsZero()
+}
+
+// Format returns the date in the form that it was parsed from. Dates that
+// were not parsed are formatted with a numeric offset and with only as many
+// fractional digits as are significant.
+func (xd XmpDate) Format() string {
+	t := xd.Time
+
+	var parts []string
+
+	parts = append(parts, fmt.Sprintf("%04d", t.Year()))
+
+	if xd.Precision >= DatePrecisionMonth {
+		parts = append(parts, fmt.Sprintf("-%02d", int(t.Month())))
+	}
+
+	if xd.Precision >= DatePrecisionDay {
+		parts = append(parts, fmt.Sprintf("-%02d", t.Day()))
+	}
+
+	if xd.Precision >= DatePrecisionMinute {
+		parts = append(parts, fmt.Sprintf("T%02d:%02d", t.Hour(), t.Minute()))
+	}
+
+	if xd.Precision >= DatePrecisionSecond {
+		parts = append(parts, fmt.Sprintf(":%02d", t.Second()))
+	}
+
+	if xd.Precision >= DatePrecisionNanosecond {
+		fraction := fmt.Sprintf("%09d", t.Nanosecond())
+
+		if xd.fractionDigits > 0 {
+			fraction = fraction[:xd.fractionDigits]
+		} else {
+			fraction = strings.TrimRight(fraction, "0")
+		}
+
+		if fraction != "" {
+			parts = append(parts, "."+fraction)
+		}
+	}
+
+	if xd.HasZone == true {
+		if xd.isUtcDesignator == true {
+			parts = append(parts, "Z")
+		} else if xd.hasUtcDesignatorPrefix == true {
+			parts = append(parts, "Z"+t.Format("-07:00"))
+		} else {
+			parts = append(parts, t.Format("-07:00"))
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// String returns the formatted date.
+func (xd XmpDate) String() string {
+	return xd.Format()
+}
+
+// MarshalJSON encodes the date as its formatted string.
+func (xd XmpDate) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(xd.Format())), nil
+}
+
+// In returns the time in the given location. A date without a zone is a
+// local time and is interpreted as a wall-clock time in that location. A date
+// with a zone is converted to it.
+func (xd XmpDate) In(location *time.Location) time.Time {
+	if xd.HasZone == true {
+		return xd.Time.In(location)
+	}
+
+	t := xd.Time
+
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location)
+}
+
+// Equal returns true if both dates have the same precision and zone presence
+// and describe the same instant (or the same wall-clock time if they do not
+// have a zone). Offsets and formatting are not compared.
+func (xd XmpDate) Equal(other XmpDate) bool {
+	return xd.Precision == other.Precision && xd.HasZone == other.HasZone && xd.Time.Equal(other.Time) == true
+}
+
+// Before returns true if this date starts before the other. Dates without a
+// zone are compared as if they were in UTC.
+func (xd XmpDate) Before(other XmpDate) bool {
+	return xd.Time.Before(other.Time)
+}
+
+// After returns true if this date starts after the other. Dates without a zone
+// are compared as if they were in UTC.
+func (xd XmpDate) After(other XmpDate) bool {
+	return xd.Time.After(other.Time)
+}
+
+// DateFieldValue knows how to parse dates/timestamps.
+type DateFieldValue struct {
+	raw string
+}
+
+// Parse parses the raw string value to an XmpDate (not a time.Time), so that
+// the date can be formatted exactly as it was given. The time is its Time
+// field, or In for a date that might not have a zone.
+func (dfv DateFieldValue) Parse() (parsed interface{}, err error) {
+	xd, err := ParseXmpDate(dfv.raw)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, ErrValueNotValid
+	return xd, nil
 }
 
 // DateFieldType represents a date values.
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"encoding/json"
+
 	"github.com/dsoprea/go-logging"
 )
 
@@ -20,8 +22,10 @@ func TestDataFieldType_GetValueParser(t *testing.T) {
 	parsed, err := dfv.Parse()
 	log.PanicIf(err)
 
-	if parsed != time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC) {
-		t.Fatalf("Parse is not correct: [%s]", parsed.(time.Time).Format(time.RFC3339Nano))
+	xd := parsed.(XmpDate)
+
+	if xd.Time != time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Parse is not correct: [%s]", xd.Time.Format(time.RFC3339Nano))
 	}
 }
 
@@ -34,8 +38,14 @@ func TestDataFieldType_Parse_Format1(t *testing.T) {
 	parsed, err := dfv.Parse()
 	log.PanicIf(err)
 
-	if parsed != time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC) {
-		t.Fatalf("Parse is not correct: [%s]", parsed.(time.Time).Format(time.RFC3339Nano))
+	xd := parsed.(XmpDate)
+
+	if xd.Time != time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Parse is not correct: [%s]", xd.Time.Format(time.RFC3339Nano))
+	} else if xd.Precision != DatePrecisionYear {
+		t.Fatalf("Precision not correct: [%s]", xd.Precision)
+	} else if xd.HasZone != false {
+		t.Fatalf("Expected no zone.")
 	}
 }
 
@@ -48,8 +58,12 @@ func TestDataFieldType_Parse_Format2(t *testing.T) {
 	parsed, err := dfv.Parse()
 	log.PanicIf(err)
 
-	if parsed != time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC) {
-		t.Fatalf("Parse is not correct: [%s]", parsed.(time.Time).Format(time.RFC3339Nano))
+	xd := parsed.(XmpDate)
+
+	if xd.Time != time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Parse is not correct: [%s]", xd.Time.Format(time.RFC3339Nano))
+	} else if xd.Precision != DatePrecisionMonth {
+		t.Fatalf("Precision not correct: [%s]", xd.Precision)
 	}
 }
 
@@ -62,8 +76,12 @@ func TestDataFieldType_Parse_Format3(t *testing.T) {
 	parsed, err := dfv.Parse()
 	log.PanicIf(err)
 
-	if parsed != time.Date(2019, 5, 7, 0, 0, 0, 0, time.UTC) {
-		t.Fatalf("Parse is not correct: [%s]", parsed.(time.Time).Format(time.RFC3339Nano))
+	xd := parsed.(XmpDate)
+
+	if xd.Time != time.Date(2019, 5, 7, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Parse is not correct: [%s]", xd.Time.Format(time.RFC3339Nano))
+	} else if xd.Precision != DatePrecisionDay {
+		t.Fatalf("Precision not correct: [%s]", xd.Precision)
 	}
 }
 
@@ -76,12 +94,14 @@ func TestDataFieldType_Parse_Format4(t *testing.T) {
 	parsed, err := dfv.Parse()
 	log.PanicIf(err)
 
-	actual := parsed.(time.Time)
+	actual := parsed.(XmpDate).Time
 
 	expected := time.Date(2019, 5, 7, 12, 34, 0, 0, testTimezone)
 
 	if actual.Equal(expected) != true {
 		t.Fatalf("Parse is not correct: [%s] (%d) != [%s] (%d)", actual.Format(time.RFC3339Nano), actual.Nanosecond(), expected.Format(time.RFC3339Nano), expected.Nanosecond())
+	} else if parsed.(XmpDate).Precision != DatePrecisionMinute {
+		t.Fatalf("Precision not correct: [%s]", parsed.(XmpDate).Precision)
 	}
 }
 
@@ -94,12 +114,14 @@ func TestDataFieldType_Parse_Format5(t *testing.T) {
 	parsed, err := dfv.Parse()
 	log.PanicIf(err)
 
-	actual := parsed.(time.Time)
+	actual := parsed.(XmpDate).Time
 
 	expected := time.Date(2019, 5, 7, 12, 34, 56, 0, testTimezone)
 
 	if actual.Equal(expected) != true {
 		t.Fatalf("Parse is not correct: [%s] (%d) != [%s] (%d)", actual.Format(time.RFC3339Nano), actual.Nanosecond(), expected.Format(time.RFC3339Nano), expected.Nanosecond())
+	} else if parsed.(XmpDate).Precision != DatePrecisionSecond {
+		t.Fatalf("Precision not correct: [%s]", parsed.(XmpDate).Precision)
 	}
 }
 
@@ -112,11 +134,258 @@ func TestDataFieldType_Parse_Format6(t *testing.T) {
 	parsed, err := dfv.Parse()
 	log.PanicIf(err)
 
-	actual := parsed.(time.Time)
+	actual := parsed.(XmpDate).Time
 
 	expected := time.Date(2019, 5, 7, 12, 34, 56, 123, testTimezone)
 
 	if actual.Equal(expected) != true {
 		t.Fatalf("Parse is not correct: [%s] (%d) != [%s] (%d)", actual.Format(time.RFC3339Nano), actual.Nanosecond(), expected.Format(time.RFC3339Nano), expected.Nanosecond())
+	} else if parsed.(XmpDate).Precision != DatePrecisionNanosecond {
+		t.Fatalf("Precision not correct: [%s]", parsed.(XmpDate).Precision)
+	}
+}
+
+func TestDataFieldType_Parse_NoZone(t *testing.T) {
+	dft := DateFieldType{}
+	scp := dft.GetValueParser("2013-09-23T10:09")
+
+	parsed, err := scp.Parse()
+	log.PanicIf(err)
+
+	xd := parsed.(XmpDate)
+
+	if xd.HasZone != false {
+		t.Fatalf("Expected no zone.")
+	} else if xd.Time != time.Date(2013, 9, 23, 10, 9, 0, 0, time.UTC) {
+		t.Fatalf("Parse is not correct: [%s]", xd.Time.Format(time.RFC3339Nano))
+	}
+}
+
+func TestDataFieldType_Parse_UtcDesignator(t *testing.T) {
+	dft := DateFieldType{}
+	scp := dft.GetValueParser("2013-09-23T10:09:46Z")
+
+	parsed, err := scp.Parse()
+	log.PanicIf(err)
+
+	xd := parsed.(XmpDate)
+
+	if xd.HasZone != true {
+		t.Fatalf("Expected zone.")
+	} else if xd.Time.Equal(time.Date(2013, 9, 23, 10, 9, 46, 0, time.UTC)) != true {
+		t.Fatalf("Parse is not correct: [%s]", xd.Time.Format(time.RFC3339Nano))
+	}
+}
+
+func TestDataFieldType_Parse_NotValid(t *testing.T) {
+	rawValues := []string{
+		"",
+		"not a date",
+		"19",
+		"2019-13",
+		"2019-02-30",
+		"2019-05-07T25:00",
+		"2019-05-07T12",
+		"2019-05-07Z",
+		"2019-05-07T12:34:56.",
+	}
+
+	for _, raw := range rawValues {
+		_, err := DateFieldType{}.GetValueParser(raw).Parse()
+		if err != ErrValueNotValid {
+			t.Fatalf("Expected not-valid error for [%s]: %v", raw, err)
+		}
+	}
+}
+
+func TestXmpDate_Format(t *testing.T) {
+	rawValues := []string{
+		"1962",
+		"1962-07",
+		"1962-07-14",
+		"1962-07-14T08:15",
+		"1962-07-14T08:15+01:00",
+		"1962-07-14T08:15:30",
+		"1962-07-14T08:15:30Z",
+		"1962-07-14T08:15:30-05:00",
+		"1962-07-14T08:15:30.50+01:00",
+		"1962-07-14T08:15:30.000000123Z",
+	}
+
+	for _, raw := range rawValues {
+		xd, err := ParseXmpDate(raw)
+		log.PanicIf(err)
+
+		if xd.Format() != raw {
+			t.Fatalf("Format not exact: [%s] != [%s]", xd.Format(), raw)
+		} else if xd.String() != raw {
+			t.Fatalf("String not exact: [%s] != [%s]", xd.String(), raw)
+		}
+	}
+
+	// The nonstandard "Z" before an offset is kept.
+
+	xd, err := ParseXmpDate("2019-05-07T12:34Z-05:00")
+	log.PanicIf(err)
+
+	if xd.Format() != "2019-05-07T12:34Z-05:00" {
+		t.Fatalf("Format not correct: [%s]", xd.Format())
+	}
+}
+
+func TestXmpDate_Format_EveryForm(t *testing.T) {
+	rawValues := []string{
+		"2019",
+		"2019-05",
+		"2019-05-07",
+	}
+
+	times := []string{
+		"T12:34",
+		"T12:34:56",
+	}
+
+	for digits := 1; digits <= 9; digits++ {
+		times = append(times, "T12:34:56."+"123456789"[:digits])
+	}
+
+	// Trailing zeros are significant.
+	times = append(times, "T12:34:56.0", "T12:34:56.500")
+
+	zones := []string{"", "Z", "+01:00", "-05:30", "Z+01:00", "Z-05:30"}
+
+	for _, clock := range times {
+		for _, zone := range zones {
+			rawValues = append(rawValues, "2019-05-07"+clock+zone)
+		}
+	}
+
+	for _, raw := range rawValues {
+		xd, err := ParseXmpDate(raw)
+		log.PanicIf(err)
+
+		if xd.Format() != raw {
+			t.Fatalf("Format not exact: [%s] != [%s]", xd.Format(), raw)
+		}
+
+		reparsed, err := ParseXmpDate(xd.Format())
+		log.PanicIf(err)
+
+		if reparsed.Equal(xd) != true {
+			t.Fatalf("Reparsed date not correct: [%s]", raw)
+		}
+	}
+}
+
+func TestNewXmpDate(t *testing.T) {
+	full := time.Date(2019, 5, 7, 12, 34, 56, 500000000, testTimezone)
+
+	testCases := []struct {
+		precision DatePrecision
+		hasZone   bool
+		expected  string
+	}{
+		{DatePrecisionYear, true, "2019"},
+		{DatePrecisionMonth, false, "2019-05"},
+		{DatePrecisionDay, true, "2019-05-07"},
+		{DatePrecisionMinute, true, "2019-05-07T12:34-05:00"},
+		{DatePrecisionSecond, false, "2019-05-07T12:34:56"},
+		{DatePrecisionNanosecond, true, "2019-05-07T12:34:56.5-05:00"},
+	}
+
+	for _, tc := range testCases {
+		xd := NewXmpDate(full, tc.precision, tc.hasZone)
+
+		if xd.Format() != tc.expected {
+			t.Fatalf("Format not correct for (%s): [%s] != [%s]", tc.precision, xd.Format(), tc.expected)
+		}
+	}
+}
+
+func TestXmpDate_Equal(t *testing.T) {
+	a, err := ParseXmpDate("2019-05-07T12:34:56+02:00")
+	log.PanicIf(err)
+
+	b, err := ParseXmpDate("2019-05-07T10:34:56Z")
+	log.PanicIf(err)
+
+	c, err := ParseXmpDate("2019-05-07T10:34:56")
+	log.PanicIf(err)
+
+	d, err := ParseXmpDate("2019")
+	log.PanicIf(err)
+
+	e, err := ParseXmpDate("2019-01-01")
+	log.PanicIf(err)
+
+	if a.Equal(b) != true {
+		t.Fatalf("Expected same instant to be equal.")
+	} else if b.Equal(c) != false {
+		t.Fatalf("Expected date without zone to not be equal.")
+	} else if d.Equal(e) != false {
+		t.Fatalf("Expected dates of different precision to not be equal.")
+	} else if d.Equal(d) != true {
+		t.Fatalf("Expected date to equal itself.")
+	}
+}
+
+func TestXmpDate_BeforeAfter(t *testing.T) {
+	a, err := ParseXmpDate("1962")
+	log.PanicIf(err)
+
+	b, err := ParseXmpDate("1962-07-14")
+	log.PanicIf(err)
+
+	if a.Before(b) != true || b.Before(a) != false {
+		t.Fatalf("Before not correct.")
+	} else if b.After(a) != true || a.After(b) != false {
+		t.Fatalf("After not correct.")
+	}
+}
+
+func TestXmpDate_In(t *testing.T) {
+	local, err := ParseXmpDate("2019-05-07T12:34")
+	log.PanicIf(err)
+
+	zoned, err := ParseXmpDate("2019-05-07T12:34Z")
+	log.PanicIf(err)
+
+	if local.In(testTimezone).Equal(time.Date(2019, 5, 7, 12, 34, 0, 0, testTimezone)) != true {
+		t.Fatalf("Local time not correct: [%s]", local.In(testTimezone))
+	} else if zoned.In(testTimezone).Equal(time.Date(2019, 5, 7, 7, 34, 0, 0, testTimezone)) != true || zoned.In(testTimezone).Hour() != 7 {
+		t.Fatalf("Zoned time not correct: [%s]", zoned.In(testTimezone))
+	}
+}
+
+func TestXmpDate_IsZero(t *testing.T) {
+	if (XmpDate{}).IsZero() != true {
+		t.Fatalf("Expected zero date.")
+	}
+
+	xd, err := ParseXmpDate("1962")
+	log.PanicIf(err)
+
+	if xd.IsZero() != false {
+		t.Fatalf("Expected non-zero date.")
+	}
+}
+
+func TestXmpDate_MarshalJSON(t *testing.T) {
+	xd, err := ParseXmpDate("1962")
+	log.PanicIf(err)
+
+	encoded, err := json.Marshal(xd)
+	log.PanicIf(err)
+
+	if string(encoded) != `"1962"` {
+		t.Fatalf("Encoding not correct: [%s]", string(encoded))
+	}
+}
+
+func TestDatePrecision_String(t *testing.T) {
+	if DatePrecisionYear.String() != "year" {
+		t.Fatalf("String not correct: [%s]", DatePrecisionYear.String())
+	} else if DatePrecision(99).String() != "DatePrecision<99>" {
+		t.Fatalf("String not correct: [%s]", DatePrecision(99).String())
 	}
 }
@@ -0,0 +1,55 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// DeviceSettings describes the picture-taking conditions of a particular
+// camera model (the "exif:DeviceSettings" struct).
+type DeviceSettings struct {
+	// Columns is the number of columns in the table.
+	Columns int64
+
+	// Rows is the number of rows in the table.
+	Rows int64
+
+	// Settings are the camera settings, in row-major order.
+	Settings []string
+}
+
+// String returns a string representation of the settings.
+func (ds DeviceSettings) String() string {
+	return fmt.Sprintf("DeviceSettings<COLUMNS=(%d) ROWS=(%d) SETTINGS=%v>", ds.Columns, ds.Rows, ds.Settings)
+}
+
+// DeviceSettingsFieldType is the field-type of device-setting descriptions
+// ("exif:DeviceSettingDescription").
+type DeviceSettingsFieldType struct {
+}
+
+// ParseStruct parses the fields to a DeviceSettings.
+func (dsft DeviceSettingsFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(ExifUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	ds := DeviceSettings{
+		Columns:  sf.integer("Columns"),
+		Rows:     sf.integer("Rows"),
+		Settings: sf.textItems("Settings"),
+	}
+
+	return ds, nil
+}
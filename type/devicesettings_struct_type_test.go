@@ -0,0 +1,46 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestDeviceSettingsFieldType_ParseStruct(t *testing.T) {
+	settings := OrderedTextArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "Macro", "Vivid"))
+
+	fields := map[xml.Name]interface{}{
+		{Space: ExifUri, Local: "Columns"}:  int64(1),
+		{Space: ExifUri, Local: "Rows"}:     int64(2),
+		{Space: ExifUri, Local: "Settings"}: settings,
+	}
+
+	parsed, err := DeviceSettingsFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := DeviceSettings{
+		Columns:  1,
+		Rows:     2,
+		Settings: []string{"Macro", "Vivid"},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Device settings not correct: %s", parsed)
+	}
+
+	if parsed.(DeviceSettings).String() != "DeviceSettings<COLUMNS=(1) ROWS=(2) SETTINGS=[Macro Vivid]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(DeviceSettings).String())
+	}
+}
+
+func TestDeviceSettingsFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := DeviceSettingsFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
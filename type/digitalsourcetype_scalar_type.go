@@ -0,0 +1,74 @@
+package xmptype
+
+import (
+	"strings"
+)
+
+const (
+	// DigitalSourceTypeVocabularyUri is the URI of the IPTC "Digital Source
+	// Type" controlled vocabulary. The codes are this URI followed by the
+	// name of the term.
+	DigitalSourceTypeVocabularyUri = "http://cv.iptc.org/newscodes/digitalsourcetype/"
+)
+
+var (
+	digitalSourceTypeChoices = []ChoiceDefinition{
+		{Code: DigitalSourceTypeVocabularyUri + "digitalCapture", Label: "Original digital capture sampled from real life"},
+		{Code: DigitalSourceTypeVocabularyUri + "computationalCapture", Label: "Computational capture"},
+		{Code: DigitalSourceTypeVocabularyUri + "negativeFilm", Label: "Digitised from a negative on film"},
+		{Code: DigitalSourceTypeVocabularyUri + "positiveFilm", Label: "Digitised from a positive on film"},
+		{Code: DigitalSourceTypeVocabularyUri + "print", Label: "Digitised from a print on non-transparent medium"},
+		{Code: DigitalSourceTypeVocabularyUri + "humanEdits", Label: "Human-edited media"},
+		{Code: DigitalSourceTypeVocabularyUri + "minorHumanEdits", Label: "Minor human edits"},
+		{Code: DigitalSourceTypeVocabularyUri + "algorithmicallyEnhanced", Label: "Algorithmically-enhanced media"},
+		{Code: DigitalSourceTypeVocabularyUri + "screenCapture", Label: "Screen capture"},
+		{Code: DigitalSourceTypeVocabularyUri + "virtualRecording", Label: "Virtual recording"},
+		{Code: DigitalSourceTypeVocabularyUri + "composite", Label: "Composite"},
+		{Code: DigitalSourceTypeVocabularyUri + "compositeCapture", Label: "Composite of captured elements"},
+		{Code: DigitalSourceTypeVocabularyUri + "compositeSynthetic", Label: "Composite including synthetic elements"},
+		{Code: DigitalSourceTypeVocabularyUri + "compositeWithTrainedAlgorithmicMedia", Label: "Composite with trained algorithmic media"},
+		{Code: DigitalSourceTypeVocabularyUri + "dataDrivenMedia", Label: "Data-driven media"},
+		{Code: DigitalSourceTypeVocabularyUri + "digitalArt", Label: "Digital art"},
+		{Code: DigitalSourceTypeVocabularyUri + "digitalCreation", Label: "Digital creation"},
+		{Code: DigitalSourceTypeVocabularyUri + "algorithmicMedia", Label: "Pure algorithmic media"},
+		{Code: DigitalSourceTypeVocabularyUri + "trainedAlgorithmicMedia", Label: "Trained algorithmic media"},
+	}
+
+	// generativeDigitalSourceTypes are the terms that indicate that the
+	// content was created, in whole or in part, by a generative model.
+	generativeDigitalSourceTypes = map[string]struct{}{
+		DigitalSourceTypeVocabularyUri + "trainedAlgorithmicMedia":              {},
+		DigitalSourceTypeVocabularyUri + "compositeWithTrainedAlgorithmicMedia": {},
+	}
+)
+
+// IsGenerativeDigitalSourceType returns true if the given digital-source-type
+// code indicates content that was created, in whole or in part, by a trained
+// (generative) model. Both the "http" and "https" forms of the vocabulary URI
+// are accepted.
+func IsGenerativeDigitalSourceType(code string) bool {
+	code = strings.Replace(code, "https://", "http://", 1)
+	_, found := generativeDigitalSourceTypes[code]
+
+	return found
+}
+
+// DigitalSourceTypeFieldValue knows how to parse an IPTC digital-source-type
+// value.
+type DigitalSourceTypeFieldValue struct {
+	ChoiceFieldValue
+}
+
+// DigitalSourceTypeFieldType describes the source of the digital content
+// ("Iptc4xmpExt:DigitalSourceType"). The value should be a term of the IPTC
+// vocabulary but other values are allowed.
+type DigitalSourceTypeFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (dstft DigitalSourceTypeFieldType) GetValueParser(raw string) ScalarValueParser {
+	return DigitalSourceTypeFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, digitalSourceTypeChoices, false),
+	}
+}
@@ -0,0 +1,47 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestDigitalSourceTypeFieldType_GetValueParser(t *testing.T) {
+	ft := DigitalSourceTypeFieldType{}
+	scp := ft.GetValueParser("http://cv.iptc.org/newscodes/digitalsourcetype/trainedAlgorithmicMedia")
+
+	fv := scp.(DigitalSourceTypeFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "http://cv.iptc.org/newscodes/digitalsourcetype/trainedAlgorithmicMedia",
+		Label: "Trained algorithmic media",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestDigitalSourceTypeFieldType_GetValueParser_Other(t *testing.T) {
+	ft := DigitalSourceTypeFieldType{}
+
+	parsed, err := ft.GetValueParser("http://other/vocabulary/term").Parse()
+	log.PanicIf(err)
+
+	if parsed != (Choice{Code: "http://other/vocabulary/term"}) {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestIsGenerativeDigitalSourceType(t *testing.T) {
+	if IsGenerativeDigitalSourceType("http://cv.iptc.org/newscodes/digitalsourcetype/trainedAlgorithmicMedia") != true {
+		t.Fatalf("Expected trained algorithmic media to be generative.")
+	} else if IsGenerativeDigitalSourceType("https://cv.iptc.org/newscodes/digitalsourcetype/compositeWithTrainedAlgorithmicMedia") != true {
+		t.Fatalf("Expected composite with trained algorithmic media to be generative.")
+	} else if IsGenerativeDigitalSourceType("http://cv.iptc.org/newscodes/digitalsourcetype/digitalCapture") != false {
+		t.Fatalf("Expected digital capture to not be generative.")
+	}
+}
@@ -0,0 +1,29 @@
+package xmptype
+
+var (
+	dimensionUnitChoices = []ChoiceDefinition{
+		{Code: "inch", Label: "Inches"},
+		{Code: "mm", Label: "Millimeters"},
+		{Code: "pixel", Label: "Pixels"},
+		{Code: "pica", Label: "Picas"},
+		{Code: "point", Label: "Points"},
+	}
+)
+
+// DimensionUnitFieldValue knows how to parse a dimensions-unit value.
+type DimensionUnitFieldValue struct {
+	ChoiceFieldValue
+}
+
+// DimensionUnitFieldType describes a dimensions-unit value. Other units are
+// allowed.
+type DimensionUnitFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (duft DimensionUnitFieldType) GetValueParser(raw string) ScalarValueParser {
+	return DimensionUnitFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, dimensionUnitChoices, false),
+	}
+}
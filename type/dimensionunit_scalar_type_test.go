@@ -0,0 +1,38 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestDimensionUnitFieldType_GetValueParser(t *testing.T) {
+	ft := DimensionUnitFieldType{}
+	scp := ft.GetValueParser("mm")
+
+	fv := scp.(DimensionUnitFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "mm",
+		Label: "Millimeters",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestDimensionUnitFieldType_GetValueParser_Other(t *testing.T) {
+	ft := DimensionUnitFieldType{}
+	scp := ft.GetValueParser("cm")
+
+	parsed, err := scp.Parse()
+	log.PanicIf(err)
+
+	if parsed != (Choice{Code: "cm"}) {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
@@ -0,0 +1,61 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// Entity is a person, organisation, or other entity, optionally with the
+// roles that it had (the "Iptc4xmpExt:Entity" and "Iptc4xmpExt:EntityWRole"
+// structs).
+type Entity struct {
+	// Identifiers are URIs that identify the entity.
+	Identifiers []string
+
+	// Name is the name of the entity, keyed by language.
+	Name map[string]string
+
+	// Roles are URIs that identify the roles of the entity.
+	Roles []string
+}
+
+// String returns a string representation of the entity.
+func (e Entity) String() string {
+	return fmt.Sprintf("Entity<IDENTIFIERS=%v NAME=%v ROLES=%v>", e.Identifiers, e.Name, e.Roles)
+}
+
+// EntityFieldType is the field-type of entities (e.g. "Iptc4xmpExt:Creator"
+// and "Iptc4xmpExt:rCtype").
+type EntityFieldType struct {
+}
+
+// ParseItem parses the item to an Entity.
+func (eft EntityFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return eft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to an Entity.
+func (eft EntityFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	e := Entity{
+		Identifiers: sf.textItems("Identifier"),
+		Name:        sf.languageItems("Name"),
+		Roles:       sf.textItems("Role"),
+	}
+
+	return e, nil
+}
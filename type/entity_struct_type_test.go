@@ -0,0 +1,53 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestEntityFieldType_ParseItem(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+	registerTestXmlNamespace()
+
+	identifiers := UnorderedArrayFieldType{ItemType: UriFieldType{}}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Bag", "http://example.com/person/1"))
+	roles := UnorderedArrayFieldType{ItemType: UriFieldType{}}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Bag", "http://example.com/role/photographer"))
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "Identifier"}: identifiers,
+			{Space: Iptc4xmpExtUri, Local: "Name"}:       getTestLanguageAlternatives("x-default", "Some Person"),
+			{Space: Iptc4xmpExtUri, Local: "Role"}:       roles,
+		},
+	}
+
+	parsed, err := EntityFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := Entity{
+		Identifiers: []string{"http://example.com/person/1"},
+		Name:        map[string]string{"x-default": "Some Person"},
+		Roles:       []string{"http://example.com/role/photographer"},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Entity not correct: %s", parsed)
+	}
+
+	if parsed.(Entity).String() != "Entity<IDENTIFIERS=[http://example.com/person/1] NAME=map[x-default:Some Person] ROLES=[http://example.com/role/photographer]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(Entity).String())
+	}
+}
+
+func TestEntityFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := EntityFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
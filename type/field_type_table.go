@@ -0,0 +1,98 @@
+package xmptype
+
+import (
+	"reflect"
+)
+
+// FieldTypeEntry describes a single field-type.
+type FieldTypeEntry struct {
+	// Name is the name of the type in the XMP specification. It is empty for
+	// field-types that can not be named directly (e.g. because they only
+	// appear in a specific namespace).
+	Name string
+
+	// FieldType is the field-type.
+	FieldType interface{}
+
+	// ValueType is the Go type of the values parsed by the field-type.
+	ValueType reflect.Type
+}
+
+var (
+	// FieldTypes are the field-types that values can be parsed with. A
+	// field-type may appear more than once if the specification has more than
+	// one name for it.
+	FieldTypes = []FieldTypeEntry{
+		{"AgentName", AgentNameFieldType{}, reflect.TypeOf("")},
+		{"ArtworkOrObjectDetails", ArtworkOrObjectFieldType{}, reflect.TypeOf(ArtworkOrObject{})},
+		{"Boolean", BooleanFieldType{}, reflect.TypeOf(false)},
+		{"CFAPattern", CfaPatternFieldType{}, reflect.TypeOf(CfaPattern{})},
+		{"CVTerm", CvTermFieldType{}, reflect.TypeOf(CvTerm{})},
+		{"ContactInfo", ContactInfoFieldType{}, reflect.TypeOf(ContactInfo{})},
+		{"Date", DateFieldType{}, reflect.TypeOf(XmpDate{})},
+		{"DeviceSettings", DeviceSettingsFieldType{}, reflect.TypeOf(DeviceSettings{})},
+		{"DigitalSourceType", DigitalSourceTypeFieldType{}, reflect.TypeOf(Choice{})},
+		{"Entity", EntityFieldType{}, reflect.TypeOf(Entity{})},
+		{"EntityWRole", EntityFieldType{}, reflect.TypeOf(Entity{})},
+		{"Flash", FlashFieldType{}, reflect.TypeOf(Flash{})},
+		{"FrameCount", FrameCountFieldType{}, reflect.TypeOf(FrameCount{})},
+		{"FrameRate", FrameRateFieldType{}, reflect.TypeOf(FrameRate{})},
+		{"GPSCoordinate", GpsCoordinateFieldType{}, reflect.TypeOf(GpsCoordinate{})},
+		{"GUID", GuidFieldType{}, reflect.TypeOf("")},
+		{"ImageRegion", ImageRegionFieldType{}, reflect.TypeOf(ImageRegion{})},
+		{"Integer", IntegerFieldType{}, reflect.TypeOf(int64(0))},
+		{"Locale", LocaleFieldType{}, reflect.TypeOf("")},
+		{"LocationDetails", LocationDetailsFieldType{}, reflect.TypeOf(LocationDetails{})},
+		{"MIMEType", MimeTypeFieldType{}, reflect.TypeOf("")},
+		{"OECF/SFR", OecfFieldType{}, reflect.TypeOf(Oecf{})},
+		{"Part", PartFieldType{}, reflect.TypeOf("")},
+		{"PersonDetails", PersonDetailsFieldType{}, reflect.TypeOf(PersonDetails{})},
+		{"ProperName", ProperNameFieldType{}, reflect.TypeOf("")},
+		{"Rational", RationalFieldType{}, reflect.TypeOf(Rational{})},
+		{"Real", RealFieldType{}, reflect.TypeOf(float64(0))},
+		{"RegionBoundary", RegionBoundaryFieldType{}, reflect.TypeOf(RegionBoundary{})},
+		{"RegistryEntryDetails", RegistryEntryFieldType{}, reflect.TypeOf(RegistryEntry{})},
+		{"RenditionClass", RenditionClassFieldType{}, reflect.TypeOf("")},
+		{"ResourceEvent", ResourceEventFieldType{}, reflect.TypeOf(ResourceEvent{})},
+		{"ResourceRef", ResourceRefFieldType{}, reflect.TypeOf(ResourceRef{})},
+		{"Text", TextFieldType{}, reflect.TypeOf("")},
+		{"URI", UriFieldType{}, reflect.TypeOf("")},
+		{"URL", UrlFieldType{}, reflect.TypeOf("")},
+		{"Version", VersionFieldType{}, reflect.TypeOf(Version{})},
+
+		{"", CorrectionMaskFieldType{}, reflect.TypeOf(CorrectionMask{})},
+		{"", DefinedChoiceFieldType{}, reflect.TypeOf(Choice{})},
+		{"", GenericStructFieldType{}, reflect.TypeOf(StructValue{})},
+		{"", LocalCorrectionFieldType{}, reflect.TypeOf(LocalCorrection{})},
+		{"", RetouchAreaFieldType{}, reflect.TypeOf(RetouchArea{})},
+		{"", ToneCurvePointFieldType{}, reflect.TypeOf(ToneCurvePoint{})},
+	}
+)
+
+// LookupFieldTypeByName returns the field-type having the given name in the
+// XMP specification.
+func LookupFieldTypeByName(name string) (ft interface{}, found bool) {
+	if name == "" {
+		return nil, false
+	}
+
+	for _, entry := range FieldTypes {
+		if entry.Name == name {
+			return entry.FieldType, true
+		}
+	}
+
+	return nil, false
+}
+
+// LookupValueType returns the Go type of the values parsed by the given
+// field-type.
+func LookupValueType(ft interface{}) (valueType reflect.Type, found bool) {
+	for _, entry := range FieldTypes {
+		if reflect.TypeOf(entry.FieldType) == reflect.TypeOf(ft) {
+			return entry.ValueType, true
+		}
+	}
+
+	return nil, false
+}
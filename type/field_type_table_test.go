@@ -0,0 +1,35 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookupFieldTypeByName(t *testing.T) {
+	ft, found := LookupFieldTypeByName("EntityWRole")
+	if found != true || ft != (EntityFieldType{}) {
+		t.Fatalf("Field-type not correct: [%v] [%v]", ft, found)
+	}
+
+	if _, found := LookupFieldTypeByName(""); found != false {
+		t.Fatalf("Expected unnamed field-types to not be found.")
+	} else if _, found := LookupFieldTypeByName("Unknown"); found != false {
+		t.Fatalf("Expected unknown name to not be found.")
+	}
+}
+
+func TestLookupValueType(t *testing.T) {
+	valueType, found := LookupValueType(DateFieldType{})
+	if found != true || valueType != reflect.TypeOf(XmpDate{}) {
+		t.Fatalf("Value-type not correct: [%v] [%v]", valueType, found)
+	}
+
+	valueType, found = LookupValueType(GenericStructFieldType{})
+	if found != true || valueType != reflect.TypeOf(StructValue{}) {
+		t.Fatalf("Value-type of unnamed field-type not correct: [%v] [%v]", valueType, found)
+	}
+
+	if _, found := LookupValueType(MarkerFieldType{}); found != false {
+		t.Fatalf("Expected field-type without an entry to not be found.")
+	}
+}
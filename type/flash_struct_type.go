@@ -0,0 +1,71 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// ExifUri is the URI for the "exif" namespace. We can't use the same
+	// value from xmpnamespace because xmptype can't import from it.
+	ExifUri = "http://ns.adobe.com/exif/1.0/"
+)
+
+// Flash describes the state of the flash when an image was taken (the
+// "exif:Flash" struct).
+type Flash struct {
+	// Fired indicates that the flash fired.
+	Fired bool
+
+	// Return is the strobe-return status: 0 (no strobe-return detection), 2
+	// (strobe-return light not detected), or 3 (strobe-return light
+	// detected).
+	Return int64
+
+	// Mode is the flash mode: 0 (unknown), 1 (compulsory flash firing), 2
+	// (compulsory flash suppression), or 3 (auto mode).
+	Mode int64
+
+	// Function indicates that there is no flash function.
+	Function bool
+
+	// RedEyeMode indicates that red-eye reduction is supported.
+	RedEyeMode bool
+}
+
+// String returns a string representation of the flash.
+func (f Flash) String() string {
+	return fmt.Sprintf("Flash<FIRED=[%v] RETURN=(%d) MODE=(%d) FUNCTION=[%v] RED-EYE-MODE=[%v]>", f.Fired, f.Return, f.Mode, f.Function, f.RedEyeMode)
+}
+
+// FlashFieldType is the field-type of flash descriptions ("exif:Flash").
+type FlashFieldType struct {
+}
+
+// ParseStruct parses the fields to a Flash.
+func (fft FlashFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(ExifUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	f := Flash{
+		Fired:      sf.boolean("Fired"),
+		Return:     sf.integer("Return"),
+		Mode:       sf.integer("Mode"),
+		Function:   sf.boolean("Function"),
+		RedEyeMode: sf.boolean("RedEyeMode"),
+	}
+
+	return f, nil
+}
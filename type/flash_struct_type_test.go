@@ -0,0 +1,46 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestFlashFieldType_ParseStruct(t *testing.T) {
+	fields := map[xml.Name]interface{}{
+		{Space: ExifUri, Local: "Fired"}:      true,
+		{Space: ExifUri, Local: "Return"}:     int64(3),
+		{Space: ExifUri, Local: "Mode"}:       int64(1),
+		{Space: ExifUri, Local: "Function"}:   false,
+		{Space: ExifUri, Local: "RedEyeMode"}: "True",
+	}
+
+	parsed, err := FlashFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := Flash{
+		Fired:      true,
+		Return:     3,
+		Mode:       1,
+		Function:   false,
+		RedEyeMode: true,
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Flash not correct: %s", parsed)
+	}
+
+	if parsed.(Flash).String() != "Flash<FIRED=[true] RETURN=(3) MODE=(1) FUNCTION=[false] RED-EYE-MODE=[true]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(Flash).String())
+	}
+}
+
+func TestFlashFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := FlashFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
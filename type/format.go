@@ -0,0 +1,70 @@
+package xmptype
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// formatTimeLayout is used to format dates without fractional seconds.
+	// The offset is always numeric (never "Z") since that is what the date
+	// parser accepts.
+	formatTimeLayout = "2006-01-02T15:04:05-07:00"
+
+	// formatTimeLayoutFractional is used to format dates having fractional
+	// seconds.
+	formatTimeLayoutFractional = "2006-01-02T15:04:05.999999999-07:00"
+)
+
+// FormatValue returns the string encoding of a value returned by any of the
+// scalar parsers. It is the inverse of parsing and the result may be given to
+// the same parser to recover the value.
+func FormatValue(parsedValue interface{}) (raw string, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	switch value := parsedValue.(type) {
+	case string:
+		return value, nil
+	case bool:
+		if value == true {
+			return "True", nil
+		}
+
+		return "False", nil
+	case int64:
+		return strconv.FormatInt(value, 10), nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case Rational:
+		return fmt.Sprintf("%d/%d", value.Numerator, value.Denominator), nil
+	case Choice:
+		return value.Code, nil
+	case FrameRate:
+		return value.String(), nil
+	case FrameCount:
+		return value.String(), nil
+	case GpsCoordinate:
+		return value.String(), nil
+	case ToneCurvePoint:
+		return value.String(), nil
+	case XmpDate:
+		return value.Format(), nil
+	case time.Time:
+		if value.Nanosecond() != 0 {
+			return value.Format(formatTimeLayoutFractional), nil
+		}
+
+		return value.Format(formatTimeLayout), nil
+	}
+
+	log.Panicf("can not format unhandled value: [%v]", reflect.TypeOf(parsedValue))
+	panic(nil)
+}
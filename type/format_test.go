@@ -0,0 +1,86 @@
+package xmptype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestFormatValue(t *testing.T) {
+	testCases := []struct {
+		parsed   interface{}
+		expected string
+	}{
+		{"some text", "some text"},
+		{true, "True"},
+		{false, "False"},
+		{int64(-123), "-123"},
+		{float64(1.5), "1.5"},
+		{Rational{Numerator: 1, Denominator: 3}, "1/3"},
+		{Choice{Code: "3", Label: "RGB color"}, "3"},
+		{FrameRate{Numerator: 30000, Denominator: 1001}, "f30000s1001"},
+		{FrameCount{Count: 120, Rate: FrameRate{Numerator: 25, Denominator: 1}}, "120f25"},
+		{GpsCoordinate{Degrees: 47, Minutes: 34.123, Direction: "N"}, "47,34.123N"},
+		{GpsCoordinate{Degrees: 40, Minutes: 26, Seconds: 46.5, HasSeconds: true, Direction: "W"}, "40,26,46.5W"},
+		{ToneCurvePoint{Input: 64, Output: 60}, "64, 60"},
+		{time.Date(2019, 2, 3, 4, 5, 6, 0, testTimezone), "2019-02-03T04:05:06-05:00"},
+		{time.Date(2019, 2, 3, 4, 5, 6, 0, time.UTC), "2019-02-03T04:05:06+00:00"},
+		{time.Date(2019, 2, 3, 4, 5, 6, 500000000, testTimezone), "2019-02-03T04:05:06.5-05:00"},
+		{NewXmpDate(time.Date(1962, 1, 1, 0, 0, 0, 0, time.UTC), DatePrecisionYear, false), "1962"},
+		{NewXmpDate(time.Date(2019, 2, 3, 4, 5, 6, 0, testTimezone), DatePrecisionSecond, true), "2019-02-03T04:05:06-05:00"},
+	}
+
+	for _, tc := range testCases {
+		raw, err := FormatValue(tc.parsed)
+		log.PanicIf(err)
+
+		if raw != tc.expected {
+			t.Fatalf("Formatted value not correct: [%s] != [%s]", raw, tc.expected)
+		}
+	}
+}
+
+func TestFormatValue_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		sft ScalarFieldType
+		raw string
+	}{
+		{BooleanFieldType{}, "True"},
+		{IntegerFieldType{}, "42"},
+		{RealFieldType{}, "0.25"},
+		{RationalFieldType{}, "10/3"},
+		{DateFieldType{}, "2013-09-23T10:09:46+02:00"},
+		{DateFieldType{}, "2013-09-23T10:09:46.25+02:00"},
+		{ColorModeFieldType{}, "3"},
+		{DateFieldType{}, "1962"},
+		{DateFieldType{}, "2013-09-23T10:09"},
+		{DateFieldType{}, "2013-09-23T10:09:46Z"},
+		{FrameRateFieldType{}, "f24"},
+		{FrameCountFieldType{}, "1234f24000s1001"},
+		{GpsCoordinateFieldType{}, "47,34.123N"},
+		{GpsCoordinateFieldType{}, "40,26,46W"},
+		{ToneCurvePointFieldType{}, "64, 60"},
+	}
+
+	for _, tc := range testCases {
+		parsed, err := tc.sft.GetValueParser(tc.raw).Parse()
+		log.PanicIf(err)
+
+		raw, err := FormatValue(parsed)
+		log.PanicIf(err)
+
+		if raw != tc.raw {
+			t.Fatalf("Round-trip not correct: [%s] != [%s]", raw, tc.raw)
+		}
+	}
+}
+
+func TestFormatValue_Unhandled(t *testing.T) {
+	_, err := FormatValue(struct{}{})
+	if err == nil {
+		t.Fatalf("Expected error for unhandled value.")
+	} else if err.Error() != "can not format unhandled value: [struct {}]" {
+		t.Fatalf("Error not correct: [%s]", err.Error())
+	}
+}
@@ -1,12 +1,120 @@
 package xmptype
 
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrFrameRateNotGiven indicates that a frame-count can not be converted
+	// to a duration because it does not have a frame-rate.
+	ErrFrameRateNotGiven = errors.New("frame-rate not given")
+)
+
+var (
+	frameCountRe = regexp.MustCompile(`^(\d+)(?:f(\d+)(?:s(\d+))?)?$`)
+)
+
+// FrameCount is a number of frames, optionally with the frame-rate at which
+// they are played.
+type FrameCount struct {
+	// Count is the number of frames.
+	Count int64
+
+	// Rate is the frame-rate. Zero if not given.
+	Rate FrameRate
+}
+
+// ParseFrameCount parses a frame-count in the "###", "###f###", or
+// "###f###s###" forms (e.g. "1234f30000s1001").
+func ParseFrameCount(raw string) (fc FrameCount, err error) {
+	matches := frameCountRe.FindStringSubmatch(raw)
+	if matches == nil {
+		return fc, ErrValueNotValid
+	}
+
+	fc.Count, err = strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return fc, ErrValueNotValid
+	}
+
+	if matches[2] != "" {
+		fc.Rate, err = newFrameRate(matches[2], matches[3])
+		if err != nil {
+			return fc, err
+		}
+	}
+
+	return fc, nil
+}
+
+// Duration returns the playing time of the frames. Returns
+// ErrFrameRateNotGiven if there is no frame-rate.
+func (fc FrameCount) Duration() (d time.Duration, err error) {
+	if fc.Rate.IsZero() == true {
+		return 0, ErrFrameRateNotGiven
+	}
+
+	return framesDuration(fc.Count, fc.Rate), nil
+}
+
+// String returns the frame-count in the form that it is stored.
+func (fc FrameCount) String() string {
+	if fc.Rate.IsZero() == true {
+		return fmt.Sprintf("%d", fc.Count)
+	}
+
+	return fmt.Sprintf("%d%s", fc.Count, fc.Rate)
+}
+
+// framesDuration returns the playing time of the given number of frames at
+// the given rate, rounded to the nearest nanosecond.
+func framesDuration(frames int64, rate FrameRate) time.Duration {
+	nanoseconds := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(frames), big.NewInt(rate.Denominator*int64(time.Second))),
+		big.NewInt(rate.Numerator))
+
+	return time.Duration(roundRat(nanoseconds))
+}
+
+// roundRat rounds the given rational to the nearest integer, with halves
+// rounded away from zero.
+func roundRat(r *big.Rat) int64 {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	twiceRemainder := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+
+	if twiceRemainder.Cmp(r.Denom()) >= 0 {
+		if r.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return quotient.Int64()
+}
+
 // FrameCountFieldValue knows how to parse frame-count expressions.
 type FrameCountFieldValue struct {
-	TextFieldValue
+	raw string
+}
+
+// Parse parses the raw string value to a FrameCount.
+func (fcfv FrameCountFieldValue) Parse() (parsed interface{}, err error) {
+	fc, err := ParseFrameCount(fcfv.raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return fc, nil
 }
 
-// FrameCountFieldType represents a frame-count specification. It is handled
-// as a string.
+// FrameCountFieldType represents a frame-count specification ("###" with an
+// optional frame-rate).
 type FrameCountFieldType struct {
 }
 
@@ -14,8 +122,6 @@ type FrameCountFieldType struct {
 // parse a specific string.
 func (fcft FrameCountFieldType) GetValueParser(raw string) ScalarValueParser {
 	return FrameCountFieldValue{
-		TextFieldValue: TextFieldValue{
-			raw: raw,
-		},
+		raw: raw,
 	}
 }
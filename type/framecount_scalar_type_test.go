@@ -2,20 +2,99 @@ package xmptype
 
 import (
 	"testing"
+	"time"
 
 	"github.com/dsoprea/go-logging"
 )
 
 func TestFrameCountFieldType_GetValueParser(t *testing.T) {
 	fft := FrameCountFieldType{}
-	scp := fft.GetValueParser("test_text")
+	scp := fft.GetValueParser("1234f30000s1001")
 
 	ffv := scp.(FrameCountFieldValue)
 
 	parsed, err := ffv.Parse()
 	log.PanicIf(err)
 
-	if parsed != "test_text" {
-		t.Fatalf("Parse is not correct: [%s]", parsed)
+	expected := FrameCount{
+		Count: 1234,
+		Rate:  FrameRate{Numerator: 30000, Denominator: 1001},
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestFrameCountFieldType_GetValueParser_NotValid(t *testing.T) {
+	fft := FrameCountFieldType{}
+
+	for _, raw := range []string{"test_text", "f24", "12f", "12f0", "-12"} {
+		_, err := fft.GetValueParser(raw).Parse()
+		if err != ErrValueNotValid {
+			t.Fatalf("Expected not-valid error for [%s]: %v", raw, err)
+		}
+	}
+}
+
+func TestParseFrameCount(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected FrameCount
+	}{
+		{"120", FrameCount{Count: 120}},
+		{"120f25", FrameCount{Count: 120, Rate: FrameRate{Numerator: 25, Denominator: 1}}},
+		{"120f24000s1001", FrameCount{Count: 120, Rate: FrameRate{Numerator: 24000, Denominator: 1001}}},
+	}
+
+	for _, testCase := range testCases {
+		fc, err := ParseFrameCount(testCase.raw)
+		log.PanicIf(err)
+
+		if fc != testCase.expected {
+			t.Fatalf("Frame-count for [%s] not correct: %v", testCase.raw, fc)
+		}
+
+		if fc.String() != testCase.raw {
+			t.Fatalf("String for [%s] not correct: [%s]", testCase.raw, fc.String())
+		}
+	}
+}
+
+func TestFrameCount_Duration(t *testing.T) {
+	fc := FrameCount{
+		Count: 30000,
+		Rate:  FrameRate{Numerator: 30000, Denominator: 1001},
+	}
+
+	d, err := fc.Duration()
+	log.PanicIf(err)
+
+	if d != 1001*time.Second {
+		t.Fatalf("Duration not correct: [%s]", d)
+	}
+
+	fc = FrameCount{
+		Count: 1,
+		Rate:  FrameRate{Numerator: 30000, Denominator: 1001},
+	}
+
+	d, err = fc.Duration()
+	log.PanicIf(err)
+
+	// 1001/30000 seconds is 33366666.67 nanoseconds.
+	if d != 33366667*time.Nanosecond {
+		t.Fatalf("Duration not rounded correctly: [%d]", d)
+	}
+}
+
+func TestFrameCount_Duration_NoRate(t *testing.T) {
+	fc := FrameCount{
+		Count: 120,
+	}
+
+	_, err := fc.Duration()
+	if err != ErrFrameRateNotGiven {
+		t.Fatalf("Expected no-rate error: %v", err)
 	}
 }
@@ -1,12 +1,105 @@
 package xmptype
 
-// FrameRateFieldValue knows how to parse frame-count expressions.
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var (
+	frameRateRe = regexp.MustCompile(`^f(\d+)(?:s(\d+))?$`)
+)
+
+// FrameRate is a rate of frames per second expressed as a rational.
+type FrameRate struct {
+	// Numerator is the number of frames.
+	Numerator int64
+
+	// Denominator is the number of seconds. It is one unless given.
+	Denominator int64
+}
+
+// ParseFrameRate parses a frame-rate in the "f###" or "f###s###" forms (e.g.
+// "f24" or "f30000s1001").
+func ParseFrameRate(raw string) (fr FrameRate, err error) {
+	matches := frameRateRe.FindStringSubmatch(raw)
+	if matches == nil {
+		return fr, ErrValueNotValid
+	}
+
+	fr, err = newFrameRate(matches[1], matches[2])
+	if err != nil {
+		return fr, err
+	}
+
+	return fr, nil
+}
+
+// newFrameRate returns a frame-rate from the digits of its numerator and
+// optional denominator.
+func newFrameRate(numeratorRaw, denominatorRaw string) (fr FrameRate, err error) {
+	numerator, err := strconv.ParseInt(numeratorRaw, 10, 64)
+	if err != nil {
+		return fr, ErrValueNotValid
+	}
+
+	denominator := int64(1)
+
+	if denominatorRaw != "" {
+		denominator, err = strconv.ParseInt(denominatorRaw, 10, 64)
+		if err != nil {
+			return fr, ErrValueNotValid
+		}
+	}
+
+	if numerator == 0 || denominator == 0 {
+		return fr, ErrValueNotValid
+	}
+
+	fr = FrameRate{
+		Numerator:   numerator,
+		Denominator: denominator,
+	}
+
+	return fr, nil
+}
+
+// IsZero returns true if the rate is not set.
+func (fr FrameRate) IsZero() bool {
+	return fr.Numerator == 0
+}
+
+// FramesPerSecond returns the rate as a real number.
+func (fr FrameRate) FramesPerSecond() float64 {
+	return float64(fr.Numerator) / float64(fr.Denominator)
+}
+
+// String returns the rate in the form that it is stored.
+func (fr FrameRate) String() string {
+	if fr.Denominator == 1 {
+		return fmt.Sprintf("f%d", fr.Numerator)
+	}
+
+	return fmt.Sprintf("f%ds%d", fr.Numerator, fr.Denominator)
+}
+
+// FrameRateFieldValue knows how to parse frame-rate expressions.
 type FrameRateFieldValue struct {
-	TextFieldValue
+	raw string
+}
+
+// Parse parses the raw string value to a FrameRate.
+func (frfv FrameRateFieldValue) Parse() (parsed interface{}, err error) {
+	fr, err := ParseFrameRate(frfv.raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return fr, nil
 }
 
-// FrameRateFieldType represents a frame-count specification. It is handled
-// as a string.
+// FrameRateFieldType represents a frame-rate specification ("f###" or
+// "f###s###").
 type FrameRateFieldType struct {
 }
 
@@ -14,8 +107,6 @@ type FrameRateFieldType struct {
 // parse a specific string.
 func (fcft FrameRateFieldType) GetValueParser(raw string) ScalarValueParser {
 	return FrameRateFieldValue{
-		TextFieldValue: TextFieldValue{
-			raw: raw,
-		},
+		raw: raw,
 	}
 }
@@ -8,14 +8,57 @@ import (
 
 func TestFrameRateFieldType_GetValueParser(t *testing.T) {
 	fft := FrameRateFieldType{}
-	scp := fft.GetValueParser("test_text")
+	scp := fft.GetValueParser("f30000s1001")
 
 	ffv := scp.(FrameRateFieldValue)
 
 	parsed, err := ffv.Parse()
 	log.PanicIf(err)
 
-	if parsed != "test_text" {
-		t.Fatalf("Parse is not correct: [%s]", parsed)
+	expected := FrameRate{Numerator: 30000, Denominator: 1001}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestFrameRateFieldType_GetValueParser_NotValid(t *testing.T) {
+	fft := FrameRateFieldType{}
+
+	for _, raw := range []string{"test_text", "24", "f", "f0", "f24s0", "f24s"} {
+		_, err := fft.GetValueParser(raw).Parse()
+		if err != ErrValueNotValid {
+			t.Fatalf("Expected not-valid error for [%s]: %v", raw, err)
+		}
+	}
+}
+
+func TestParseFrameRate(t *testing.T) {
+	fr, err := ParseFrameRate("f24")
+	log.PanicIf(err)
+
+	if fr.Numerator != 24 || fr.Denominator != 1 {
+		t.Fatalf("Frame-rate not correct: %v", fr)
+	}
+}
+
+func TestFrameRate_FramesPerSecond(t *testing.T) {
+	fr := FrameRate{Numerator: 24000, Denominator: 1001}
+
+	fps := fr.FramesPerSecond()
+	if fps < 23.976 || fps > 23.977 {
+		t.Fatalf("Frames-per-second not correct: (%f)", fps)
+	}
+}
+
+func TestFrameRate_String(t *testing.T) {
+	fr := FrameRate{Numerator: 25, Denominator: 1}
+	if fr.String() != "f25" {
+		t.Fatalf("String not correct: [%s]", fr.String())
+	}
+
+	fr = FrameRate{Numerator: 60000, Denominator: 1001}
+	if fr.String() != "f60000s1001" {
+		t.Fatalf("String not correct: [%s]", fr.String())
 	}
 }
@@ -0,0 +1,129 @@
+package xmptype
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	gpsCoordinateRe = regexp.MustCompile(`^(\d{1,3}),(\d{1,2}(?:\.\d+)?)(?:,(\d{1,2}(?:\.\d+)?))?([NSEW])$`)
+)
+
+// GpsCoordinate is a latitude or longitude in degrees, minutes, and
+// (optionally) seconds, along with the compass direction (the "exif:
+// GPSCoordinate" type).
+type GpsCoordinate struct {
+	// Degrees is the whole number of degrees.
+	Degrees int64
+
+	// Minutes is the number of minutes. It may have a fractional part if
+	// there are no seconds.
+	Minutes float64
+
+	// Seconds is the number of seconds. It is only meaningful if HasSeconds
+	// is true.
+	Seconds float64
+
+	// HasSeconds indicates that the coordinate was given in the "DDD,MM,SSk"
+	// form rather than the "DDD,MM.mmk" form.
+	HasSeconds bool
+
+	// Direction is one of "N", "S", "E", or "W".
+	Direction string
+}
+
+// ParseGpsCoordinate parses a coordinate in the "DDD,MM,SSk" or "DDD,MM.mmk"
+// forms (e.g. "40,26,46N" or "79,58.9333W").
+func ParseGpsCoordinate(raw string) (gc GpsCoordinate, err error) {
+	matches := gpsCoordinateRe.FindStringSubmatch(strings.TrimSpace(raw))
+	if matches == nil {
+		return gc, ErrValueNotValid
+	}
+
+	degrees, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil || degrees > 180 {
+		return gc, ErrValueNotValid
+	}
+
+	minutes, err := strconv.ParseFloat(matches[2], 64)
+	if err != nil || minutes >= 60 {
+		return gc, ErrValueNotValid
+	}
+
+	gc = GpsCoordinate{
+		Degrees:   degrees,
+		Minutes:   minutes,
+		Direction: matches[4],
+	}
+
+	if matches[3] != "" {
+		// Only the minutes of the "DDD,MM.mmk" form may be fractional.
+		if strings.Contains(matches[2], ".") == true {
+			return GpsCoordinate{}, ErrValueNotValid
+		}
+
+		seconds, err := strconv.ParseFloat(matches[3], 64)
+		if err != nil || seconds >= 60 {
+			return GpsCoordinate{}, ErrValueNotValid
+		}
+
+		gc.Seconds = seconds
+		gc.HasSeconds = true
+	}
+
+	return gc, nil
+}
+
+// Decimal returns the coordinate in decimal degrees. Southern and western
+// coordinates are negative.
+func (gc GpsCoordinate) Decimal() float64 {
+	decimal := float64(gc.Degrees) + gc.Minutes/60 + gc.Seconds/3600
+
+	if gc.Direction == "S" || gc.Direction == "W" {
+		return -decimal
+	}
+
+	return decimal
+}
+
+// String returns the coordinate in the form that it is stored.
+func (gc GpsCoordinate) String() string {
+	minutes := strconv.FormatFloat(gc.Minutes, 'f', -1, 64)
+
+	if gc.HasSeconds == true {
+		seconds := strconv.FormatFloat(gc.Seconds, 'f', -1, 64)
+		return fmt.Sprintf("%d,%s,%s%s", gc.Degrees, minutes, seconds, gc.Direction)
+	}
+
+	return fmt.Sprintf("%d,%s%s", gc.Degrees, minutes, gc.Direction)
+}
+
+// GpsCoordinateFieldValue knows how to parse GPS coordinates.
+type GpsCoordinateFieldValue struct {
+	raw string
+}
+
+// Parse parses the raw string value to a GpsCoordinate.
+func (gcfv GpsCoordinateFieldValue) Parse() (parsed interface{}, err error) {
+	gc, err := ParseGpsCoordinate(gcfv.raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return gc, nil
+}
+
+// GpsCoordinateFieldType represents a GPS latitude or longitude
+// ("DDD,MM,SSk" or "DDD,MM.mmk").
+type GpsCoordinateFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (gcft GpsCoordinateFieldType) GetValueParser(raw string) ScalarValueParser {
+	return GpsCoordinateFieldValue{
+		raw: raw,
+	}
+}
@@ -0,0 +1,83 @@
+package xmptype
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestGpsCoordinateFieldType_GetValueParser(t *testing.T) {
+	gcft := GpsCoordinateFieldType{}
+	scp := gcft.GetValueParser("40,26,46N")
+
+	gcfv := scp.(GpsCoordinateFieldValue)
+
+	parsed, err := gcfv.Parse()
+	log.PanicIf(err)
+
+	expected := GpsCoordinate{
+		Degrees:    40,
+		Minutes:    26,
+		Seconds:    46,
+		HasSeconds: true,
+		Direction:  "N",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestGpsCoordinateFieldType_GetValueParser_NotValid(t *testing.T) {
+	gcft := GpsCoordinateFieldType{}
+
+	for _, raw := range []string{"test_text", "40,26,46", "40N", "40,26.5,10N", "40,60N", "40,26,60N", "181,0N", "40,26X"} {
+		_, err := gcft.GetValueParser(raw).Parse()
+		if err != ErrValueNotValid {
+			t.Fatalf("Expected not-valid error for [%s]: %v", raw, err)
+		}
+	}
+}
+
+func TestParseGpsCoordinate_DecimalMinutes(t *testing.T) {
+	gc, err := ParseGpsCoordinate("79,58.5W")
+	log.PanicIf(err)
+
+	expected := GpsCoordinate{
+		Degrees:   79,
+		Minutes:   58.5,
+		Direction: "W",
+	}
+
+	if gc != expected {
+		t.Fatalf("Coordinate not correct: %v", gc)
+	}
+}
+
+func TestGpsCoordinate_Decimal(t *testing.T) {
+	gc, err := ParseGpsCoordinate("40,26,46N")
+	log.PanicIf(err)
+
+	if math.Abs(gc.Decimal()-40.446111) > 0.000001 {
+		t.Fatalf("Decimal not correct: (%f)", gc.Decimal())
+	}
+
+	gc, err = ParseGpsCoordinate("79,58.5W")
+	log.PanicIf(err)
+
+	if gc.Decimal() != -79.975 {
+		t.Fatalf("Decimal not correct: (%f)", gc.Decimal())
+	}
+}
+
+func TestGpsCoordinate_String(t *testing.T) {
+	for _, raw := range []string{"40,26,46N", "79,58.5W", "0,0S"} {
+		gc, err := ParseGpsCoordinate(raw)
+		log.PanicIf(err)
+
+		if gc.String() != raw {
+			t.Fatalf("String not correct: [%s] != [%s]", gc.String(), raw)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package xmptype
+
+import (
+	"fmt"
+	"reflect"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// RegionBoundaryPoint is a vertex of a polygon region (the "Iptc4xmpExt:
+// BoundaryPoint" struct).
+type RegionBoundaryPoint struct {
+	// X is the horizontal position.
+	X float64
+
+	// Y is the vertical position.
+	Y float64
+}
+
+// RegionBoundaryPointFieldType is the item-type of the vertices of a polygon
+// region ("Iptc4xmpExt:rbVertices").
+type RegionBoundaryPointFieldType struct {
+}
+
+// ParseItem parses the item to a RegionBoundaryPoint.
+func (rbpft RegionBoundaryPointFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return rbpft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a RegionBoundaryPoint.
+func (rbpft RegionBoundaryPointFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	rbp := RegionBoundaryPoint{
+		X: sf.real("rbX"),
+		Y: sf.real("rbY"),
+	}
+
+	return rbp, nil
+}
+
+// RegionBoundary is the boundary of an image region (the "Iptc4xmpExt:
+// RegionBoundary" struct). Positions are in pixels or relative to the size of
+// the image, depending on the unit.
+type RegionBoundary struct {
+	// Shape is "rectangle", "circle", or "polygon".
+	Shape Choice
+
+	// Unit is "pixel" or "relative".
+	Unit Choice
+
+	// X is the horizontal position of the top-left corner of a rectangle or
+	// of the center of a circle.
+	X float64
+
+	// Y is the vertical position of the top-left corner of a rectangle or of
+	// the center of a circle.
+	Y float64
+
+	// W is the width of a rectangle.
+	W float64
+
+	// H is the height of a rectangle.
+	H float64
+
+	// Rx is the radius of a circle.
+	Rx float64
+
+	// Vertices are the vertices of a polygon.
+	Vertices []RegionBoundaryPoint
+}
+
+// String returns a string representation of the boundary.
+func (rb RegionBoundary) String() string {
+	return fmt.Sprintf("RegionBoundary<SHAPE=[%s] UNIT=[%s] X=(%g) Y=(%g) W=(%g) H=(%g) RX=(%g) VERTICES=(%d)>", rb.Shape.Code, rb.Unit.Code, rb.X, rb.Y, rb.W, rb.H, rb.Rx, len(rb.Vertices))
+}
+
+// RegionBoundaryFieldType is the field-type of region boundaries
+// ("Iptc4xmpExt:RegionBoundary").
+type RegionBoundaryFieldType struct {
+}
+
+// ParseStruct parses the fields to a RegionBoundary.
+func (rbft RegionBoundaryFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	rb := RegionBoundary{
+		Shape: sf.choice("rbShape"),
+		Unit:  sf.choice("rbUnit"),
+		X:     sf.real("rbX"),
+		Y:     sf.real("rbY"),
+		W:     sf.real("rbW"),
+		H:     sf.real("rbH"),
+		Rx:    sf.real("rbRx"),
+	}
+
+	if values := sf.items("rbVertices"); values != nil {
+		rb.Vertices = make([]RegionBoundaryPoint, len(values))
+
+		for i, value := range values {
+			var ok bool
+			if rb.Vertices[i], ok = value.(RegionBoundaryPoint); ok == false {
+				log.Panicf("region vertex is not a boundary point: [%v]", reflect.TypeOf(value))
+			}
+		}
+	}
+
+	return rb, nil
+}
+
+// ImageRegion is a region of an image and what it shows (the "Iptc4xmpExt:
+// ImageRegion" struct). Regions may also have properties from any other
+// namespace; those are not described here.
+type ImageRegion struct {
+	// Id identifies the region within the image.
+	Id string
+
+	// Name is the name of the region, keyed by language.
+	Name map[string]string
+
+	// ContentTypes describe the content of the region.
+	ContentTypes []Entity
+
+	// Roles describe the role of the region in the image.
+	Roles []Entity
+
+	// Boundary is the boundary of the region.
+	Boundary RegionBoundary
+}
+
+// String returns a string representation of the region.
+func (ir ImageRegion) String() string {
+	return fmt.Sprintf("ImageRegion<ID=[%s] NAME=%v BOUNDARY=%s>", ir.Id, ir.Name, ir.Boundary)
+}
+
+// ImageRegionFieldType is the field-type of image regions
+// ("Iptc4xmpExt:ImageRegion").
+type ImageRegionFieldType struct {
+}
+
+// ParseItem parses the item to an ImageRegion.
+func (irft ImageRegionFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return irft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to an ImageRegion.
+func (irft ImageRegionFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	ir := ImageRegion{
+		Id:           sf.text("rId"),
+		Name:         sf.languageItems("Name"),
+		ContentTypes: sf.entities("rCtype"),
+		Roles:        sf.entities("rRole"),
+	}
+
+	if boundary := sf.nested("RegionBoundary", RegionBoundaryFieldType{}); boundary != nil {
+		ir.Boundary = boundary.(RegionBoundary)
+	}
+
+	return ir, nil
+}
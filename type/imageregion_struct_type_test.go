@@ -0,0 +1,82 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestImageRegionFieldType_ParseItem(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+	registerTestXmlNamespace()
+
+	boundary := StructValue{
+		{Space: Iptc4xmpExtUri, Local: "rbShape"}: Choice{Code: "rectangle", Label: "Rectangle"},
+		{Space: Iptc4xmpExtUri, Local: "rbUnit"}:  Choice{Code: "relative", Label: "Relative"},
+		{Space: Iptc4xmpExtUri, Local: "rbX"}:     0.25,
+		{Space: Iptc4xmpExtUri, Local: "rbY"}:     0.5,
+		{Space: Iptc4xmpExtUri, Local: "rbW"}:     0.1,
+		{Space: Iptc4xmpExtUri, Local: "rbH"}:     0.2,
+	}
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "rId"}:            "face-1",
+			{Space: Iptc4xmpExtUri, Local: "Name"}:           getTestLanguageAlternatives("x-default", "Some Person"),
+			{Space: Iptc4xmpExtUri, Local: "RegionBoundary"}: boundary,
+		},
+	}
+
+	parsed, err := ImageRegionFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := ImageRegion{
+		Id:   "face-1",
+		Name: map[string]string{"x-default": "Some Person"},
+		Boundary: RegionBoundary{
+			Shape: Choice{Code: "rectangle", Label: "Rectangle"},
+			Unit:  Choice{Code: "relative", Label: "Relative"},
+			X:     0.25,
+			Y:     0.5,
+			W:     0.1,
+			H:     0.2,
+		},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Region not correct: %s", parsed)
+	}
+
+	if parsed.(ImageRegion).String() != "ImageRegion<ID=[face-1] NAME=map[x-default:Some Person] BOUNDARY=RegionBoundary<SHAPE=[rectangle] UNIT=[relative] X=(0.25) Y=(0.5) W=(0.1) H=(0.2) RX=(0) VERTICES=(0)>>" {
+		t.Fatalf("String not correct: [%s]", parsed.(ImageRegion).String())
+	}
+}
+
+func TestImageRegionFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := ImageRegionFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestRegionBoundaryPointFieldType_ParseItem(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "rbX"}: 0.1,
+			{Space: Iptc4xmpExtUri, Local: "rbY"}: 0.9,
+		},
+	}
+
+	parsed, err := RegionBoundaryPointFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	if parsed != (RegionBoundaryPoint{X: 0.1, Y: 0.9}) {
+		t.Fatalf("Point not correct: %v", parsed)
+	}
+}
@@ -0,0 +1,89 @@
+package xmptype
+
+import (
+	"fmt"
+	"strings"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// LocalCorrection is a Camera Raw adjustment that applies to part of the
+// image (the items of "crs:GradientBasedCorrections",
+// "crs:CircularGradientBasedCorrections", "crs:PaintBasedCorrections", and
+// "crs:MaskGroupBasedCorrections").
+type LocalCorrection struct {
+	// What is the kind of correction (usually "Correction").
+	What string
+
+	// Name is the name of the correction.
+	Name string
+
+	// SyncId identifies the correction when synchronizing.
+	SyncId string
+
+	// Active indicates that the correction is enabled.
+	Active bool
+
+	// Amount is the strength of the correction (0-1).
+	Amount float64
+
+	// Adjustments are the settings of the correction keyed by their local
+	// names (e.g. "LocalExposure2012").
+	Adjustments map[string]float64
+
+	// Masks describe where the correction applies.
+	Masks []CorrectionMask
+}
+
+// String returns a string representation of the correction.
+func (lc LocalCorrection) String() string {
+	return fmt.Sprintf("LocalCorrection<WHAT=[%s] NAME=[%s] ACTIVE=[%v] AMOUNT=(%g) ADJUSTMENTS=(%d) MASKS=(%d)>", lc.What, lc.Name, lc.Active, lc.Amount, len(lc.Adjustments), len(lc.Masks))
+}
+
+// LocalCorrectionFieldType is the item-type of Camera Raw local corrections
+// (e.g. "crs:PaintBasedCorrections").
+type LocalCorrectionFieldType struct {
+}
+
+// ParseItem parses the item to a LocalCorrection.
+func (lcft LocalCorrectionFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return lcft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a LocalCorrection. The adjustments are all
+// of the real-valued fields whose names begin with "Local".
+func (lcft LocalCorrectionFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(CrsUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	lc := LocalCorrection{
+		What:        sf.text("What"),
+		Name:        sf.text("CorrectionName"),
+		SyncId:      sf.text("CorrectionSyncID"),
+		Active:      sf.boolean("CorrectionActive"),
+		Amount:      sf.real("CorrectionAmount"),
+		Adjustments: make(map[string]float64),
+		Masks:       sf.correctionMasks("CorrectionMasks"),
+	}
+
+	for name := range fields {
+		if name.Space != CrsUri || strings.HasPrefix(name.Local, "Local") == false {
+			continue
+		}
+
+		lc.Adjustments[name.Local] = sf.real(name.Local)
+	}
+
+	return lc, nil
+}
@@ -0,0 +1,55 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestLocalCorrectionFieldType_ParseItem(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: CrsUri, Local: "What"}:              "Correction",
+			{Space: CrsUri, Local: "CorrectionName"}:    "Darken sky",
+			{Space: CrsUri, Local: "CorrectionActive"}:  true,
+			{Space: CrsUri, Local: "CorrectionAmount"}:  1.0,
+			{Space: CrsUri, Local: "LocalExposure2012"}: -0.5,
+			{Space: CrsUri, Local: "LocalContrast2012"}: 0.2,
+			{Space: CrsUri, Local: "CorrectionSyncID"}:  "8A3C5D1E",
+			{Space: xmpUri, Local: "LocalExposure2012"}: 1.0,
+		},
+	}
+
+	parsed, err := LocalCorrectionFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := LocalCorrection{
+		What:   "Correction",
+		Name:   "Darken sky",
+		SyncId: "8A3C5D1E",
+		Active: true,
+		Amount: 1,
+		Adjustments: map[string]float64{
+			"LocalExposure2012": -0.5,
+			"LocalContrast2012": 0.2,
+		},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Correction not correct: %s", parsed)
+	}
+
+	if parsed.(LocalCorrection).String() != "LocalCorrection<WHAT=[Correction] NAME=[Darken sky] ACTIVE=[true] AMOUNT=(1) ADJUSTMENTS=(2) MASKS=(0)>" {
+		t.Fatalf("String not correct: [%s]", parsed.(LocalCorrection).String())
+	}
+}
+
+func TestLocalCorrectionFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := LocalCorrectionFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// LocationDetails describes a location (the "Iptc4xmpExt:LocationDetails"
+// struct). The GPS fields are in the "exif" namespace.
+type LocationDetails struct {
+	// Identifiers are URIs that identify the location.
+	Identifiers []string
+
+	// Name is the name of the location, keyed by language.
+	Name map[string]string
+
+	// Sublocation is the name of a location within the city.
+	Sublocation string
+
+	// City is the city.
+	City string
+
+	// ProvinceState is the province or state.
+	ProvinceState string
+
+	// CountryName is the name of the country.
+	CountryName string
+
+	// CountryCode is the ISO 3166 code of the country.
+	CountryCode string
+
+	// WorldRegion is the name of the world region.
+	WorldRegion string
+
+	// GpsLatitude is the latitude.
+	GpsLatitude GpsCoordinate
+
+	// GpsLongitude is the longitude.
+	GpsLongitude GpsCoordinate
+
+	// GpsAltitude is the altitude in meters.
+	GpsAltitude Rational
+
+	// GpsAltitudeRef indicates whether the altitude is above or below sea
+	// level.
+	GpsAltitudeRef Choice
+}
+
+// String returns a string representation of the location.
+func (ld LocationDetails) String() string {
+	return fmt.Sprintf("LocationDetails<NAME=%v CITY=[%s] COUNTRY-CODE=[%s]>", ld.Name, ld.City, ld.CountryCode)
+}
+
+// LocationDetailsFieldType is the field-type of locations (e.g.
+// "Iptc4xmpExt:LocationCreated" and "Iptc4xmpExt:LocationShown").
+type LocationDetailsFieldType struct {
+}
+
+// ParseItem parses the item to a LocationDetails.
+func (ldft LocationDetailsFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return ldft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a LocationDetails.
+func (ldft LocationDetailsFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+	exifSf := newStructFields(ExifUri, fields)
+
+	if sf.isEmpty() == true && exifSf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	ld := LocationDetails{
+		Identifiers:    sf.textItems("LocationId"),
+		Name:           sf.languageItems("LocationName"),
+		Sublocation:    sf.text("Sublocation"),
+		City:           sf.text("City"),
+		ProvinceState:  sf.text("ProvinceState"),
+		CountryName:    sf.text("CountryName"),
+		CountryCode:    sf.text("CountryCode"),
+		WorldRegion:    sf.text("WorldRegion"),
+		GpsLatitude:    exifSf.gpsCoordinate("GPSLatitude"),
+		GpsLongitude:   exifSf.gpsCoordinate("GPSLongitude"),
+		GpsAltitude:    exifSf.rational("GPSAltitude"),
+		GpsAltitudeRef: exifSf.choice("GPSAltitudeRef"),
+	}
+
+	return ld, nil
+}
@@ -0,0 +1,63 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestLocationDetailsFieldType_ParseItem(t *testing.T) {
+	latitude := GpsCoordinate{Degrees: 48, Minutes: 51, Seconds: 30, HasSeconds: true, Direction: "N"}
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "City"}:        "Paris",
+			{Space: Iptc4xmpExtUri, Local: "CountryCode"}: "FRA",
+			{Space: Iptc4xmpExtUri, Local: "Sublocation"}: "Montmartre",
+			{Space: ExifUri, Local: "GPSLatitude"}:        latitude,
+			{Space: ExifUri, Local: "GPSAltitude"}:        Rational{Numerator: 130, Denominator: 1},
+		},
+	}
+
+	parsed, err := LocationDetailsFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := LocationDetails{
+		City:        "Paris",
+		CountryCode: "FRA",
+		Sublocation: "Montmartre",
+		GpsLatitude: latitude,
+		GpsAltitude: Rational{Numerator: 130, Denominator: 1},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Location not correct: %s", parsed)
+	}
+
+	if parsed.(LocationDetails).String() != "LocationDetails<NAME=map[] CITY=[Paris] COUNTRY-CODE=[FRA]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(LocationDetails).String())
+	}
+}
+
+func TestLocationDetailsFieldType_ParseStruct_GpsOnly(t *testing.T) {
+	fields := map[xml.Name]interface{}{
+		{Space: ExifUri, Local: "GPSLongitude"}: GpsCoordinate{Degrees: 2, Minutes: 20.5, Direction: "E"},
+	}
+
+	parsed, err := LocationDetailsFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	if parsed.(LocationDetails).GpsLongitude.Decimal() != 2+20.5/60 {
+		t.Fatalf("Longitude not correct: %v", parsed)
+	}
+}
+
+func TestLocationDetailsFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := LocationDetailsFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package xmptype
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// Marker is a marker in a track of a media file, such as a chapter or a cue
+// (the "xmpDM:Marker" struct).
+type Marker struct {
+	// StartTime is the position of the marker.
+	StartTime FrameCount
+
+	// Duration is the length of the marker. Zero if not given.
+	Duration FrameCount
+
+	// Type is the type of the marker (e.g. "Chapter" or "Cue").
+	Type Choice
+
+	// Name is the name of the marker.
+	Name string
+
+	// Comment is a description of the marker.
+	Comment string
+
+	// Location is the URL of a page to show for a web-link marker.
+	Location string
+
+	// Target is the frame of the page to show for a web-link marker.
+	Target string
+
+	// Speaker is who is speaking during a speech marker.
+	Speaker string
+
+	// Probability is the confidence of a speech-recognition marker.
+	Probability float64
+
+	// CuePointType is the type of a cue-point marker.
+	CuePointType string
+
+	// CuePointParams are the parameters of a cue-point marker.
+	CuePointParams []CuePointParam
+}
+
+// Start returns the position of the marker in real time. Fails with
+// ErrFrameRateNotGiven if the start-time does not have a frame-rate.
+func (m Marker) Start() (d time.Duration, err error) {
+	return m.StartTime.Duration()
+}
+
+// String returns a string representation of the marker.
+func (m Marker) String() string {
+	return fmt.Sprintf("Marker<TYPE=[%s] START-TIME=[%s] DURATION=[%s] NAME=[%s]>", m.Type.Code, m.StartTime, m.Duration, m.Name)
+}
+
+// MarkerFieldType is the item-type of arrays of markers (e.g.
+// "xmpDM:markers").
+type MarkerFieldType struct {
+}
+
+// ParseItem parses the item to a Marker.
+func (mft MarkerFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return mft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a Marker.
+func (mft MarkerFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(XmpDmUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	m := Marker{
+		StartTime:    sf.frameCount("startTime"),
+		Duration:     sf.frameCount("duration"),
+		Type:         sf.choice("type"),
+		Name:         sf.text("name"),
+		Comment:      sf.text("comment"),
+		Location:     sf.text("location"),
+		Target:       sf.text("target"),
+		Speaker:      sf.text("speaker"),
+		Probability:  sf.real("probability"),
+		CuePointType: sf.text("cuePointType"),
+	}
+
+	if values := sf.items("cuePointParams"); values != nil {
+		m.CuePointParams = make([]CuePointParam, len(values))
+
+		for i, value := range values {
+			var ok bool
+			if m.CuePointParams[i], ok = value.(CuePointParam); ok == false {
+				log.Panicf("marker cue-point parameter is not a cue-point parameter: [%v]", reflect.TypeOf(value))
+			}
+		}
+	}
+
+	return m, nil
+}
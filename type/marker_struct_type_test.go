@@ -0,0 +1,102 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func newTestCuePointParamArrayValue() ArrayValue {
+	keyName := xml.Name{Space: XmpDmUri, Local: "key"}
+
+	itemElements := [][]interface{}{
+		{
+			xml.StartElement{Name: rdfLiTag},
+			xml.StartElement{Name: keyName},
+			"scene",
+			xml.EndElement{Name: keyName},
+			xml.EndElement{Name: rdfLiTag},
+		},
+	}
+
+	collected := NewCollected(rdfSeqTag, itemElements)
+
+	return OrderedCuePointParamArrayFieldType{}.New(xmpregistry.Default(), nil, collected)
+}
+
+func TestMarkerFieldType_ParseItem(t *testing.T) {
+	startTime := FrameCount{Count: 50, Rate: FrameRate{Numerator: 25, Denominator: 1}}
+	markerType := Choice{Code: "Cue", Label: "Cue"}
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: XmpDmUri, Local: "startTime"}:      startTime,
+			{Space: XmpDmUri, Local: "type"}:           markerType,
+			{Space: XmpDmUri, Local: "name"}:           "Some Cue",
+			{Space: XmpDmUri, Local: "comment"}:        "Some comment",
+			{Space: XmpDmUri, Local: "probability"}:    0.5,
+			{Space: XmpDmUri, Local: "cuePointType"}:   "Event",
+			{Space: XmpDmUri, Local: "cuePointParams"}: newTestCuePointParamArrayValue(),
+		},
+	}
+
+	parsed, err := MarkerFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := Marker{
+		StartTime:    startTime,
+		Type:         markerType,
+		Name:         "Some Cue",
+		Comment:      "Some comment",
+		Probability:  0.5,
+		CuePointType: "Event",
+		CuePointParams: []CuePointParam{
+			{Key: "scene"},
+		},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Marker not correct: %s", parsed)
+	}
+
+	m := parsed.(Marker)
+
+	if m.String() != "Marker<TYPE=[Cue] START-TIME=[50f25] DURATION=[0] NAME=[Some Cue]>" {
+		t.Fatalf("String not correct: [%s]", m.String())
+	}
+
+	start, err := m.Start()
+	log.PanicIf(err)
+
+	if start != 2*time.Second {
+		t.Fatalf("Start not correct: [%s]", start)
+	}
+}
+
+func TestMarkerFieldType_ParseItem_NotMarker(t *testing.T) {
+	ai := ArrayItem{
+		CharData: "not a marker",
+	}
+
+	_, err := MarkerFieldType{}.ParseItem(ai)
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestMarker_Start_NoRate(t *testing.T) {
+	m := Marker{
+		StartTime: FrameCount{Count: 50},
+	}
+
+	_, err := m.Start()
+	if err != ErrFrameRateNotGiven {
+		t.Fatalf("Expected no-rate error: %v", err)
+	}
+}
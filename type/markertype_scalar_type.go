@@ -0,0 +1,28 @@
+package xmptype
+
+var (
+	markerTypeChoices = []ChoiceDefinition{
+		{Code: "Chapter", Label: "Chapter"},
+		{Code: "Cue", Label: "Cue"},
+		{Code: "Index", Label: "Index"},
+		{Code: "Speech", Label: "Speech"},
+		{Code: "Track", Label: "Track"},
+	}
+)
+
+// MarkerTypeFieldValue knows how to parse a marker-type value.
+type MarkerTypeFieldValue struct {
+	ChoiceFieldValue
+}
+
+// MarkerTypeFieldType describes a marker-type value. Other types are allowed.
+type MarkerTypeFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (mtft MarkerTypeFieldType) GetValueParser(raw string) ScalarValueParser {
+	return MarkerTypeFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, markerTypeChoices, false),
+	}
+}
@@ -0,0 +1,38 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestMarkerTypeFieldType_GetValueParser(t *testing.T) {
+	ft := MarkerTypeFieldType{}
+	scp := ft.GetValueParser("Chapter")
+
+	fv := scp.(MarkerTypeFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "Chapter",
+		Label: "Chapter",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestMarkerTypeFieldType_GetValueParser_Other(t *testing.T) {
+	ft := MarkerTypeFieldType{}
+	scp := ft.GetValueParser("Scene")
+
+	parsed, err := scp.Parse()
+	log.PanicIf(err)
+
+	if parsed != (Choice{Code: "Scene"}) {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
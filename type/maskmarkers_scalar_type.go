@@ -0,0 +1,25 @@
+package xmptype
+
+// MaskMarkersFieldValue knows how to parse a mask-markers value.
+type MaskMarkersFieldValue struct {
+	ClosedChoiceFieldValue
+}
+
+// MaskMarkersFieldType describes whether the markers of a referenced resource
+// are processed.
+type MaskMarkersFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (mmft MaskMarkersFieldType) GetValueParser(raw string) ScalarValueParser {
+	return MaskMarkersFieldValue{
+		ClosedChoiceFieldValue: ClosedChoiceFieldValue{
+			raw: raw,
+			choices: []string{
+				"All",
+				"None",
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestMaskMarkersFieldType_GetValueParser(t *testing.T) {
+	mmft := MaskMarkersFieldType{}
+	scp := mmft.GetValueParser("None")
+
+	mmfv := scp.(MaskMarkersFieldValue)
+
+	parsed, err := mmfv.Parse()
+	log.PanicIf(err)
+
+	if parsed != "None" {
+		t.Fatalf("Parse is not correct: [%s]", parsed)
+	}
+}
+
+func TestMaskMarkersFieldType_GetValueParser_NotValid(t *testing.T) {
+	mmft := MaskMarkersFieldType{}
+	scp := mmft.GetValueParser("Some")
+
+	_, err := scp.Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
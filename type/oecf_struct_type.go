@@ -0,0 +1,60 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// Oecf is an opto-electronic conversion function or a spatial frequency
+// response table (the "exif:OECF/SFR" struct). Values holds the cells of the
+// table in row-major order.
+type Oecf struct {
+	// Columns is the number of columns in the table.
+	Columns int64
+
+	// Rows is the number of rows in the table.
+	Rows int64
+
+	// Names are the column names.
+	Names []string
+
+	// Values are the cells of the table.
+	Values []Rational
+}
+
+// String returns a string representation of the table.
+func (o Oecf) String() string {
+	return fmt.Sprintf("Oecf<COLUMNS=(%d) ROWS=(%d) NAMES=%v VALUES=(%d)>", o.Columns, o.Rows, o.Names, len(o.Values))
+}
+
+// OecfFieldType is the field-type of OECF and SFR tables (e.g. "exif:OECF"
+// and "exif:SpatialFrequencyResponse").
+type OecfFieldType struct {
+}
+
+// ParseStruct parses the fields to an Oecf.
+func (oft OecfFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(ExifUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	o := Oecf{
+		Columns: sf.integer("Columns"),
+		Rows:    sf.integer("Rows"),
+		Names:   sf.textItems("Names"),
+		Values:  sf.rationalItems("Values"),
+	}
+
+	return o, nil
+}
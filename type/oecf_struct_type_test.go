@@ -0,0 +1,52 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestOecfFieldType_ParseStruct(t *testing.T) {
+	names := OrderedTextArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "Level", "Output"))
+	values := OrderedArrayFieldType{ItemType: RationalFieldType{}}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Seq", "1/10", "2/10"))
+
+	fields := map[xml.Name]interface{}{
+		{Space: ExifUri, Local: "Columns"}: int64(2),
+		{Space: ExifUri, Local: "Rows"}:    int64(1),
+		{Space: ExifUri, Local: "Names"}:   names,
+		{Space: ExifUri, Local: "Values"}:  values,
+	}
+
+	parsed, err := OecfFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := Oecf{
+		Columns: 2,
+		Rows:    1,
+		Names:   []string{"Level", "Output"},
+		Values: []Rational{
+			{Numerator: 1, Denominator: 10},
+			{Numerator: 2, Denominator: 10},
+		},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("OECF not correct: %s", parsed)
+	}
+
+	if parsed.(Oecf).String() != "Oecf<COLUMNS=(2) ROWS=(1) NAMES=[Level Output] VALUES=(2)>" {
+		t.Fatalf("String not correct: [%s]", parsed.(Oecf).String())
+	}
+}
+
+func TestOecfFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := OecfFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -21,22 +21,43 @@ var (
 	ErrChildFieldNotFound = errors.New("field not found")
 )
 
+// ParentName returns the name of the property that directly encloses the last
+// node of the given path (the nearest node before it that is not RDF syntax).
+// This is what scoped field-types are keyed by. Returns the zero name if there
+// is none.
+func ParentName(xpn xmpregistry.XmpPropertyName) xml.Name {
+	for i := len(xpn) - 2; i >= 0; i-- {
+		if xpn[i].Space != RdfUri {
+			return xml.Name(xpn[i])
+		}
+	}
+
+	return xml.Name{}
+}
+
 // ParseValue knows how to parse any value for registered namespaces with
-// knowledge of the given field.
-func ParseValue(namespace xmpregistry.Namespace, fieldName string, rawValue string) (parsedValue interface{}, err error) {
+// knowledge of the given field. The parent is the property that directly
+// encloses the field (see ParentName) and selects any scoped field-type.
+func ParseValue(namespace xmpregistry.Namespace, parent xml.Name, fieldName string, rawValue string) (parsedValue interface{}, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
 			err = log.Wrap(errRaw.(error))
 		}
 	}()
 
-	ft, found := namespace.Fields[fieldName]
+	ft, found := namespace.FieldType(parent, fieldName)
 	if found == false {
 		return nil, ErrChildFieldNotFound
 	}
 
 	namespaceUri := namespace.Uri
 
+	// A struct can not be expressed as a single value.
+	if _, ok := ft.(StructFieldType); ok == true {
+		parseLogger.Warningf(nil, "Could not parse value of struct field: NS=[%s] FIELD=[%s] VALUE=[%s]", namespaceUri, fieldName, rawValue)
+		return nil, ErrValueNotValid
+	}
+
 	sft, ok := ft.(ScalarFieldType)
 	if ok == false {
 		log.Panicf("scalar value field did not return a scalar parser: NS=[%s] FIELD=[%s] TYPE=[%v]", namespaceUri, fieldName, reflect.TypeOf(ft))
@@ -54,14 +75,14 @@ func ParseValue(namespace xmpregistry.Namespace, fieldName string, rawValue stri
 }
 
 // IsArrayType returns true if the field-type is an array-type.
-func IsArrayType(namespace xmpregistry.Namespace, fieldName string) (flag bool, err error) {
+func IsArrayType(namespace xmpregistry.Namespace, parent xml.Name, fieldName string) (flag bool, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
 			err = log.Wrap(errRaw.(error))
 		}
 	}()
 
-	ft, found := namespace.Fields[fieldName]
+	ft, found := namespace.FieldType(parent, fieldName)
 
 	if found == false {
 		return false, ErrChildFieldNotFound
@@ -72,8 +93,11 @@ func IsArrayType(namespace xmpregistry.Namespace, fieldName string) (flag bool,
 	return ok, nil
 }
 
-// ParseAttributes parses attributes and returns a map.
-func ParseAttributes(se xml.StartElement) (attributes map[xml.Name]interface{}, err error) {
+// ParseAttributes parses attributes and returns a map. The namespaces are
+// looked-up in the given registry. The parent is the property that the
+// attributes are fields of, which is usually the node itself but is the array
+// property for array items.
+func ParseAttributes(registry *xmpregistry.Registry, se xml.StartElement, parent xml.Name) (attributes map[xml.Name]interface{}, err error) {
 	defer func() {
 		if errRaw := recover(); errRaw != nil {
 			err = log.Wrap(errRaw.(error))
@@ -87,7 +111,7 @@ func ParseAttributes(se xml.StartElement) (attributes map[xml.Name]interface{},
 		attributeLocalName := attribute.Name.Local
 		attributeRawValue := attribute.Value
 
-		attributeNamespace, err := xmpregistry.Get(attributeNamespaceUri)
+		attributeNamespace, err := registry.Get(attributeNamespaceUri)
 		if err != nil {
 			if err == xmpregistry.ErrNamespaceNotFound {
 				continue
@@ -96,7 +120,7 @@ func ParseAttributes(se xml.StartElement) (attributes map[xml.Name]interface{},
 			log.Panic(err)
 		}
 
-		parsedValue, err := ParseValue(attributeNamespace, attributeLocalName, attributeRawValue)
+		parsedValue, err := ParseValue(attributeNamespace, parent, attributeLocalName, attributeRawValue)
 		if err != nil {
 			if err == ErrChildFieldNotFound {
 				parseLogger.Warningf(
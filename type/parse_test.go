@@ -20,7 +20,7 @@ func TestParseValue_Good(t *testing.T) {
 		},
 	}
 
-	parsedValue, err := ParseValue(namespace, "TestNumber", "123")
+	parsedValue, err := ParseValue(namespace, xml.Name{}, "TestNumber", "123")
 	log.PanicIf(err)
 
 	if parsedValue.(int64) != int64(123) {
@@ -36,7 +36,7 @@ func TestParseValue_Bad(t *testing.T) {
 		},
 	}
 
-	_, err := ParseValue(namespace, "TestField", "abc")
+	_, err := ParseValue(namespace, xml.Name{}, "TestField", "abc")
 	if err != ErrValueNotValid {
 		log.Panic(err)
 	}
@@ -50,7 +50,7 @@ func TestParseValue_InvalidChild(t *testing.T) {
 		},
 	}
 
-	_, err := ParseValue(namespace, "InvalidField", "abc")
+	_, err := ParseValue(namespace, xml.Name{}, "InvalidField", "abc")
 	if err == nil {
 		t.Fatalf("Expected error for invalid child.")
 	} else if err != ErrChildFieldNotFound {
@@ -58,6 +58,71 @@ func TestParseValue_InvalidChild(t *testing.T) {
 	}
 }
 
+func TestParseValue_Struct(t *testing.T) {
+	namespace := xmpregistry.Namespace{
+		Uri: "some/uri",
+		Fields: map[string]interface{}{
+			"TestField": TimeFieldType{},
+		},
+	}
+
+	_, err := ParseValue(namespace, xml.Name{}, "TestField", "120")
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error for struct field: %v", err)
+	}
+}
+
+func TestParseValue_Scoped(t *testing.T) {
+	parent := xml.Name{Space: "some/uri", Local: "TestStruct"}
+
+	namespace := xmpregistry.Namespace{
+		Uri: "some/uri",
+		Fields: map[string]interface{}{
+			"TestField": TextFieldType{},
+		},
+		ScopedFields: map[xml.Name]map[string]interface{}{
+			parent: {
+				"TestField": IntegerFieldType{},
+			},
+		},
+	}
+
+	parsedValue, err := ParseValue(namespace, parent, "TestField", "123")
+	log.PanicIf(err)
+
+	if parsedValue != int64(123) {
+		t.Fatalf("Scoped value not correct: [%v]", parsedValue)
+	}
+
+	parsedValue, err = ParseValue(namespace, xml.Name{}, "TestField", "123")
+	log.PanicIf(err)
+
+	if parsedValue != "123" {
+		t.Fatalf("Unscoped value not correct: [%v]", parsedValue)
+	}
+}
+
+func TestParentName(t *testing.T) {
+	xpn := xmpregistry.XmpPropertyName{
+		{Space: "some/uri", Local: "TestArray"},
+		{Space: RdfUri, Local: "Seq"},
+		{Space: RdfUri, Local: "li"},
+		{Space: "some/uri", Local: "TestField"},
+	}
+
+	parent := ParentName(xpn)
+
+	if parent != (xml.Name{Space: "some/uri", Local: "TestArray"}) {
+		t.Fatalf("Parent not correct: %v", parent)
+	}
+
+	parent = ParentName(xpn[:1])
+
+	if parent != (xml.Name{}) {
+		t.Fatalf("Expected empty parent for top-level name: %v", parent)
+	}
+}
+
 func TestIsArrayType_Hit(t *testing.T) {
 	namespace := xmpregistry.Namespace{
 		Uri: "some/uri",
@@ -66,7 +131,7 @@ func TestIsArrayType_Hit(t *testing.T) {
 		},
 	}
 
-	flag, err := IsArrayType(namespace, "TestField")
+	flag, err := IsArrayType(namespace, xml.Name{}, "TestField")
 	log.PanicIf(err)
 
 	if flag != true {
@@ -82,7 +147,7 @@ func TestIsArrayType_Miss(t *testing.T) {
 		},
 	}
 
-	flag, err := IsArrayType(namespace, "TestField")
+	flag, err := IsArrayType(namespace, xml.Name{}, "TestField")
 	log.PanicIf(err)
 
 	if flag != false {
@@ -98,7 +163,7 @@ func TestIsArrayType_InvalidChild(t *testing.T) {
 		},
 	}
 
-	_, err := IsArrayType(namespace, "InvalidField")
+	_, err := IsArrayType(namespace, xml.Name{}, "InvalidField")
 	if err == nil {
 		t.Fatalf("Expected error for invalid child.")
 	} else if err != ErrChildFieldNotFound {
@@ -146,12 +211,12 @@ func TestParseAttributes_Ok(t *testing.T) {
 		Attr: rawAttributes,
 	}
 
-	actual, err := ParseAttributes(se)
+	actual, err := ParseAttributes(xmpregistry.Default(), se, se.Name)
 	log.PanicIf(err)
 
 	expected := map[xml.Name]interface{}{
 		labelName:      "test_label_value",
-		modifyDateName: time.Date(2020, 6, 26, 0, 0, 0, 0, time.UTC),
+		modifyDateName: NewXmpDate(time.Date(2020, 6, 26, 0, 0, 0, 0, time.UTC), DatePrecisionDay, false),
 	}
 
 	if reflect.DeepEqual(actual, expected) != true {
@@ -181,7 +246,7 @@ func TestParseAttributes_SkipUnknownNamespaces(t *testing.T) {
 		Attr: rawAttributes,
 	}
 
-	actual, err := ParseAttributes(se)
+	actual, err := ParseAttributes(xmpregistry.Default(), se, se.Name)
 	log.PanicIf(err)
 
 	expected := map[xml.Name]interface{}{}
@@ -230,7 +295,7 @@ func TestParseAttributes_SkipInvalidFields(t *testing.T) {
 		Attr: rawAttributes,
 	}
 
-	actual, err := ParseAttributes(se)
+	actual, err := ParseAttributes(xmpregistry.Default(), se, se.Name)
 	log.PanicIf(err)
 
 	expected := map[xml.Name]interface{}{
@@ -241,3 +306,46 @@ func TestParseAttributes_SkipInvalidFields(t *testing.T) {
 		t.Fatalf("Attributes not parsed correctly.")
 	}
 }
+
+func TestParseAttributes_Registry(t *testing.T) {
+	xmpregistry.Clear()
+	defer xmpregistry.Clear()
+
+	registry := xmpregistry.NewRegistry()
+
+	xmpNamespace := xmpregistry.Namespace{
+		Uri:             xmpUri,
+		PreferredPrefix: "xmp",
+		Fields: map[string]interface{}{
+			"Label": TextFieldType{},
+		},
+	}
+
+	err := registry.Register(xmpNamespace)
+	log.PanicIf(err)
+
+	labelName := xml.Name{Space: xmpUri, Local: "Label"}
+
+	se := xml.StartElement{
+		Attr: []xml.Attr{
+			{
+				Name:  labelName,
+				Value: "test_label_value",
+			},
+		},
+	}
+
+	actual, err := ParseAttributes(registry, se, se.Name)
+	log.PanicIf(err)
+
+	if actual[labelName] != "test_label_value" {
+		t.Fatalf("Attribute not parsed with the given registry: %v", actual)
+	}
+
+	actual, err = ParseAttributes(xmpregistry.Default(), se, se.Name)
+	log.PanicIf(err)
+
+	if len(actual) != 0 {
+		t.Fatalf("Attribute parsed with a registry that does not have it: %v", actual)
+	}
+}
@@ -0,0 +1,75 @@
+package xmptype
+
+import (
+	"fmt"
+	"reflect"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// PersonDetails describes a person shown in an image (the "Iptc4xmpExt:
+// PersonDetails" struct).
+type PersonDetails struct {
+	// Identifiers are URIs that identify the person.
+	Identifiers []string
+
+	// Name is the name of the person, keyed by language.
+	Name map[string]string
+
+	// Description describes the person, keyed by language.
+	Description map[string]string
+
+	// Characteristics are terms that describe the person (e.g. an age group).
+	Characteristics []CvTerm
+}
+
+// String returns a string representation of the person.
+func (pd PersonDetails) String() string {
+	return fmt.Sprintf("PersonDetails<IDENTIFIERS=%v NAME=%v CHARACTERISTICS=(%d)>", pd.Identifiers, pd.Name, len(pd.Characteristics))
+}
+
+// PersonDetailsFieldType is the field-type of person details
+// ("Iptc4xmpExt:PersonInImageWDetails").
+type PersonDetailsFieldType struct {
+}
+
+// ParseItem parses the item to a PersonDetails.
+func (pdft PersonDetailsFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return pdft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a PersonDetails.
+func (pdft PersonDetailsFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	pd := PersonDetails{
+		Identifiers: sf.textItems("PersonId"),
+		Name:        sf.languageItems("PersonName"),
+		Description: sf.languageItems("PersonDescription"),
+	}
+
+	if values := sf.items("PersonCharacteristic"); values != nil {
+		pd.Characteristics = make([]CvTerm, len(values))
+
+		for i, value := range values {
+			var ok bool
+			if pd.Characteristics[i], ok = value.(CvTerm); ok == false {
+				log.Panicf("person characteristic is not a controlled-vocabulary term: [%v]", reflect.TypeOf(value))
+			}
+		}
+	}
+
+	return pd, nil
+}
@@ -0,0 +1,82 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestPersonDetailsFieldType_ParseItem(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+	registerTestXmlNamespace()
+
+	identifiers := UnorderedArrayFieldType{ItemType: UriFieldType{}}.New(xmpregistry.Default(), testPropertyName, getTestCollected("Bag", "http://example.com/person/1"))
+
+	// The characteristic is a struct item expressed with attributes, which are
+	// parsed by the namespace.
+
+	namespace := xmpregistry.Namespace{
+		Uri:             Iptc4xmpExtUri,
+		PreferredPrefix: "Iptc4xmpExt",
+		Fields: map[string]interface{}{
+			"CvTermId": UriFieldType{},
+		},
+	}
+
+	xmpregistry.Register(namespace)
+
+	termId := xml.Attr{
+		Name:  xml.Name{Space: Iptc4xmpExtUri, Local: "CvTermId"},
+		Value: "http://example.com/characteristic/adult",
+	}
+
+	characteristicElements := [][]interface{}{
+		{
+			xml.StartElement{Name: rdfLiTag, Attr: []xml.Attr{termId}},
+			"",
+			xml.EndElement{Name: rdfLiTag},
+		},
+	}
+
+	characteristics := UnorderedArrayFieldType{ItemType: CvTermFieldType{}}.New(xmpregistry.Default(), testPropertyName, NewCollected(xml.Name{Space: RdfUri, Local: "Bag"}, characteristicElements))
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "PersonId"}:             identifiers,
+			{Space: Iptc4xmpExtUri, Local: "PersonName"}:           getTestLanguageAlternatives("x-default", "Some Person"),
+			{Space: Iptc4xmpExtUri, Local: "PersonCharacteristic"}: characteristics,
+		},
+	}
+
+	parsed, err := PersonDetailsFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := PersonDetails{
+		Identifiers: []string{"http://example.com/person/1"},
+		Name:        map[string]string{"x-default": "Some Person"},
+		Characteristics: []CvTerm{
+			{Id: "http://example.com/characteristic/adult"},
+		},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Person not correct: %s", parsed)
+	}
+
+	if parsed.(PersonDetails).String() != "PersonDetails<IDENTIFIERS=[http://example.com/person/1] NAME=map[x-default:Some Person] CHARACTERISTICS=(1)>" {
+		t.Fatalf("String not correct: [%s]", parsed.(PersonDetails).String())
+	}
+}
+
+func TestPersonDetailsFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := PersonDetailsFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
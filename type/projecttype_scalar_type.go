@@ -0,0 +1,28 @@
+package xmptype
+
+var (
+	projectTypeChoices = []ChoiceDefinition{
+		{Code: "movie", Label: "Movie"},
+		{Code: "still", Label: "Still"},
+		{Code: "audio", Label: "Audio"},
+		{Code: "custom", Label: "Custom"},
+	}
+)
+
+// ProjectTypeFieldValue knows how to parse the file-type of a project-link.
+type ProjectTypeFieldValue struct {
+	ChoiceFieldValue
+}
+
+// ProjectTypeFieldType describes the file-type of a project-link. The value
+// must be one of the defined types.
+type ProjectTypeFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (ptft ProjectTypeFieldType) GetValueParser(raw string) ScalarValueParser {
+	return ProjectTypeFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, projectTypeChoices, true),
+	}
+}
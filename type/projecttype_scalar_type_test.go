@@ -0,0 +1,36 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestProjectTypeFieldType_GetValueParser(t *testing.T) {
+	ft := ProjectTypeFieldType{}
+	scp := ft.GetValueParser("movie")
+
+	fv := scp.(ProjectTypeFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "movie",
+		Label: "Movie",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestProjectTypeFieldType_GetValueParser_Invalid(t *testing.T) {
+	ft := ProjectTypeFieldType{}
+	scp := ft.GetValueParser("Chapter")
+
+	_, err := scp.Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
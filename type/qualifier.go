@@ -0,0 +1,54 @@
+package xmptype
+
+import (
+	"encoding/xml"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+const (
+	// XmlUri is the URI for the "xml" namespace. We can't use the same value
+	// from xmpnamespace because xmptype can't import from it.
+	XmlUri = "http://www.w3.org/XML/1998/namespace"
+)
+
+var (
+	rdfValueTag = xml.Name{
+		Space: RdfUri,
+		Local: "value",
+	}
+
+	xmlLangAttribute = xml.Name{
+		Space: XmlUri,
+		Local: "lang",
+	}
+)
+
+// Qualifiers are the qualifiers of a simple value. This is either the
+// "xml:lang" of a simple text value or the properties that are siblings of an
+// "rdf:value" (e.g. "xmpidq:Scheme"). The values are parsed.
+type Qualifiers map[xml.Name]interface{}
+
+// Get returns the value of the given qualifier.
+func (qualifiers Qualifiers) Get(uri string, local string) (value interface{}, found bool) {
+	name := xml.Name{
+		Space: uri,
+		Local: local,
+	}
+
+	value, found = qualifiers[name]
+
+	return value, found
+}
+
+// Language returns the "xml:lang" qualifier or an empty string if there isn't
+// one.
+func (qualifiers Qualifiers) Language() string {
+	language, _ := qualifiers[xmlLangAttribute].(string)
+	return language
+}
+
+// String returns a string representation of the qualifiers.
+func (qualifiers Qualifiers) String() string {
+	return xmpregistry.InlineAttributes(qualifiers)
+}
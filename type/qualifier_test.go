@@ -0,0 +1,75 @@
+package xmptype
+
+import (
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestQualifiers_Get(t *testing.T) {
+	qualifiers := Qualifiers{
+		{Space: xmpUri, Local: "bb"}: "value1",
+	}
+
+	if value, found := qualifiers.Get(xmpUri, "bb"); found != true || value != "value1" {
+		t.Fatalf("Qualifier not correct: [%v]", value)
+	}
+
+	if _, found := qualifiers.Get(xmpUri, "cc"); found != false {
+		t.Fatalf("Expected qualifier to not be found.")
+	}
+}
+
+func TestQualifiers_Language(t *testing.T) {
+	qualifiers := Qualifiers{
+		xmlLangAttribute: "de",
+	}
+
+	if qualifiers.Language() != "de" {
+		t.Fatalf("Language not correct: [%s]", qualifiers.Language())
+	}
+
+	qualifiers = nil
+
+	if qualifiers.Language() != "" {
+		t.Fatalf("Expected no language: [%s]", qualifiers.Language())
+	}
+}
+
+func TestQualifiers_String(t *testing.T) {
+	defer xmpregistry.Clear()
+	registerTestNamespaces()
+
+	qualifiers := Qualifiers{
+		{Space: xmpUri, Local: "bb"}: "value2",
+		{Space: RdfUri, Local: "aa"}: "value1",
+	}
+
+	if qualifiers.String() != "[rdf]aa=[value1] [xmp]bb=[value2]" {
+		t.Fatalf("String not correct: [%s]", qualifiers.String())
+	}
+}
+
+func TestArrayItem_Language(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			xmlLangAttribute: "x-default",
+		},
+	}
+
+	if ai.Language() != "x-default" {
+		t.Fatalf("Language from attributes not correct: [%s]", ai.Language())
+	}
+
+	ai = ArrayItem{
+		Qualifiers: Qualifiers{
+			xmlLangAttribute: "fr",
+		},
+	}
+
+	if ai.Language() != "fr" {
+		t.Fatalf("Language from qualifiers not correct: [%s]", ai.Language())
+	}
+}
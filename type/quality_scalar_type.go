@@ -0,0 +1,27 @@
+package xmptype
+
+var (
+	qualityChoices = []ChoiceDefinition{
+		{Code: "High", Label: "High"},
+		{Code: "Medium", Label: "Medium"},
+		{Code: "Low", Label: "Low"},
+	}
+)
+
+// QualityFieldValue knows how to parse a media-quality value.
+type QualityFieldValue struct {
+	ChoiceFieldValue
+}
+
+// QualityFieldType describes a media-quality value. The value must be one of
+// the defined qualities.
+type QualityFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (qft QualityFieldType) GetValueParser(raw string) ScalarValueParser {
+	return QualityFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, qualityChoices, true),
+	}
+}
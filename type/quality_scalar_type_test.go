@@ -0,0 +1,36 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestQualityFieldType_GetValueParser(t *testing.T) {
+	ft := QualityFieldType{}
+	scp := ft.GetValueParser("High")
+
+	fv := scp.(QualityFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "High",
+		Label: "High",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestQualityFieldType_GetValueParser_NotValid(t *testing.T) {
+	ft := QualityFieldType{}
+	scp := ft.GetValueParser("Best")
+
+	_, err := scp.Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// RegistryEntry is the identifier of an image in a registry (the
+// "Iptc4xmpExt:RegistryEntryDetails" struct).
+type RegistryEntry struct {
+	// ItemId is the identifier of the image in the registry.
+	ItemId string
+
+	// OrganisationId is the identifier of the registry.
+	OrganisationId string
+
+	// Role is a URI that identifies the role of the entry.
+	Role string
+}
+
+// String returns a string representation of the entry.
+func (re RegistryEntry) String() string {
+	return fmt.Sprintf("RegistryEntry<ITEM-ID=[%s] ORGANISATION-ID=[%s] ROLE=[%s]>", re.ItemId, re.OrganisationId, re.Role)
+}
+
+// RegistryEntryFieldType is the field-type of registry entries
+// ("Iptc4xmpExt:RegistryId").
+type RegistryEntryFieldType struct {
+}
+
+// ParseItem parses the item to a RegistryEntry.
+func (reft RegistryEntryFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return reft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a RegistryEntry.
+func (reft RegistryEntryFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(Iptc4xmpExtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	re := RegistryEntry{
+		ItemId:         sf.text("RegItemId"),
+		OrganisationId: sf.text("RegOrgId"),
+		Role:           sf.text("RegEntryRole"),
+	}
+
+	return re, nil
+}
@@ -0,0 +1,44 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestRegistryEntryFieldType_ParseItem(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: Iptc4xmpExtUri, Local: "RegItemId"}:    "abc-123",
+			{Space: Iptc4xmpExtUri, Local: "RegOrgId"}:     "http://registry.example.com",
+			{Space: Iptc4xmpExtUri, Local: "RegEntryRole"}: "http://cv.iptc.org/newscodes/imageregistryrole/primary",
+		},
+	}
+
+	parsed, err := RegistryEntryFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := RegistryEntry{
+		ItemId:         "abc-123",
+		OrganisationId: "http://registry.example.com",
+		Role:           "http://cv.iptc.org/newscodes/imageregistryrole/primary",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Entry not correct: %s", parsed)
+	}
+
+	if parsed.(RegistryEntry).String() != "RegistryEntry<ITEM-ID=[abc-123] ORGANISATION-ID=[http://registry.example.com] ROLE=[http://cv.iptc.org/newscodes/imageregistryrole/primary]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(RegistryEntry).String())
+	}
+}
+
+func TestRegistryEntryFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := RegistryEntryFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
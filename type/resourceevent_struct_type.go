@@ -0,0 +1,101 @@
+package xmptype
+
+import (
+	"fmt"
+	"strings"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// StEvtUri is the URI for the "stEvt" namespace. We can't use the same
+	// value from xmpnamespace because xmptype can't import from it.
+	StEvtUri = "http://ns.adobe.com/xap/1.0/sType/ResourceEvent#"
+)
+
+// ResourceEvent is a single high-level event in the history of a resource
+// (the "stEvt" struct).
+type ResourceEvent struct {
+	// Action is the action that occurred (e.g. "created" or "saved").
+	Action string
+
+	// Changed is the semicolon-delimited list of the parts of the resource
+	// that changed since the previous event. Only expected for "saved".
+	Changed string
+
+	// InstanceID is the instance-ID of the resource after the action.
+	InstanceID string
+
+	// Parameters are any additional description of the action.
+	Parameters string
+
+	// SoftwareAgent is the software that performed the action.
+	SoftwareAgent string
+
+	// When is the time at which the action occurred. Zero if not given.
+	When XmpDate
+}
+
+// ChangedParts returns the parts that changed, split from Changed.
+func (re ResourceEvent) ChangedParts() (parts []string) {
+	parts = make([]string, 0)
+
+	for _, part := range strings.Split(re.Changed, ";") {
+		part = strings.TrimSpace(part)
+
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return parts
+}
+
+// String returns a string representation of the event.
+func (re ResourceEvent) String() string {
+	when := ""
+	if re.When.IsZero() == false {
+		when = re.When.Format()
+	}
+
+	return fmt.Sprintf("ResourceEvent<ACTION=[%s] WHEN=[%s] INSTANCE-ID=[%s] AGENT=[%s] CHANGED=[%s]>", re.Action, when, re.InstanceID, re.SoftwareAgent, re.Changed)
+}
+
+// ResourceEventFieldType is the item-type of arrays of resource events (e.g.
+// "xmpMM:History"). Items may have their fields as attributes or as child
+// nodes.
+type ResourceEventFieldType struct {
+}
+
+// ParseItem parses the item to a ResourceEvent.
+func (reft ResourceEventFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return reft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a ResourceEvent.
+func (reft ResourceEventFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(StEvtUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	re := ResourceEvent{
+		Action:        sf.text("action"),
+		Changed:       sf.text("changed"),
+		InstanceID:    sf.text("instanceID"),
+		Parameters:    sf.text("parameters"),
+		SoftwareAgent: sf.text("softwareAgent"),
+		When:          sf.date("when"),
+	}
+
+	return re, nil
+}
@@ -0,0 +1,109 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestResourceEventFieldType_ParseItem(t *testing.T) {
+	when := NewXmpDate(time.Date(2013, 9, 23, 10, 9, 46, 0, time.FixedZone("", 7200)), DatePrecisionSecond, true)
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: StEvtUri, Local: "action"}:        "saved",
+			{Space: StEvtUri, Local: "changed"}:       "/metadata; /content",
+			{Space: StEvtUri, Local: "instanceID"}:    "xmp.iid:1",
+			{Space: StEvtUri, Local: "softwareAgent"}: "Some Agent",
+			{Space: StEvtUri, Local: "when"}:          when,
+		},
+	}
+
+	parsed, err := ResourceEventFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := ResourceEvent{
+		Action:        "saved",
+		Changed:       "/metadata; /content",
+		InstanceID:    "xmp.iid:1",
+		SoftwareAgent: "Some Agent",
+		When:          when,
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Event not correct: %s", parsed)
+	}
+
+	re := parsed.(ResourceEvent)
+
+	if reflect.DeepEqual(re.ChangedParts(), []string{"/metadata", "/content"}) != true {
+		t.Fatalf("Changed parts not correct: %v", re.ChangedParts())
+	} else if re.String() != "ResourceEvent<ACTION=[saved] WHEN=[2013-09-23T10:09:46+02:00] INSTANCE-ID=[xmp.iid:1] AGENT=[Some Agent] CHANGED=[/metadata; /content]>" {
+		t.Fatalf("String not correct: [%s]", re.String())
+	}
+}
+
+func TestResourceEventFieldType_ParseItem_NotEvent(t *testing.T) {
+	ai := ArrayItem{
+		CharData: "not an event",
+	}
+
+	_, err := ResourceEventFieldType{}.ParseItem(ai)
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestResourceEvent_ChangedParts_Empty(t *testing.T) {
+	re := ResourceEvent{}
+
+	if len(re.ChangedParts()) != 0 {
+		t.Fatalf("Expected no changed parts: %v", re.ChangedParts())
+	}
+}
+
+func TestOrderedResourceEventArrayValue_Events(t *testing.T) {
+	itemName := xml.Name{Space: RdfUri, Local: "li"}
+	actionName := xml.Name{Space: StEvtUri, Local: "action"}
+
+	itemElements := [][]interface{}{
+		{
+			xml.StartElement{Name: itemName},
+			xml.StartElement{Name: actionName},
+			"created",
+			xml.EndElement{Name: actionName},
+			xml.EndElement{Name: itemName},
+		},
+		{
+			xml.StartElement{Name: itemName},
+			"not an event",
+			xml.EndElement{Name: itemName},
+		},
+	}
+
+	collected := NewCollected(rdfSeqTag, itemElements)
+	av := OrderedResourceEventArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, collected)
+	oreav := av.(OrderedResourceEventArrayValue)
+
+	_, err := oreav.Events()
+	if _, ok := err.(ArrayItemsError); ok != true {
+		t.Fatalf("Expected items error: %v", err)
+	}
+
+	collected = NewCollected(rdfSeqTag, itemElements[:1])
+	av = OrderedResourceEventArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, collected)
+	oreav = av.(OrderedResourceEventArrayValue)
+
+	events, err := oreav.Events()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(events, []ResourceEvent{{Action: "created"}}) != true {
+		t.Fatalf("Events not correct: %v", events)
+	}
+}
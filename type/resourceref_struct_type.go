@@ -0,0 +1,128 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// StRefUri is the URI for the "stRef" namespace. We can't use the same
+	// value from xmpnamespace because xmptype can't import from it.
+	StRefUri = "http://ns.adobe.com/xap/1.0/sType/ResourceRef#"
+)
+
+// ResourceRef is a reference to another resource, such as the document that
+// a resource was derived from or an asset placed in a composite document
+// (the "stRef" struct).
+type ResourceRef struct {
+	// AlternatePaths are fallback file-paths or URLs of the resource. Only
+	// available when the reference is not an array item.
+	AlternatePaths []string
+
+	// DocumentID is the document-ID of the referenced resource.
+	DocumentID string
+
+	// FilePath is the file-path or URL of the referenced resource.
+	FilePath string
+
+	// FromPart is the portion of the referenced resource that was used.
+	FromPart string
+
+	// InstanceID is the instance-ID of the referenced resource.
+	InstanceID string
+
+	// LastModifyDate is when the referenced resource was last modified. Zero
+	// if not given.
+	LastModifyDate XmpDate
+
+	// Manager is the name of the asset-management system.
+	Manager string
+
+	// ManagerVariant is the particular variant of the asset-management system.
+	ManagerVariant string
+
+	// ManageTo is the URI that identifies the resource to the asset-management
+	// system.
+	ManageTo string
+
+	// ManageUI is a URI that can be used to access information about the
+	// resource in the asset-management system.
+	ManageUI string
+
+	// MaskMarkers tells whether to process the markers of the referenced
+	// resource ("All" or "None").
+	MaskMarkers string
+
+	// PartMapping is the name or URI of the mapping function used to map
+	// FromPart to ToPart.
+	PartMapping string
+
+	// RenditionClass is the rendition-class of the referenced resource.
+	RenditionClass string
+
+	// RenditionParams are additional rendition parameters.
+	RenditionParams string
+
+	// ToPart is the portion of the including resource that the referenced
+	// part was used in.
+	ToPart string
+
+	// VersionID is the version-ID of the referenced resource.
+	VersionID string
+}
+
+// String returns a string representation of the reference.
+func (rr ResourceRef) String() string {
+	return fmt.Sprintf("ResourceRef<DOCUMENT-ID=[%s] INSTANCE-ID=[%s] FILE-PATH=[%s] FROM-PART=[%s] TO-PART=[%s]>", rr.DocumentID, rr.InstanceID, rr.FilePath, rr.FromPart, rr.ToPart)
+}
+
+// ResourceRefFieldType is the field-type of resource references. It is used
+// both for single references (e.g. "xmpMM:DerivedFrom") and as the item-type
+// of arrays of them (e.g. "xmpMM:Ingredients"). The fields may be attributes
+// or child nodes.
+type ResourceRefFieldType struct {
+}
+
+// ParseItem parses the item to a ResourceRef.
+func (rrft ResourceRefFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return rrft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a ResourceRef.
+func (rrft ResourceRefFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(StRefUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	rr := ResourceRef{
+		AlternatePaths:  sf.textItems("alternatePaths"),
+		DocumentID:      sf.text("documentID"),
+		FilePath:        sf.text("filePath"),
+		FromPart:        sf.text("fromPart"),
+		InstanceID:      sf.text("instanceID"),
+		LastModifyDate:  sf.date("lastModifyDate"),
+		Manager:         sf.text("manager"),
+		ManagerVariant:  sf.text("managerVariant"),
+		ManageTo:        sf.text("manageTo"),
+		ManageUI:        sf.text("manageUI"),
+		MaskMarkers:     sf.text("maskMarkers"),
+		PartMapping:     sf.text("partMapping"),
+		RenditionClass:  sf.text("renditionClass"),
+		RenditionParams: sf.text("renditionParams"),
+		ToPart:          sf.text("toPart"),
+		VersionID:       sf.text("versionID"),
+	}
+
+	return rr, nil
+}
@@ -0,0 +1,114 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestResourceRefFieldType_ParseStruct(t *testing.T) {
+	lastModifyDate := NewXmpDate(time.Date(2014, 9, 22, 10, 56, 35, 0, time.UTC), DatePrecisionSecond, true)
+
+	alternatePaths := NewCollected(rdfSeqTag, [][]interface{}{
+		{
+			xml.StartElement{Name: xml.Name{Space: RdfUri, Local: "li"}},
+			"file:///a.psd",
+			xml.EndElement{Name: xml.Name{Space: RdfUri, Local: "li"}},
+		},
+	})
+
+	fields := map[xml.Name]interface{}{
+		{Space: StRefUri, Local: "alternatePaths"}: OrderedUriArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, alternatePaths),
+		{Space: StRefUri, Local: "documentID"}:     "xmp.did:1",
+		{Space: StRefUri, Local: "instanceID"}:     "xmp.iid:1",
+		{Space: StRefUri, Local: "filePath"}:       "placed.psd",
+		{Space: StRefUri, Local: "lastModifyDate"}: lastModifyDate,
+		{Space: StRefUri, Local: "maskMarkers"}:    "All",
+	}
+
+	parsed, err := ResourceRefFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := ResourceRef{
+		AlternatePaths: []string{"file:///a.psd"},
+		DocumentID:     "xmp.did:1",
+		FilePath:       "placed.psd",
+		InstanceID:     "xmp.iid:1",
+		LastModifyDate: lastModifyDate,
+		MaskMarkers:    "All",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Reference not correct: %s", parsed)
+	}
+
+	rr := parsed.(ResourceRef)
+
+	if rr.String() != "ResourceRef<DOCUMENT-ID=[xmp.did:1] INSTANCE-ID=[xmp.iid:1] FILE-PATH=[placed.psd] FROM-PART=[] TO-PART=[]>" {
+		t.Fatalf("String not correct: [%s]", rr.String())
+	}
+}
+
+func TestResourceRefFieldType_ParseItem_NotReference(t *testing.T) {
+	ai := ArrayItem{
+		CharData: "not a reference",
+	}
+
+	_, err := ResourceRefFieldType{}.ParseItem(ai)
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestUnorderedResourceRefArrayValue_Refs(t *testing.T) {
+	itemName := xml.Name{Space: RdfUri, Local: "li"}
+	documentIdName := xml.Name{Space: StRefUri, Local: "documentID"}
+
+	itemElements := [][]interface{}{
+		{
+			xml.StartElement{Name: itemName},
+			xml.StartElement{Name: documentIdName},
+			"xmp.did:1",
+			xml.EndElement{Name: documentIdName},
+			xml.EndElement{Name: itemName},
+		},
+		{
+			xml.StartElement{Name: itemName},
+			"not a reference",
+			xml.EndElement{Name: itemName},
+		},
+	}
+
+	collected := NewCollected(rdfBagTag, itemElements)
+	av := UnorderedResourceRefArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, collected)
+	urrav := av.(UnorderedResourceRefArrayValue)
+
+	_, err := urrav.Refs()
+	if _, ok := err.(ArrayItemsError); ok != true {
+		t.Fatalf("Expected items error: %v", err)
+	}
+
+	collected = NewCollected(rdfBagTag, itemElements[:1])
+	av = UnorderedResourceRefArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, collected)
+	urrav = av.(UnorderedResourceRefArrayValue)
+
+	refs, err := urrav.Refs()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(refs, []ResourceRef{{DocumentID: "xmp.did:1"}}) != true {
+		t.Fatalf("References not correct: %v", refs)
+	}
+
+	items, err := urrav.StringItems()
+	log.PanicIf(err)
+
+	if len(items) != 1 {
+		t.Fatalf("String items not correct: %v", items)
+	}
+}
@@ -0,0 +1,85 @@
+package xmptype
+
+import (
+	"fmt"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// RetouchArea is a Camera Raw spot removal (the items of
+// "crs:RetouchAreas").
+type RetouchArea struct {
+	// SpotType is "heal" or "clone".
+	SpotType string
+
+	// SourceState indicates how the source was chosen (e.g.
+	// "sourceSetExplicitly" or "sourceAutoComputed").
+	SourceState string
+
+	// Method is the healing method (e.g. "gaussian").
+	Method string
+
+	// SourceX is the horizontal offset of the source.
+	SourceX float64
+
+	// OffsetY is the vertical offset of the source.
+	OffsetY float64
+
+	// Opacity is the opacity of the retouching (0-1).
+	Opacity float64
+
+	// Feather is the feathering of the retouching.
+	Feather float64
+
+	// Seed seeds the choice of the source when it is computed.
+	Seed int64
+
+	// Masks describe the area that is retouched.
+	Masks []CorrectionMask
+}
+
+// String returns a string representation of the retouch area.
+func (ra RetouchArea) String() string {
+	return fmt.Sprintf("RetouchArea<SPOT-TYPE=[%s] METHOD=[%s] OPACITY=(%g) MASKS=(%d)>", ra.SpotType, ra.Method, ra.Opacity, len(ra.Masks))
+}
+
+// RetouchAreaFieldType is the item-type of Camera Raw spot removals
+// ("crs:RetouchAreas").
+type RetouchAreaFieldType struct {
+}
+
+// ParseItem parses the item to a RetouchArea.
+func (raft RetouchAreaFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return raft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a RetouchArea.
+func (raft RetouchAreaFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(CrsUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	ra := RetouchArea{
+		SpotType:    sf.text("SpotType"),
+		SourceState: sf.text("SourceState"),
+		Method:      sf.text("Method"),
+		SourceX:     sf.real("SourceX"),
+		OffsetY:     sf.real("OffsetY"),
+		Opacity:     sf.real("Opacity"),
+		Feather:     sf.real("Feather"),
+		Seed:        sf.integer("Seed"),
+		Masks:       sf.correctionMasks("Masks"),
+	}
+
+	return ra, nil
+}
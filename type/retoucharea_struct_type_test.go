@@ -0,0 +1,54 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestRetouchAreaFieldType_ParseItem(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: CrsUri, Local: "SpotType"}:    "heal",
+			{Space: CrsUri, Local: "SourceState"}: "sourceAutoComputed",
+			{Space: CrsUri, Local: "Method"}:      "gaussian",
+			{Space: CrsUri, Local: "SourceX"}:     0.25,
+			{Space: CrsUri, Local: "OffsetY"}:     0.75,
+			{Space: CrsUri, Local: "Opacity"}:     1.0,
+			{Space: CrsUri, Local: "Feather"}:     0.4,
+			{Space: CrsUri, Local: "Seed"}:        int64(3),
+		},
+	}
+
+	parsed, err := RetouchAreaFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	expected := RetouchArea{
+		SpotType:    "heal",
+		SourceState: "sourceAutoComputed",
+		Method:      "gaussian",
+		SourceX:     0.25,
+		OffsetY:     0.75,
+		Opacity:     1,
+		Feather:     0.4,
+		Seed:        3,
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Retouch area not correct: %s", parsed)
+	}
+
+	if parsed.(RetouchArea).String() != "RetouchArea<SPOT-TYPE=[heal] METHOD=[gaussian] OPACITY=(1) MASKS=(0)>" {
+		t.Fatalf("String not correct: [%s]", parsed.(RetouchArea).String())
+	}
+}
+
+func TestRetouchAreaFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := RetouchAreaFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -0,0 +1,415 @@
+package xmptype
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// StructFieldType is satisfied by the field-types of struct values that are
+// not array items (e.g. "xmpMM:DerivedFrom").
+type StructFieldType interface {
+	// ParseStruct returns the struct described by the given fields. Fields
+	// expressed as attributes and as child nodes are given together, already
+	// parsed by the field-types of their namespace. Child arrays are given as
+	// their ArrayValue.
+	ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error)
+}
+
+// StructValue holds the fields of a struct that is nested in a struct-valued
+// array item (e.g. the "stVer:event" of an "xmpMM:Versions" item). The fields
+// are keyed and parsed like attributes.
+type StructValue map[xml.Name]interface{}
+
+// GenericStructFieldType describes a struct that has no dedicated type (e.g.
+// when loaded from a schema). The fields are typed by the namespace and the
+// struct is returned as a StructValue.
+type GenericStructFieldType struct {
+}
+
+// ParseItem parses the item to a StructValue.
+func (gsft GenericStructFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return gsft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a StructValue. Returns ErrValueNotValid if
+// there are no fields.
+func (gsft GenericStructFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	if len(fields) == 0 {
+		return nil, ErrValueNotValid
+	}
+
+	sv := make(StructValue, len(fields))
+	for name, value := range fields {
+		sv[name] = value
+	}
+
+	return sv, nil
+}
+
+// structFields reads the fields of a struct value. For struct-valued array
+// items, fields expressed as attributes and as child nodes
+// ("rdf:parseType='Resource'") are both found in the item's attributes,
+// already parsed by the field-types of the struct's namespace.
+type structFields struct {
+	uri        string
+	attributes map[xml.Name]interface{}
+}
+
+func newStructFields(uri string, attributes map[xml.Name]interface{}) structFields {
+	return structFields{
+		uri:        uri,
+		attributes: attributes,
+	}
+}
+
+// isEmpty returns true if the item has no fields in the struct's namespace.
+func (sf structFields) isEmpty() bool {
+	for name := range sf.attributes {
+		if name.Space == sf.uri {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (sf structFields) get(local string) (value interface{}, found bool) {
+	name := xml.Name{
+		Space: sf.uri,
+		Local: local,
+	}
+
+	value, found = sf.attributes[name]
+
+	return value, found
+}
+
+// text returns the given text field or an empty string if not present.
+func (sf structFields) text(local string) string {
+	value, found := sf.get(local)
+	if found == false {
+		return ""
+	}
+
+	s, ok := value.(string)
+	if ok == false {
+		log.Panicf("struct field is not text: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return s
+}
+
+// date returns the given date field or a zero date if not present.
+func (sf structFields) date(local string) XmpDate {
+	value, found := sf.get(local)
+	if found == false {
+		return XmpDate{}
+	}
+
+	t, ok := value.(XmpDate)
+	if ok == false {
+		log.Panicf("struct field is not a date: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return t
+}
+
+// textItems returns the items of the given array field or nil if not present.
+func (sf structFields) textItems(local string) []string {
+	value, found := sf.get(local)
+	if found == false {
+		return nil
+	}
+
+	av, ok := value.(ArrayValue)
+	if ok == false {
+		log.Panicf("struct field is not an array: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	items, err := TextItems(av)
+	log.PanicIf(err)
+
+	return items
+}
+
+// nested returns the given nested-struct field as parsed by the given field-
+// type or nil if not present.
+func (sf structFields) nested(local string, sft StructFieldType) interface{} {
+	value, found := sf.get(local)
+	if found == false {
+		return nil
+	}
+
+	sv, ok := value.(StructValue)
+	if ok == false {
+		log.Panicf("struct field is not a struct: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	parsed, err := sft.ParseStruct(sv)
+	log.PanicIf(err)
+
+	return parsed
+}
+
+// integer returns the given integer field or zero if not present. The field
+// may have been parsed as text.
+func (sf structFields) integer(local string) int64 {
+	value, found := sf.get(local)
+	if found == false {
+		return 0
+	}
+
+	switch v := value.(type) {
+	case int64:
+		return v
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			log.Panicf("struct field is not an integer: [%s] [%s] [%s]", sf.uri, local, v)
+		}
+
+		return i
+	}
+
+	log.Panicf("struct field is not an integer: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	panic(nil)
+}
+
+// boolean returns the given boolean field or false if not present. The field
+// may have been parsed as text.
+func (sf structFields) boolean(local string) bool {
+	value, found := sf.get(local)
+	if found == false {
+		return false
+	}
+
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		parsed, err := BooleanFieldType{}.GetValueParser(strings.TrimSpace(v)).Parse()
+		if err != nil {
+			log.Panicf("struct field is not a boolean: [%s] [%s] [%s]", sf.uri, local, v)
+		}
+
+		return parsed.(bool)
+	}
+
+	log.Panicf("struct field is not a boolean: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	panic(nil)
+}
+
+// rational returns the given rational field or a zero rational if not
+// present.
+func (sf structFields) rational(local string) Rational {
+	value, found := sf.get(local)
+	if found == false {
+		return Rational{}
+	}
+
+	r, ok := value.(Rational)
+	if ok == false {
+		log.Panicf("struct field is not a rational: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return r
+}
+
+// choice returns the given choice field or an empty choice if not present.
+func (sf structFields) choice(local string) Choice {
+	value, found := sf.get(local)
+	if found == false {
+		return Choice{}
+	}
+
+	c, ok := value.(Choice)
+	if ok == false {
+		log.Panicf("struct field is not a choice: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return c
+}
+
+// real returns the given real field or zero if not present.
+func (sf structFields) real(local string) float64 {
+	value, found := sf.get(local)
+	if found == false {
+		return 0
+	}
+
+	f, ok := value.(float64)
+	if ok == false {
+		log.Panicf("struct field is not a real: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return f
+}
+
+// frameCount returns the given frame-count field or a zero frame-count if
+// not present.
+func (sf structFields) frameCount(local string) FrameCount {
+	value, found := sf.get(local)
+	if found == false {
+		return FrameCount{}
+	}
+
+	fc, ok := value.(FrameCount)
+	if ok == false {
+		log.Panicf("struct field is not a frame-count: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return fc
+}
+
+// frameRate returns the given frame-rate field or a zero frame-rate if not
+// present.
+func (sf structFields) frameRate(local string) FrameRate {
+	value, found := sf.get(local)
+	if found == false {
+		return FrameRate{}
+	}
+
+	fr, ok := value.(FrameRate)
+	if ok == false {
+		log.Panicf("struct field is not a frame-rate: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return fr
+}
+
+// items returns the parsed items of the given array field or nil if not
+// present.
+func (sf structFields) items(local string) []interface{} {
+	value, found := sf.get(local)
+	if found == false {
+		return nil
+	}
+
+	av, ok := value.(ArrayValue)
+	if ok == false {
+		log.Panicf("struct field is not an array: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	values, err := parsedValues(av)
+	log.PanicIf(err)
+
+	return values
+}
+
+// integerItems returns the items of the given integer-array field or nil if
+// not present.
+func (sf structFields) integerItems(local string) []int64 {
+	value, found := sf.get(local)
+	if found == false {
+		return nil
+	}
+
+	av, ok := value.(ArrayValue)
+	if ok == false {
+		log.Panicf("struct field is not an array: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	items, err := IntegerItems(av)
+	log.PanicIf(err)
+
+	return items
+}
+
+// rationalItems returns the items of the given rational-array field or nil
+// if not present.
+func (sf structFields) rationalItems(local string) []Rational {
+	value, found := sf.get(local)
+	if found == false {
+		return nil
+	}
+
+	av, ok := value.(ArrayValue)
+	if ok == false {
+		log.Panicf("struct field is not an array: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	items, err := RationalItems(av)
+	log.PanicIf(err)
+
+	return items
+}
+
+// languageItems returns the alternatives of the given language-alternative
+// field, keyed by language, or nil if not present.
+func (sf structFields) languageItems(local string) map[string]string {
+	value, found := sf.get(local)
+	if found == false {
+		return nil
+	}
+
+	av, ok := value.(ArrayValue)
+	if ok == false {
+		log.Panicf("struct field is not an array: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	items, err := LanguageItems(av)
+	log.PanicIf(err)
+
+	return items
+}
+
+// gpsCoordinate returns the given GPS-coordinate field or a zero coordinate
+// if not present.
+func (sf structFields) gpsCoordinate(local string) GpsCoordinate {
+	value, found := sf.get(local)
+	if found == false {
+		return GpsCoordinate{}
+	}
+
+	gc, ok := value.(GpsCoordinate)
+	if ok == false {
+		log.Panicf("struct field is not a GPS coordinate: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+	}
+
+	return gc
+}
+
+// entities returns the items of the given entity-array field or nil if not
+// present.
+func (sf structFields) entities(local string) []Entity {
+	values := sf.items(local)
+	if values == nil {
+		return nil
+	}
+
+	entities := make([]Entity, len(values))
+
+	for i, value := range values {
+		var ok bool
+		if entities[i], ok = value.(Entity); ok == false {
+			log.Panicf("struct field item is not an entity: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+		}
+	}
+
+	return entities
+}
+
+// correctionMasks returns the items of the given mask-array field or nil if
+// not present.
+func (sf structFields) correctionMasks(local string) []CorrectionMask {
+	values := sf.items(local)
+	if values == nil {
+		return nil
+	}
+
+	masks := make([]CorrectionMask, len(values))
+
+	for i, value := range values {
+		var ok bool
+		if masks[i], ok = value.(CorrectionMask); ok == false {
+			log.Panicf("struct field item is not a correction mask: [%s] [%s] [%v]", sf.uri, local, reflect.TypeOf(value))
+		}
+	}
+
+	return masks
+}
@@ -0,0 +1,115 @@
+package xmptype
+
+import (
+	"testing"
+	"time"
+
+	"encoding/xml"
+)
+
+func TestStructFields(t *testing.T) {
+	when := NewXmpDate(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), DatePrecisionSecond, true)
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: xmpUri, Local: "text"}: "some text",
+			{Space: xmpUri, Local: "date"}: when,
+			{Space: RdfUri, Local: "text"}: "other namespace",
+		},
+	}
+
+	sf := newStructFields(xmpUri, ai.Attributes)
+
+	if sf.isEmpty() != false {
+		t.Fatalf("Expected fields.")
+	} else if sf.text("text") != "some text" {
+		t.Fatalf("Text not correct: [%s]", sf.text("text"))
+	} else if sf.text("missing") != "" {
+		t.Fatalf("Missing text not correct: [%s]", sf.text("missing"))
+	} else if sf.date("date").Equal(when) != true {
+		t.Fatalf("Date not correct: [%s]", sf.date("date"))
+	} else if sf.date("missing").IsZero() != true {
+		t.Fatalf("Missing date not correct: [%s]", sf.date("missing"))
+	}
+
+	sf = newStructFields("other/uri", ai.Attributes)
+
+	if sf.isEmpty() != true {
+		t.Fatalf("Expected no fields in other namespace.")
+	}
+}
+
+func TestStructFields_WrongType(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: xmpUri, Local: "date"}: "not a date",
+		},
+	}
+
+	sf := newStructFields(xmpUri, ai.Attributes)
+
+	defer func() {
+		if errRaw := recover(); errRaw == nil {
+			t.Fatalf("Expected panic for wrong type.")
+		}
+	}()
+
+	sf.date("date")
+}
+
+func TestStructFields_Boolean(t *testing.T) {
+	fields := map[xml.Name]interface{}{
+		{Space: xmpUri, Local: "parsed"}: true,
+		{Space: xmpUri, Local: "text"}:   "True",
+	}
+
+	sf := newStructFields(xmpUri, fields)
+
+	if sf.boolean("parsed") != true {
+		t.Fatalf("Parsed boolean not correct.")
+	} else if sf.boolean("text") != true {
+		t.Fatalf("Text boolean not correct.")
+	} else if sf.boolean("missing") != false {
+		t.Fatalf("Missing boolean not correct.")
+	}
+}
+
+func TestStructFields_GpsCoordinate(t *testing.T) {
+	gc := GpsCoordinate{Degrees: 40, Minutes: 26.5, Direction: "N"}
+
+	fields := map[xml.Name]interface{}{
+		{Space: xmpUri, Local: "coordinate"}: gc,
+	}
+
+	sf := newStructFields(xmpUri, fields)
+
+	if sf.gpsCoordinate("coordinate") != gc {
+		t.Fatalf("Coordinate not correct: [%s]", sf.gpsCoordinate("coordinate"))
+	} else if sf.gpsCoordinate("missing") != (GpsCoordinate{}) {
+		t.Fatalf("Missing coordinate not correct: [%s]", sf.gpsCoordinate("missing"))
+	}
+}
+
+func TestGenericStructFieldType_ParseItem(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: xmpUri, Local: "text"}: "some text",
+		},
+	}
+
+	parsed, err := GenericStructFieldType{}.ParseItem(ai)
+	if err != nil {
+		t.Fatalf("Parse failed: [%v]", err)
+	}
+
+	sv := parsed.(StructValue)
+
+	if len(sv) != 1 || sv[xml.Name{Space: xmpUri, Local: "text"}] != "some text" {
+		t.Fatalf("Struct not correct: %v", sv)
+	}
+
+	_, err = GenericStructFieldType{}.ParseItem(ArrayItem{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error for empty struct: [%v]", err)
+	}
+}
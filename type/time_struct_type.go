@@ -0,0 +1,73 @@
+package xmptype
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// XmpDmUri is the URI for the "xmpDM" namespace. We can't use the same
+	// value from xmpnamespace because xmptype can't import from it.
+	XmpDmUri = "http://ns.adobe.com/xmp/1.0/DynamicMedia/"
+)
+
+// Time is a length of media expressed as a number of units at a given scale
+// (the "xmpDM:Time" struct). For example, a value of 1001 at a scale of
+// 1/30000 is one frame of 29.97 fps video.
+type Time struct {
+	// Value is the number of units.
+	Value int64
+
+	// Scale is the length of one unit in seconds.
+	Scale Rational
+}
+
+// Duration returns the length as a duration, rounded to the nearest
+// nanosecond. Returns ErrValueNotValid if the scale is not set.
+func (t Time) Duration() (d time.Duration, err error) {
+	if t.Scale.Denominator == 0 {
+		return 0, ErrValueNotValid
+	}
+
+	nanoseconds := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(t.Value), big.NewInt(t.Scale.Numerator*int64(time.Second))),
+		big.NewInt(t.Scale.Denominator))
+
+	return time.Duration(roundRat(nanoseconds)), nil
+}
+
+// String returns a string representation of the time.
+func (t Time) String() string {
+	return fmt.Sprintf("Time<VALUE=[%d] SCALE=[%d/%d]>", t.Value, t.Scale.Numerator, t.Scale.Denominator)
+}
+
+// TimeFieldType is the field-type of media lengths (e.g. "xmpDM:duration").
+type TimeFieldType struct {
+}
+
+// ParseStruct parses the fields to a Time.
+func (tft TimeFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(XmpDmUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	t := Time{
+		Value: sf.integer("value"),
+		Scale: sf.rational("scale"),
+	}
+
+	return t, nil
+}
@@ -0,0 +1,78 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestTimeFieldType_ParseStruct(t *testing.T) {
+	fields := map[xml.Name]interface{}{
+		{Space: XmpDmUri, Local: "value"}: "2002",
+		{Space: XmpDmUri, Local: "scale"}: Rational{Numerator: 1001, Denominator: 30000},
+	}
+
+	parsed, err := TimeFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := Time{
+		Value: 2002,
+		Scale: Rational{Numerator: 1001, Denominator: 30000},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Time not correct: %s", parsed)
+	}
+
+	if parsed.(Time).String() != "Time<VALUE=[2002] SCALE=[1001/30000]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(Time).String())
+	}
+}
+
+func TestTimeFieldType_ParseStruct_ValueNotInteger(t *testing.T) {
+	fields := map[xml.Name]interface{}{
+		{Space: XmpDmUri, Local: "value"}: "abc",
+	}
+
+	_, err := TimeFieldType{}.ParseStruct(fields)
+	if err == nil {
+		t.Fatalf("Expected error for value that is not an integer.")
+	}
+}
+
+func TestTimeFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := TimeFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestTime_Duration(t *testing.T) {
+	tm := Time{
+		Value: 60,
+		Scale: Rational{Numerator: 1001, Denominator: 30000},
+	}
+
+	d, err := tm.Duration()
+	log.PanicIf(err)
+
+	// 60 * 1001/30000 seconds is 2.002 seconds.
+	if d != 2002*time.Millisecond {
+		t.Fatalf("Duration not correct: [%s]", d)
+	}
+}
+
+func TestTime_Duration_NoScale(t *testing.T) {
+	tm := Time{
+		Value: 2002,
+	}
+
+	_, err := tm.Duration()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package xmptype
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+var (
+	// ErrTimeFormatNotSupported indicates that a timecode does not have a
+	// time-format that we know the frame-rate of.
+	ErrTimeFormatNotSupported = errors.New("time-format not supported")
+)
+
+var (
+	timecodeRe = regexp.MustCompile(`^(\d{2})[:;](\d{2})[:;](\d{2})[:;](\d{2})$`)
+)
+
+// timecodeFormat describes how the timecodes of a given time-format count
+// frames.
+type timecodeFormat struct {
+	// nominalRate is the number of frames counted per timecode second.
+	nominalRate int64
+
+	// dropped is the number of frame numbers skipped at the start of each
+	// minute except every tenth. Zero for non-drop-frame formats.
+	dropped int64
+
+	// rate is the actual frame-rate.
+	rate FrameRate
+}
+
+var (
+	timecodeFormats = map[string]timecodeFormat{
+		"24Timecode":          {nominalRate: 24, rate: FrameRate{Numerator: 24, Denominator: 1}},
+		"25Timecode":          {nominalRate: 25, rate: FrameRate{Numerator: 25, Denominator: 1}},
+		"2997DropTimecode":    {nominalRate: 30, dropped: 2, rate: FrameRate{Numerator: 30000, Denominator: 1001}},
+		"2997NonDropTimecode": {nominalRate: 30, rate: FrameRate{Numerator: 30000, Denominator: 1001}},
+		"30Timecode":          {nominalRate: 30, rate: FrameRate{Numerator: 30, Denominator: 1}},
+		"50Timecode":          {nominalRate: 50, rate: FrameRate{Numerator: 50, Denominator: 1}},
+		"5994DropTimecode":    {nominalRate: 60, dropped: 4, rate: FrameRate{Numerator: 60000, Denominator: 1001}},
+		"5994NonDropTimecode": {nominalRate: 60, rate: FrameRate{Numerator: 60000, Denominator: 1001}},
+		"60Timecode":          {nominalRate: 60, rate: FrameRate{Numerator: 60, Denominator: 1}},
+		"23976Timecode":       {nominalRate: 24, rate: FrameRate{Numerator: 24000, Denominator: 1001}},
+	}
+)
+
+// Timecode is a SMPTE timecode (the "xmpDM:Timecode" struct).
+type Timecode struct {
+	// Format is the time-format (e.g. "2997DropTimecode").
+	Format Choice
+
+	// Value is the timecode in the form "hh:mm:ss:ff", or "hh;mm;ss;ff" for
+	// drop-frame formats.
+	Value string
+}
+
+func (tc Timecode) format() (tf timecodeFormat, err error) {
+	tf, found := timecodeFormats[tc.Format.Code]
+	if found == false {
+		return tf, ErrTimeFormatNotSupported
+	}
+
+	return tf, nil
+}
+
+// FrameRate returns the actual frame-rate of the time-format.
+func (tc Timecode) FrameRate() (fr FrameRate, err error) {
+	tf, err := tc.format()
+	if err != nil {
+		return fr, err
+	}
+
+	return tf.rate, nil
+}
+
+// Frames returns the number of frames that precede the timecode. For drop-
+// frame formats, the skipped frame numbers are not counted. Returns
+// ErrValueNotValid if the timecode is malformed or names a dropped frame.
+func (tc Timecode) Frames() (frames int64, err error) {
+	tf, err := tc.format()
+	if err != nil {
+		return 0, err
+	}
+
+	matches := timecodeRe.FindStringSubmatch(tc.Value)
+	if matches == nil {
+		return 0, ErrValueNotValid
+	}
+
+	components := make([]int64, 4)
+	for i := range components {
+		components[i], _ = strconv.ParseInt(matches[i+1], 10, 64)
+	}
+
+	hours, minutes, seconds, frameNumber := components[0], components[1], components[2], components[3]
+
+	if minutes >= 60 || seconds >= 60 || frameNumber >= tf.nominalRate {
+		return 0, ErrValueNotValid
+	}
+
+	totalMinutes := hours*60 + minutes
+
+	if tf.dropped > 0 && minutes%10 != 0 && seconds == 0 && frameNumber < tf.dropped {
+		return 0, ErrValueNotValid
+	}
+
+	frames = (totalMinutes*60+seconds)*tf.nominalRate + frameNumber
+	frames -= tf.dropped * (totalMinutes - totalMinutes/10)
+
+	return frames, nil
+}
+
+// Duration returns the real time at which the timecode occurs, rounded to the
+// nearest nanosecond.
+func (tc Timecode) Duration() (d time.Duration, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	frames, err := tc.Frames()
+	if err != nil {
+		return 0, err
+	}
+
+	rate, err := tc.FrameRate()
+	log.PanicIf(err)
+
+	return framesDuration(frames, rate), nil
+}
+
+// String returns a string representation of the timecode.
+func (tc Timecode) String() string {
+	return fmt.Sprintf("Timecode<FORMAT=[%s] VALUE=[%s]>", tc.Format.Code, tc.Value)
+}
+
+// TimecodeFieldType is the field-type of timecodes (e.g.
+// "xmpDM:startTimecode").
+type TimecodeFieldType struct {
+}
+
+// ParseStruct parses the fields to a Timecode.
+func (tft TimecodeFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(XmpDmUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	tc := Timecode{
+		Format: sf.choice("timeFormat"),
+		Value:  sf.text("timeValue"),
+	}
+
+	return tc, nil
+}
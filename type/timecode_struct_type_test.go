@@ -0,0 +1,153 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func newTestTimecode(format, value string) Timecode {
+	parsed, err := TimeFormatFieldType{}.GetValueParser(format).Parse()
+	log.PanicIf(err)
+
+	return Timecode{
+		Format: parsed.(Choice),
+		Value:  value,
+	}
+}
+
+func TestTimecodeFieldType_ParseStruct(t *testing.T) {
+	format := Choice{Code: "25Timecode", Label: "25 fps"}
+
+	fields := map[xml.Name]interface{}{
+		{Space: XmpDmUri, Local: "timeFormat"}: format,
+		{Space: XmpDmUri, Local: "timeValue"}:  "01:00:00:00",
+	}
+
+	parsed, err := TimecodeFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := Timecode{
+		Format: format,
+		Value:  "01:00:00:00",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Timecode not correct: %s", parsed)
+	}
+
+	if parsed.(Timecode).String() != "Timecode<FORMAT=[25Timecode] VALUE=[01:00:00:00]>" {
+		t.Fatalf("String not correct: [%s]", parsed.(Timecode).String())
+	}
+}
+
+func TestTimecodeFieldType_ParseStruct_Empty(t *testing.T) {
+	_, err := TimecodeFieldType{}.ParseStruct(map[xml.Name]interface{}{})
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestTimecode_Frames(t *testing.T) {
+	testCases := []struct {
+		format string
+		value  string
+		frames int64
+	}{
+		{"25Timecode", "00:00:01:00", 25},
+		{"25Timecode", "01:00:00:00", 90000},
+		{"2997NonDropTimecode", "00:01:00:00", 1800},
+		{"2997DropTimecode", "00;00;59;29", 1799},
+		{"2997DropTimecode", "00;01;00;02", 1800},
+		{"2997DropTimecode", "00;10;00;00", 17982},
+		{"2997DropTimecode", "01;00;00;00", 107892},
+		{"5994DropTimecode", "00;01;00;04", 3600},
+		{"5994DropTimecode", "01;00;00;00", 215784},
+		{"23976Timecode", "00:00:01:00", 24},
+	}
+
+	for _, testCase := range testCases {
+		tc := newTestTimecode(testCase.format, testCase.value)
+
+		frames, err := tc.Frames()
+		log.PanicIf(err)
+
+		if frames != testCase.frames {
+			t.Fatalf("Frames for [%s] [%s] not correct: (%d) != (%d)", testCase.format, testCase.value, frames, testCase.frames)
+		}
+	}
+}
+
+func TestTimecode_Frames_NotValid(t *testing.T) {
+	testCases := []struct {
+		format string
+		value  string
+	}{
+		{"25Timecode", "00:00:00:25"},
+		{"25Timecode", "00:60:00:00"},
+		{"25Timecode", "0:00:00:00"},
+		{"2997DropTimecode", "00;01;00;00"},
+		{"2997DropTimecode", "00;01;00;01"},
+		{"5994DropTimecode", "00;01;00;03"},
+	}
+
+	for _, testCase := range testCases {
+		tc := newTestTimecode(testCase.format, testCase.value)
+
+		_, err := tc.Frames()
+		if err != ErrValueNotValid {
+			t.Fatalf("Expected not-valid error for [%s] [%s]: %v", testCase.format, testCase.value, err)
+		}
+	}
+}
+
+func TestTimecode_Frames_FormatNotSupported(t *testing.T) {
+	tc := Timecode{
+		Value: "00:00:00:00",
+	}
+
+	_, err := tc.Frames()
+	if err != ErrTimeFormatNotSupported {
+		t.Fatalf("Expected not-supported error: %v", err)
+	}
+}
+
+func TestTimecode_Duration(t *testing.T) {
+	// One hour of drop-frame timecode is (nearly) one hour of real time.
+
+	tc := newTestTimecode("2997DropTimecode", "01;00;00;00")
+
+	d, err := tc.Duration()
+	log.PanicIf(err)
+
+	// 107892 frames at 30000/1001 fps is 3599.9964 seconds.
+	if d != 3599996400*time.Microsecond {
+		t.Fatalf("Duration not correct: [%s]", d)
+	}
+
+	// One hour of non-drop-frame timecode runs slow.
+
+	tc = newTestTimecode("2997NonDropTimecode", "01:00:00:00")
+
+	d, err = tc.Duration()
+	log.PanicIf(err)
+
+	if d != 3603600*time.Millisecond {
+		t.Fatalf("Duration not correct: [%s]", d)
+	}
+}
+
+func TestTimecode_FrameRate(t *testing.T) {
+	tc := newTestTimecode("5994DropTimecode", "00;00;00;00")
+
+	fr, err := tc.FrameRate()
+	log.PanicIf(err)
+
+	if fr.String() != "f60000s1001" {
+		t.Fatalf("Frame-rate not correct: [%s]", fr)
+	}
+}
@@ -0,0 +1,34 @@
+package xmptype
+
+var (
+	timeFormatChoices = []ChoiceDefinition{
+		{Code: "24Timecode", Label: "24 fps"},
+		{Code: "25Timecode", Label: "25 fps"},
+		{Code: "2997DropTimecode", Label: "29.97 fps, drop-frame"},
+		{Code: "2997NonDropTimecode", Label: "29.97 fps, non-drop-frame"},
+		{Code: "30Timecode", Label: "30 fps"},
+		{Code: "50Timecode", Label: "50 fps"},
+		{Code: "5994DropTimecode", Label: "59.94 fps, drop-frame"},
+		{Code: "5994NonDropTimecode", Label: "59.94 fps, non-drop-frame"},
+		{Code: "60Timecode", Label: "60 fps"},
+		{Code: "23976Timecode", Label: "23.976 fps"},
+	}
+)
+
+// TimeFormatFieldValue knows how to parse a timecode time-format value.
+type TimeFormatFieldValue struct {
+	ChoiceFieldValue
+}
+
+// TimeFormatFieldType describes a timecode time-format value. The value must be
+// one of the defined formats.
+type TimeFormatFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (tfft TimeFormatFieldType) GetValueParser(raw string) ScalarValueParser {
+	return TimeFormatFieldValue{
+		ChoiceFieldValue: NewChoiceFieldValue(raw, timeFormatChoices, true),
+	}
+}
@@ -0,0 +1,36 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestTimeFormatFieldType_GetValueParser(t *testing.T) {
+	ft := TimeFormatFieldType{}
+	scp := ft.GetValueParser("2997DropTimecode")
+
+	fv := scp.(TimeFormatFieldValue)
+
+	parsed, err := fv.Parse()
+	log.PanicIf(err)
+
+	expected := Choice{
+		Code:  "2997DropTimecode",
+		Label: "29.97 fps, drop-frame",
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestTimeFormatFieldType_GetValueParser_NotValid(t *testing.T) {
+	ft := TimeFormatFieldType{}
+	scp := ft.GetValueParser("29Timecode")
+
+	_, err := scp.Parse()
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
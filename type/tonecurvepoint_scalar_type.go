@@ -0,0 +1,82 @@
+package xmptype
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// CrsUri is the URI for the "crs" (Camera Raw) namespace. We can't use the
+	// same value from xmpnamespace because xmptype can't import from it.
+	CrsUri = "http://ns.adobe.com/camera-raw-settings/1.0/"
+)
+
+// ToneCurvePoint is a point of a Camera Raw tone curve (the items of
+// "crs:ToneCurve" and the like), stored as "input, output".
+type ToneCurvePoint struct {
+	// Input is the input level (0-255).
+	Input int64
+
+	// Output is the output level (0-255).
+	Output int64
+}
+
+// ParseToneCurvePoint parses a point in the "input, output" form (e.g.
+// "64, 56").
+func ParseToneCurvePoint(raw string) (tcp ToneCurvePoint, err error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 2 {
+		return tcp, ErrValueNotValid
+	}
+
+	input, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil {
+		return tcp, ErrValueNotValid
+	}
+
+	output, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+	if err != nil {
+		return tcp, ErrValueNotValid
+	}
+
+	tcp = ToneCurvePoint{
+		Input:  input,
+		Output: output,
+	}
+
+	return tcp, nil
+}
+
+// String returns the point in the form that it is stored.
+func (tcp ToneCurvePoint) String() string {
+	return fmt.Sprintf("%d, %d", tcp.Input, tcp.Output)
+}
+
+// ToneCurvePointFieldValue knows how to parse tone-curve points.
+type ToneCurvePointFieldValue struct {
+	raw string
+}
+
+// Parse parses the raw string value to a ToneCurvePoint.
+func (tcpfv ToneCurvePointFieldValue) Parse() (parsed interface{}, err error) {
+	tcp, err := ParseToneCurvePoint(tcpfv.raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return tcp, nil
+}
+
+// ToneCurvePointFieldType represents a point of a tone curve ("input,
+// output").
+type ToneCurvePointFieldType struct {
+}
+
+// GetValueParser returns an instance of ScalarValueParser initialized to
+// parse a specific string.
+func (tcpft ToneCurvePointFieldType) GetValueParser(raw string) ScalarValueParser {
+	return ToneCurvePointFieldValue{
+		raw: raw,
+	}
+}
@@ -0,0 +1,46 @@
+package xmptype
+
+import (
+	"testing"
+
+	"github.com/dsoprea/go-logging"
+)
+
+func TestToneCurvePointFieldType_GetValueParser(t *testing.T) {
+	tcpft := ToneCurvePointFieldType{}
+	scp := tcpft.GetValueParser("64, 56")
+
+	tcpfv := scp.(ToneCurvePointFieldValue)
+
+	parsed, err := tcpfv.Parse()
+	log.PanicIf(err)
+
+	expected := ToneCurvePoint{
+		Input:  64,
+		Output: 56,
+	}
+
+	if parsed != expected {
+		t.Fatalf("Parse is not correct: [%v]", parsed)
+	}
+}
+
+func TestToneCurvePointFieldType_GetValueParser_NotValid(t *testing.T) {
+	tcpft := ToneCurvePointFieldType{}
+
+	for _, raw := range []string{"test_text", "64", "64, 56, 1", "64, x", "6.4, 56"} {
+		_, err := tcpft.GetValueParser(raw).Parse()
+		if err != ErrValueNotValid {
+			t.Fatalf("Expected not-valid error for [%s]: %v", raw, err)
+		}
+	}
+}
+
+func TestToneCurvePoint_String(t *testing.T) {
+	tcp, err := ParseToneCurvePoint("0,255")
+	log.PanicIf(err)
+
+	if tcp.String() != "0, 255" {
+		t.Fatalf("String not correct: [%s]", tcp.String())
+	}
+}
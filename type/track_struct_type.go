@@ -0,0 +1,84 @@
+package xmptype
+
+import (
+	"fmt"
+	"reflect"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+// Track is a named set of markers of a media file (the "xmpDM:Track" struct).
+type Track struct {
+	// Name is the name of the track.
+	Name string
+
+	// Type is the type of the track's markers (e.g. "Chapter").
+	Type string
+
+	// FrameRate is the default frame-rate of the markers. Zero if not given.
+	FrameRate FrameRate
+
+	// Markers are the markers of the track in order.
+	Markers []Marker
+}
+
+// String returns a string representation of the track.
+func (t Track) String() string {
+	return fmt.Sprintf("Track<NAME=[%s] TYPE=[%s] FRAME-RATE=[%s] MARKERS=(%d)>", t.Name, t.Type, t.FrameRate, len(t.Markers))
+}
+
+// TrackFieldType is the item-type of arrays of tracks (e.g. "xmpDM:Tracks").
+type TrackFieldType struct {
+}
+
+// ParseItem parses the item to a Track.
+func (tft TrackFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return tft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a Track. Marker times that do not have
+// their own frame-rate are given the frame-rate of the track.
+func (tft TrackFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(XmpDmUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	t := Track{
+		Name:      sf.text("trackName"),
+		Type:      sf.text("trackType"),
+		FrameRate: sf.frameRate("frameRate"),
+	}
+
+	if values := sf.items("markers"); values != nil {
+		t.Markers = make([]Marker, len(values))
+
+		for i, value := range values {
+			m, ok := value.(Marker)
+			if ok == false {
+				log.Panicf("track marker is not a marker: [%v]", reflect.TypeOf(value))
+			}
+
+			if m.StartTime.Rate.IsZero() == true {
+				m.StartTime.Rate = t.FrameRate
+			}
+
+			if m.Duration.Count != 0 && m.Duration.Rate.IsZero() == true {
+				m.Duration.Rate = t.FrameRate
+			}
+
+			t.Markers[i] = m
+		}
+	}
+
+	return t, nil
+}
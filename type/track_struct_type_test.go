@@ -0,0 +1,106 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestTrackFieldType_ParseItem(t *testing.T) {
+	startTimeName := xml.Name{Space: XmpDmUri, Local: "startTime"}
+	durationName := xml.Name{Space: XmpDmUri, Local: "duration"}
+	nameName := xml.Name{Space: XmpDmUri, Local: "name"}
+
+	itemElements := [][]interface{}{
+		{
+			xml.StartElement{Name: rdfLiTag},
+			xml.StartElement{Name: startTimeName},
+			FrameCount{Count: 250},
+			xml.EndElement{Name: startTimeName},
+			xml.StartElement{Name: nameName},
+			"Intro",
+			xml.EndElement{Name: nameName},
+			xml.EndElement{Name: rdfLiTag},
+		},
+		{
+			xml.StartElement{Name: rdfLiTag},
+			xml.StartElement{Name: startTimeName},
+			FrameCount{Count: 30, Rate: FrameRate{Numerator: 30, Denominator: 1}},
+			xml.EndElement{Name: startTimeName},
+			xml.StartElement{Name: durationName},
+			FrameCount{Count: 50},
+			xml.EndElement{Name: durationName},
+			xml.EndElement{Name: rdfLiTag},
+		},
+	}
+
+	markers := OrderedMarkerArrayFieldType{}.New(xmpregistry.Default(), nil, NewCollected(rdfSeqTag, itemElements))
+	frameRate := FrameRate{Numerator: 25, Denominator: 1}
+
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: XmpDmUri, Local: "trackName"}: "Chapters",
+			{Space: XmpDmUri, Local: "trackType"}: "Chapter",
+			{Space: XmpDmUri, Local: "frameRate"}: frameRate,
+			{Space: XmpDmUri, Local: "markers"}:   markers,
+		},
+	}
+
+	parsed, err := TrackFieldType{}.ParseItem(ai)
+	log.PanicIf(err)
+
+	// Marker times without their own frame-rate take the track's.
+
+	expected := Track{
+		Name:      "Chapters",
+		Type:      "Chapter",
+		FrameRate: frameRate,
+		Markers: []Marker{
+			{
+				StartTime: FrameCount{Count: 250, Rate: frameRate},
+				Name:      "Intro",
+			},
+			{
+				StartTime: FrameCount{Count: 30, Rate: FrameRate{Numerator: 30, Denominator: 1}},
+				Duration:  FrameCount{Count: 50, Rate: frameRate},
+			},
+		},
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Track not correct: %s", parsed)
+	}
+
+	if parsed.(Track).String() != "Track<NAME=[Chapters] TYPE=[Chapter] FRAME-RATE=[f25] MARKERS=(2)>" {
+		t.Fatalf("String not correct: [%s]", parsed.(Track).String())
+	}
+}
+
+func TestTrackFieldType_ParseItem_NotTrack(t *testing.T) {
+	ai := ArrayItem{
+		CharData: "not a track",
+	}
+
+	_, err := TrackFieldType{}.ParseItem(ai)
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestTrackFieldType_ParseItem_MarkersNotArray(t *testing.T) {
+	ai := ArrayItem{
+		Attributes: map[xml.Name]interface{}{
+			{Space: XmpDmUri, Local: "markers"}: "not markers",
+		},
+	}
+
+	_, err := TrackFieldType{}.ParseItem(ai)
+	if err == nil {
+		t.Fatalf("Expected error for markers that are not an array.")
+	}
+}
@@ -0,0 +1,88 @@
+package xmptype
+
+import (
+	"fmt"
+	"reflect"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+)
+
+const (
+	// StVerUri is the URI for the "stVer" namespace. We can't use the same
+	// value from xmpnamespace because xmptype can't import from it.
+	StVerUri = "http://ns.adobe.com/xap/1.0/sType/Version#"
+)
+
+// Version is a single version of a document as recorded by a version-control
+// system (the "stVer" struct).
+type Version struct {
+	// Comments are the comments given when the version was checked in.
+	Comments string
+
+	// Event is the high-level event that created the version. Zero if not
+	// given.
+	Event ResourceEvent
+
+	// ModifyDate is when the version was checked in. Zero if not given.
+	ModifyDate XmpDate
+
+	// Modifier is the person who checked in the version.
+	Modifier string
+
+	// Version is the new version number.
+	Version string
+}
+
+// String returns a string representation of the version.
+func (v Version) String() string {
+	modifyDate := ""
+	if v.ModifyDate.IsZero() == false {
+		modifyDate = v.ModifyDate.Format()
+	}
+
+	return fmt.Sprintf("Version<VERSION=[%s] MODIFY-DATE=[%s] MODIFIER=[%s] ACTION=[%s] COMMENTS=[%s]>", v.Version, modifyDate, v.Modifier, v.Event.Action, v.Comments)
+}
+
+// VersionFieldType is the item-type of arrays of versions (e.g.
+// "xmpMM:Versions"). Items may have their fields as attributes or as child
+// nodes.
+type VersionFieldType struct {
+}
+
+// ParseItem parses the item to a Version.
+func (vft VersionFieldType) ParseItem(ai ArrayItem) (parsed interface{}, err error) {
+	return vft.ParseStruct(ai.Attributes)
+}
+
+// ParseStruct parses the fields to a Version.
+func (vft VersionFieldType) ParseStruct(fields map[xml.Name]interface{}) (parsed interface{}, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	sf := newStructFields(StVerUri, fields)
+
+	if sf.isEmpty() == true {
+		return nil, ErrValueNotValid
+	}
+
+	v := Version{
+		Comments:   sf.text("comments"),
+		ModifyDate: sf.date("modifyDate"),
+		Modifier:   sf.text("modifier"),
+		Version:    sf.text("version"),
+	}
+
+	if event := sf.nested("event", ResourceEventFieldType{}); event != nil {
+		var ok bool
+		if v.Event, ok = event.(ResourceEvent); ok == false {
+			log.Panicf("version event is not a resource event: [%v]", reflect.TypeOf(event))
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,135 @@
+package xmptype
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+)
+
+func TestVersionFieldType_ParseStruct(t *testing.T) {
+	modifyDate := NewXmpDate(time.Date(2014, 9, 22, 10, 56, 35, 0, time.UTC), DatePrecisionSecond, true)
+
+	fields := map[xml.Name]interface{}{
+		{Space: StVerUri, Local: "comments"}:   "Some comments",
+		{Space: StVerUri, Local: "modifier"}:   "Some Person",
+		{Space: StVerUri, Local: "modifyDate"}: modifyDate,
+		{Space: StVerUri, Local: "version"}:    "2",
+		{Space: StVerUri, Local: "event"}: StructValue{
+			{Space: StEvtUri, Local: "action"}: "checked-in",
+		},
+	}
+
+	parsed, err := VersionFieldType{}.ParseStruct(fields)
+	log.PanicIf(err)
+
+	expected := Version{
+		Comments: "Some comments",
+		Event: ResourceEvent{
+			Action: "checked-in",
+		},
+		Modifier:   "Some Person",
+		ModifyDate: modifyDate,
+		Version:    "2",
+	}
+
+	if reflect.DeepEqual(parsed, expected) != true {
+		t.Fatalf("Version not correct: %s", parsed)
+	}
+
+	v := parsed.(Version)
+
+	if v.String() != "Version<VERSION=[2] MODIFY-DATE=[2014-09-22T10:56:35+00:00] MODIFIER=[Some Person] ACTION=[checked-in] COMMENTS=[Some comments]>" {
+		t.Fatalf("String not correct: [%s]", v.String())
+	}
+}
+
+func TestVersionFieldType_ParseStruct_EventNotStruct(t *testing.T) {
+	fields := map[xml.Name]interface{}{
+		{Space: StVerUri, Local: "event"}: "not an event",
+	}
+
+	_, err := VersionFieldType{}.ParseStruct(fields)
+	if err == nil {
+		t.Fatalf("Expected error for event that is not a struct.")
+	}
+}
+
+func TestVersionFieldType_ParseItem_NotVersion(t *testing.T) {
+	ai := ArrayItem{
+		CharData: "not a version",
+	}
+
+	_, err := VersionFieldType{}.ParseItem(ai)
+	if err != ErrValueNotValid {
+		t.Fatalf("Expected not-valid error: %v", err)
+	}
+}
+
+func TestOrderedVersionArrayValue_Versions(t *testing.T) {
+	itemName := xml.Name{Space: RdfUri, Local: "li"}
+	versionName := xml.Name{Space: StVerUri, Local: "version"}
+	eventName := xml.Name{Space: StVerUri, Local: "event"}
+	actionName := xml.Name{Space: StEvtUri, Local: "action"}
+
+	itemElements := [][]interface{}{
+		{
+			xml.StartElement{Name: itemName},
+			xml.StartElement{Name: versionName},
+			"1",
+			xml.EndElement{Name: versionName},
+			xml.StartElement{Name: eventName},
+			xml.StartElement{Name: actionName},
+			"checked-in",
+			xml.EndElement{Name: actionName},
+			xml.EndElement{Name: eventName},
+			xml.EndElement{Name: itemName},
+		},
+		{
+			xml.StartElement{Name: itemName},
+			"not a version",
+			xml.EndElement{Name: itemName},
+		},
+	}
+
+	collected := NewCollected(rdfSeqTag, itemElements)
+	av := OrderedVersionArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, collected)
+	ovav := av.(OrderedVersionArrayValue)
+
+	_, err := ovav.Versions()
+	if _, ok := err.(ArrayItemsError); ok != true {
+		t.Fatalf("Expected items error: %v", err)
+	}
+
+	collected = NewCollected(rdfSeqTag, itemElements[:1])
+	av = OrderedVersionArrayFieldType{}.New(xmpregistry.Default(), testPropertyName, collected)
+	ovav = av.(OrderedVersionArrayValue)
+
+	versions, err := ovav.Versions()
+	log.PanicIf(err)
+
+	expected := []Version{
+		{
+			Event: ResourceEvent{
+				Action: "checked-in",
+			},
+			Version: "1",
+		},
+	}
+
+	if reflect.DeepEqual(versions, expected) != true {
+		t.Fatalf("Versions not correct: %v", versions)
+	}
+
+	items, err := ovav.StringItems()
+	log.PanicIf(err)
+
+	if len(items) != 1 {
+		t.Fatalf("String items not correct: %v", items)
+	}
+}
@@ -0,0 +1,350 @@
+package xmp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+var (
+	// ErrSkipSubtree may be returned by a WalkFunc to skip the children of
+	// the current struct node. It is ignored for any other kind of node.
+	ErrSkipSubtree = errors.New("skip subtree")
+
+	// ErrStopWalk may be returned by a WalkFunc to stop walking. Walk will
+	// return nil.
+	ErrStopWalk = errors.New("stop walk")
+)
+
+// NodeKind describes what a node in the property tree represents.
+type NodeKind int
+
+const (
+	// NodeKindStruct is an intermediate node that has child properties.
+	NodeKindStruct NodeKind = iota
+
+	// NodeKindScalar is a leaf node having a single parsed value.
+	NodeKindScalar
+
+	// NodeKindArray is a leaf node having an array value.
+	NodeKindArray
+
+	// NodeKindComplex is a leaf node described only by attributes.
+	NodeKindComplex
+)
+
+// String returns a string representation of the kind.
+func (kind NodeKind) String() string {
+	switch kind {
+	case NodeKindStruct:
+		return "struct"
+	case NodeKindScalar:
+		return "scalar"
+	case NodeKindArray:
+		return "array"
+	case NodeKindComplex:
+		return "complex"
+	}
+
+	return fmt.Sprintf("NodeKind<%d>", int(kind))
+}
+
+// Node describes a single node visited while walking an index.
+type Node struct {
+	// Kind is the kind of the node.
+	Kind NodeKind
+
+	// Name is the name of the node. It is the same as the last part of the
+	// path.
+	Name xmpregistry.XmlName
+
+	// FieldType is the registered field-type of the node or nil if the
+	// namespace or field is not registered.
+	FieldType interface{}
+
+	// Value is the parsed value for scalar nodes, the xmptype.ArrayValue for
+	// array nodes, the ComplexLeafNode for complex nodes, and nil for struct
+	// nodes.
+	Value interface{}
+
+	// Qualifiers are the qualifiers of a scalar node, if any.
+	Qualifiers xmptype.Qualifiers
+
+	// Occurrence is the position of the value among all of the values stored
+	// under the same path. It is almost always zero.
+	Occurrence int
+
+	// Index is the subindex of a struct node and nil otherwise.
+	Index *XmpPropertyIndex
+}
+
+// String returns a string representation of the node.
+func (node Node) String() string {
+	return fmt.Sprintf("Node<KIND=[%s] NAME=[%s] FIELD-TYPE=[%v] VALUE-TYPE=[%v]>", node.Kind, node.Name, reflect.TypeOf(node.FieldType), reflect.TypeOf(node.Value))
+}
+
+// WalkFunc is called for each node visited by Walk.
+type WalkFunc func(path xmpregistry.XmpPropertyName, node Node) error
+
+// lookupFieldType returns the field-type registered in the given registry for
+// the last node of the given path, with respect to the property that encloses
+// it, or nil if not known.
+func lookupFieldType(registry *xmpregistry.Registry, path xmpregistry.XmpPropertyName) interface{} {
+	name := path[len(path)-1]
+
+	namespace, err := registry.Get(name.Space)
+	if err != nil {
+		return nil
+	}
+
+	ft, _ := namespace.FieldType(xmptype.ParentName(path), name.Local)
+
+	return ft
+}
+
+// newLeafNode describes a single value stored under the last node of the
+// given path.
+func (xpi *XmpPropertyIndex) newLeafNode(path xmpregistry.XmpPropertyName, value interface{}, occurrence int) (node Node, err error) {
+	defer func() {
+		if errRaw := recover(); errRaw != nil {
+			err = log.Wrap(errRaw.(error))
+		}
+	}()
+
+	node = Node{
+		Name:       path[len(path)-1],
+		FieldType:  lookupFieldType(xpi.registry, path),
+		Occurrence: occurrence,
+	}
+
+	if sln, ok := value.(ScalarLeafNode); ok == true {
+		node.Kind = NodeKindScalar
+		node.Value = sln.ParsedValue
+		node.Qualifiers = sln.Qualifiers
+	} else if av, ok := value.(xmptype.ArrayValue); ok == true {
+		node.Kind = NodeKindArray
+		node.Value = av
+	} else if cln, ok := value.(ComplexLeafNode); ok == true {
+		node.Kind = NodeKindComplex
+		node.Value = cln
+	} else {
+		log.Panicf("can not walk unhandled value: [%v]", reflect.TypeOf(value))
+	}
+
+	return node, nil
+}
+
+func newStructNode(path xmpregistry.XmpPropertyName, subindex *XmpPropertyIndex) Node {
+	return Node{
+		Kind:      NodeKindStruct,
+		Name:      subindex.nodeName,
+		FieldType: lookupFieldType(subindex.registry, path),
+		Index:     subindex,
+	}
+}
+
+// childPath returns a new path with the given name appended. The parent path
+// is never modified.
+func childPath(parent xmpregistry.XmpPropertyName, name xmpregistry.XmlName) xmpregistry.XmpPropertyName {
+	path := make(xmpregistry.XmpPropertyName, len(parent), len(parent)+1)
+	copy(path, parent)
+
+	return append(path, name)
+}
+
+func (xpi *XmpPropertyIndex) walk(path xmpregistry.XmpPropertyName, order IndexOrder, cb WalkFunc) (err error) {
+	for _, entry := range xpi.orderedEntries(order) {
+		currentPath := childPath(path, entry.name)
+
+		if entry.isLeaf == false {
+			subindex := xpi.subindices[entry.key]
+
+			err := cb(currentPath, newStructNode(currentPath, subindex))
+			if err == ErrSkipSubtree {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			err = subindex.walk(currentPath, order, cb)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		for i, value := range xpi.leaves[entry.key] {
+			node, err := xpi.newLeafNode(currentPath, value, i)
+			if err != nil {
+				return err
+			}
+
+			err = cb(currentPath, node)
+			if err != nil && err != ErrSkipSubtree {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Walk visits every node of the index depth-first with properties sorted by
+// namespace prefix and then by local name. Struct nodes are visited before
+// their children. If the callback returns ErrSkipSubtree for a struct node,
+// its children are not visited. If it returns ErrStopWalk, walking stops and
+// nil is returned. Any other error stops walking and is returned as-is.
+func (xpi *XmpPropertyIndex) Walk(cb WalkFunc) (err error) {
+	return xpi.WalkOrdered(IndexOrderSorted, cb)
+}
+
+// WalkOrdered is equivalent to Walk but visits properties in the given order.
+func (xpi *XmpPropertyIndex) WalkOrdered(order IndexOrder, cb WalkFunc) (err error) {
+	rootPath := make(xmpregistry.XmpPropertyName, 0)
+
+	err = xpi.walk(rootPath, order, cb)
+	if err == ErrStopWalk {
+		return nil
+	}
+
+	return err
+}
+
+// nodeIteratorFrame tracks the position within a single level of the tree.
+type nodeIteratorFrame struct {
+	index   *XmpPropertyIndex
+	path    xmpregistry.XmpPropertyName
+	entries []indexEntry
+
+	// position is the current entry.
+	position int
+
+	// occurrence is the next value to return for the current leaf entry.
+	occurrence int
+}
+
+// NodeIterator is the iterator form of Walk. Nodes are returned in the same
+// order that Walk would visit them.
+type NodeIterator struct {
+	order IndexOrder
+	stack []*nodeIteratorFrame
+
+	currentPath xmpregistry.XmpPropertyName
+	currentNode Node
+	err         error
+
+	// skip indicates that the children of the current struct node should not
+	// be descended into.
+	skip bool
+}
+
+// Iterate returns an iterator over all nodes in the given order.
+func (xpi *XmpPropertyIndex) Iterate(order IndexOrder) *NodeIterator {
+	rootFrame := &nodeIteratorFrame{
+		index:   xpi,
+		path:    make(xmpregistry.XmpPropertyName, 0),
+		entries: xpi.orderedEntries(order),
+	}
+
+	return &NodeIterator{
+		order: order,
+		stack: []*nodeIteratorFrame{rootFrame},
+	}
+}
+
+// Next advances to the next node. It returns false when there are no more
+// nodes or if an error occurred (see Err).
+func (ni *NodeIterator) Next() bool {
+	if ni.err != nil {
+		return false
+	}
+
+	// Descend into the last-returned struct node unless we were told to skip
+	// it.
+
+	if ni.currentNode.Kind == NodeKindStruct && ni.currentNode.Index != nil && ni.skip == false {
+		subindex := ni.currentNode.Index
+
+		frame := &nodeIteratorFrame{
+			index:   subindex,
+			path:    ni.currentPath,
+			entries: subindex.orderedEntries(ni.order),
+		}
+
+		ni.stack = append(ni.stack, frame)
+	}
+
+	ni.skip = false
+	ni.currentNode = Node{}
+
+	for len(ni.stack) > 0 {
+		frame := ni.stack[len(ni.stack)-1]
+
+		if frame.position >= len(frame.entries) {
+			ni.stack = ni.stack[:len(ni.stack)-1]
+			continue
+		}
+
+		entry := frame.entries[frame.position]
+		currentPath := childPath(frame.path, entry.name)
+
+		if entry.isLeaf == false {
+			frame.position++
+
+			ni.currentPath = currentPath
+			ni.currentNode = newStructNode(currentPath, frame.index.subindices[entry.key])
+
+			return true
+		}
+
+		values := frame.index.leaves[entry.key]
+		if frame.occurrence >= len(values) {
+			frame.position++
+			frame.occurrence = 0
+
+			continue
+		}
+
+		node, err := frame.index.newLeafNode(currentPath, values[frame.occurrence], frame.occurrence)
+		if err != nil {
+			ni.err = err
+			return false
+		}
+
+		frame.occurrence++
+
+		ni.currentPath = currentPath
+		ni.currentNode = node
+
+		return true
+	}
+
+	return false
+}
+
+// SkipSubtree prevents the next call to Next from descending into the
+// current struct node.
+func (ni *NodeIterator) SkipSubtree() {
+	ni.skip = true
+}
+
+// Path returns the path of the current node.
+func (ni *NodeIterator) Path() xmpregistry.XmpPropertyName {
+	return ni.currentPath
+}
+
+// Node returns the current node.
+func (ni *NodeIterator) Node() Node {
+	return ni.currentNode
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (ni *NodeIterator) Err() error {
+	return ni.err
+}
@@ -0,0 +1,249 @@
+package xmp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"encoding/xml"
+
+	"github.com/dsoprea/go-logging"
+
+	"github.com/dsoprea/go-xmp/namespace"
+	"github.com/dsoprea/go-xmp/registry"
+	"github.com/dsoprea/go-xmp/type"
+)
+
+// getTestWalkIndex returns an index with one node of every kind. The caller
+// must clear the registry.
+func getTestWalkIndex() *XmpPropertyIndex {
+	xpi := getTestOrderingIndex()
+
+	xmpregistry.Register(xmpnamespace.XmpMmNamespace)
+	xmpregistry.Register(xmpnamespace.StRefNamespace)
+
+	identifierXpn := xmpregistry.XmpPropertyName{
+		{Space: xmpnamespace.XUri, Local: "xmpmeta"},
+		{Space: xmpnamespace.XmpUri, Local: "Identifier"},
+	}
+
+	identifierFt := xmpnamespace.XmpNamespace.Fields["Identifier"].(xmptype.ArrayFieldType)
+
+	bagName := xml.Name{Space: xmpnamespace.RdfUri, Local: "Bag"}
+
+	collected := []interface{}{
+		xml.StartElement{Name: bagName},
+		xml.StartElement{Name: xmpnamespace.RdfLiTag},
+		"identifier1",
+		xml.EndElement{Name: xmpnamespace.RdfLiTag},
+		xml.EndElement{Name: bagName},
+	}
+
+	err := xpi.addArrayValue(identifierXpn, identifierFt.New(xmpregistry.Default(), identifierXpn, collected))
+	log.PanicIf(err)
+
+	derivedFromXpn := xmpregistry.XmpPropertyName{
+		{Space: xmpnamespace.XUri, Local: "xmpmeta"},
+		{Space: xmpnamespace.XmpMmUri, Local: "DerivedFrom"},
+	}
+
+	attributes := map[xml.Name]interface{}{
+		{Space: xmpnamespace.StRefUri, Local: "documentID"}: "some-document-id",
+	}
+
+	err = xpi.addComplexValue(derivedFromXpn, attributes)
+	log.PanicIf(err)
+
+	return xpi
+}
+
+func TestXmpPropertyIndex_Walk(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestWalkIndex()
+
+	visited := make([]string, 0)
+
+	cb := func(path xmpregistry.XmpPropertyName, node Node) error {
+		visited = append(visited, path.String()+" "+node.Kind.String())
+		return nil
+	}
+
+	err := xpi.Walk(cb)
+	log.PanicIf(err)
+
+	expected := []string{
+		"[x]xmpmeta struct",
+		"[x]xmpmeta.[xmp]BaseURL scalar",
+		"[x]xmpmeta.[xmp]Identifier array",
+		"[x]xmpmeta.[xmp]Label scalar",
+		"[x]xmpmeta.[xmp]Nickname scalar",
+		"[x]xmpmeta.[xmpMM]DerivedFrom complex",
+	}
+
+	if reflect.DeepEqual(visited, expected) != true {
+		t.Fatalf("Visited nodes not correct: %v", visited)
+	}
+}
+
+func TestXmpPropertyIndex_Walk_NodeDetails(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestWalkIndex()
+
+	nodes := make(map[string]Node)
+
+	cb := func(path xmpregistry.XmpPropertyName, node Node) error {
+		nodes[path.String()] = node
+		return nil
+	}
+
+	err := xpi.Walk(cb)
+	log.PanicIf(err)
+
+	label := nodes["[x]xmpmeta.[xmp]Label"]
+
+	if label.Value != "some label" {
+		t.Fatalf("Scalar value not correct: [%v]", label.Value)
+	} else if label.FieldType != (xmptype.TextFieldType{}) {
+		t.Fatalf("Scalar field-type not correct: [%v]", reflect.TypeOf(label.FieldType))
+	}
+
+	identifier := nodes["[x]xmpmeta.[xmp]Identifier"]
+
+	items, err := identifier.Value.(xmptype.ArrayStringValueLister).StringItems()
+	log.PanicIf(err)
+
+	if reflect.DeepEqual(items, []string{"identifier1"}) != true {
+		t.Fatalf("Array value not correct: %v", items)
+	}
+
+	derivedFrom := nodes["[x]xmpmeta.[xmpMM]DerivedFrom"]
+
+	if _, ok := derivedFrom.FieldType.(xmptype.ResourceRefFieldType); ok != true {
+		t.Fatalf("Field-type not correct: [%v]", reflect.TypeOf(derivedFrom.FieldType))
+	}
+
+	documentId, found := derivedFrom.Value.(ComplexLeafNode).Get(xmpnamespace.StRefUri, "documentID")
+	if found != true || documentId != "some-document-id" {
+		t.Fatalf("Complex value not correct: [%v]", documentId)
+	}
+
+	root := nodes["[x]xmpmeta"]
+
+	if root.Index == nil {
+		t.Fatalf("Struct node does not have subindex.")
+	}
+}
+
+func TestXmpPropertyIndex_Walk_SkipSubtree(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestWalkIndex()
+
+	visited := 0
+
+	cb := func(path xmpregistry.XmpPropertyName, node Node) error {
+		visited++
+
+		if node.Kind == NodeKindStruct {
+			return ErrSkipSubtree
+		}
+
+		return nil
+	}
+
+	err := xpi.Walk(cb)
+	log.PanicIf(err)
+
+	if visited != 1 {
+		t.Fatalf("Expected only the root struct to be visited: (%d)", visited)
+	}
+}
+
+func TestXmpPropertyIndex_Walk_Stop(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestWalkIndex()
+
+	visited := 0
+
+	cb := func(path xmpregistry.XmpPropertyName, node Node) error {
+		visited++
+
+		if visited == 3 {
+			return ErrStopWalk
+		}
+
+		return nil
+	}
+
+	err := xpi.Walk(cb)
+	log.PanicIf(err)
+
+	if visited != 3 {
+		t.Fatalf("Walk did not stop: (%d)", visited)
+	}
+}
+
+func TestXmpPropertyIndex_Walk_Error(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestWalkIndex()
+
+	errSome := errors.New("some error")
+
+	cb := func(path xmpregistry.XmpPropertyName, node Node) error {
+		return errSome
+	}
+
+	err := xpi.Walk(cb)
+	if err != errSome {
+		t.Fatalf("Expected callback error: [%v]", err)
+	}
+}
+
+func TestXmpPropertyIndex_Iterate(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestWalkIndex()
+
+	walked := make([]string, 0)
+
+	cb := func(path xmpregistry.XmpPropertyName, node Node) error {
+		walked = append(walked, path.String())
+		return nil
+	}
+
+	err := xpi.WalkOrdered(IndexOrderDocument, cb)
+	log.PanicIf(err)
+
+	iterated := make([]string, 0)
+
+	ni := xpi.Iterate(IndexOrderDocument)
+	for ni.Next() == true {
+		iterated = append(iterated, ni.Path().String())
+	}
+
+	log.PanicIf(ni.Err())
+
+	if reflect.DeepEqual(iterated, walked) != true {
+		t.Fatalf("Iterated nodes not correct:\n%v\n!=\n%v", iterated, walked)
+	}
+}
+
+func TestNodeIterator_SkipSubtree(t *testing.T) {
+	defer xmpregistry.Clear()
+	xpi := getTestWalkIndex()
+
+	count := 0
+
+	ni := xpi.Iterate(IndexOrderSorted)
+	for ni.Next() == true {
+		count++
+
+		if ni.Node().Kind == NodeKindStruct {
+			ni.SkipSubtree()
+		}
+	}
+
+	log.PanicIf(ni.Err())
+
+	if count != 1 {
+		t.Fatalf("Expected only the root struct to be returned: (%d)", count)
+	}
+}